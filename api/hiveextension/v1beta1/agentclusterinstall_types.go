@@ -83,6 +83,27 @@ const (
 	ClusterLastInstallationPreparationPending           string                             = "Cluster preparation has never been performed for this cluster"
 	ClusterLastInstallationPreparationFailedCondition   hivev1.ClusterInstallConditionType = "LastInstallationPreparationFailed"
 
+	ClusterOperatorsFailedCondition       hivev1.ClusterInstallConditionType = "OperatorsFailed"
+	ClusterOperatorsFailedReason          string                             = "OperatorsFailed"
+	ClusterOperatorsFailedMsg             string                             = "The following operators failed to install:"
+	ClusterOperatorsNotFailedReason       string                             = "OperatorsNotFailed"
+	ClusterOperatorsNotFailedMsg          string                             = "No operator failed to install"
+	ClusterOperatorsProgressingCondition  hivev1.ClusterInstallConditionType = "OperatorsProgressing"
+	ClusterOperatorsProgressingReason     string                             = "OperatorsProgressing"
+	ClusterOperatorsProgressingMsg        string                             = "The following operators are still progressing:"
+	ClusterOperatorsNotProgressingReason  string                             = "OperatorsNotProgressing"
+	ClusterOperatorsNotProgressingMsg     string                             = "No operator is progressing"
+	ClusterOperatorsNotInstalledYetReason string                             = "ClusterNotInstalled"
+	ClusterOperatorsNotInstalledYetMsg    string                             = "Operator status is reported once the cluster is installed"
+
+	ClusterSpokeHealthyCondition  hivev1.ClusterInstallConditionType = "SpokeHealthy"
+	ClusterSpokeHealthyReason     string                             = "SpokeHealthy"
+	ClusterSpokeHealthyMsg        string                             = "The spoke cluster is healthy:"
+	ClusterSpokeUnhealthyReason   string                             = "SpokeUnhealthy"
+	ClusterSpokeUnhealthyMsg      string                             = "The spoke cluster is unhealthy:"
+	ClusterSpokeUnreachableReason string                             = "SpokeUnreachable"
+	ClusterSpokeUnreachableMsg    string                             = "The spoke cluster could not be reached:"
+
 	ClusterConsumerLabel string = "agentclusterinstalls.agent-install.openshift.io/consumer"
 )
 
@@ -317,6 +338,89 @@ type AgentClusterInstallStatus struct {
 	// ValidationsInfo is a JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)
 	// +optional
 	ValidationsInfo common.ValidationsStatus `json:"validationsInfo,omitempty"`
+
+	// Operators is the installation status of each OLM operator installed on the cluster, as tracked by the cluster monitor.
+	// +optional
+	Operators []OperatorStatus `json:"operators,omitempty"`
+
+	// MachinePools is the day-2 scaling state of the compute pools that set replicas.
+	// +optional
+	MachinePools []AgentMachinePoolStatus `json:"machinePools,omitempty"`
+
+	// SpecPreview is the result of a dry run of the Spec sync, reported instead of applying the Spec
+	// while the spec-dry-run annotation is set.
+	// +optional
+	SpecPreview *SpecPreview `json:"specPreview,omitempty"`
+}
+
+// SpecPreview describes the changes that syncing the Spec would make to the cluster in the backend.
+type SpecPreview struct {
+	// Changes are the cluster fields that would be updated.
+	// +optional
+	Changes []SpecPreviewFieldChange `json:"changes,omitempty"`
+
+	// ValidationChanges are the cluster validations whose status would change.
+	// +optional
+	ValidationChanges []SpecPreviewValidationChange `json:"validationChanges,omitempty"`
+
+	// Error is the error that the backend would return when applying the changes.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// SpecPreviewFieldChange is a cluster field that would be updated by syncing the Spec.
+type SpecPreviewFieldChange struct {
+	// Field is the name of the cluster field, as in the assisted-service REST API.
+	Field string `json:"field"`
+
+	// Current is the JSON encoded current value of the field.
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// Desired is the JSON encoded value of the field after the update.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+}
+
+// SpecPreviewValidationChange is a cluster validation whose status would change by syncing the Spec.
+type SpecPreviewValidationChange struct {
+	// ID is the id of the validation.
+	ID string `json:"id"`
+
+	// Current is the current status of the validation.
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// Desired is the status of the validation after the update.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Message is the message of the validation after the update.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// OperatorStatus is the installation status of a single OLM operator.
+type OperatorStatus struct {
+	// Name is the name of the operator.
+	Name string `json:"name"`
+
+	// Version is the installed version of the operator, once known.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Status is the installation status of the operator.
+	// +kubebuilder:validation:Enum="";available;progressing;failed
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Message contains detailed information about the operator status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastUpdated is the last time the status of the operator was updated.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
 }
 
 type DebugInfo struct {
@@ -487,6 +591,39 @@ type AgentMachinePool struct {
 	// For the control plane machine pool, the name will always be "master".
 	// For the compute machine pools, the only valid name is "worker".
 	Name string `json:"name"`
+
+	// Replicas is the desired number of day-2 Agents in a compute pool once the cluster is installed.
+	// Agents selected by AgentSelector are bound to the cluster and installed until the count is
+	// reached, and Agents that were added by the pool are unbound when the count is lowered.
+	// Ignored for the control plane and arbiter pools.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// AgentSelector selects the unbound and approved Agents, in the namespace of the AgentClusterInstall,
+	// that can be added to a compute pool when scaling up.
+	// +optional
+	AgentSelector *metav1.LabelSelector `json:"agentSelector,omitempty"`
+
+	// MachineConfigPool is the name of the MachineConfigPool set on the Agents added to a compute pool.
+	// +optional
+	MachineConfigPool string `json:"machineConfigPool,omitempty"`
+}
+
+// AgentMachinePoolStatus reports the day-2 scaling state of a compute machine pool.
+type AgentMachinePoolStatus struct {
+	// Name is the name of the machine pool.
+	Name string `json:"name"`
+
+	// Replicas is the desired number of day-2 Agents in the pool.
+	Replicas int32 `json:"replicas"`
+
+	// BoundAgents is the number of Agents currently bound to the cluster through the pool.
+	BoundAgents int32 `json:"boundAgents"`
+
+	// Message explains why the desired number of Agents can't be reached, if it can't.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type DiskEncryption struct {
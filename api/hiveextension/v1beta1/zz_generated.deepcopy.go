@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/api/common"
 	"github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -120,17 +121,19 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 	if in.ControlPlane != nil {
 		in, out := &in.ControlPlane, &out.ControlPlane
 		*out = new(AgentMachinePool)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Compute != nil {
 		in, out := &in.Compute, &out.Compute
 		*out = make([]AgentMachinePool, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Arbiter != nil {
 		in, out := &in.Arbiter, &out.Arbiter
 		*out = new(AgentMachinePool)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.APIVIPs != nil {
 		in, out := &in.APIVIPs, &out.APIVIPs
@@ -232,6 +235,23 @@ func (in *AgentClusterInstallStatus) DeepCopyInto(out *AgentClusterInstallStatus
 			(*out)[key] = outVal
 		}
 	}
+	if in.Operators != nil {
+		in, out := &in.Operators, &out.Operators
+		*out = make([]OperatorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachinePools != nil {
+		in, out := &in.MachinePools, &out.MachinePools
+		*out = make([]AgentMachinePoolStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpecPreview != nil {
+		in, out := &in.SpecPreview, &out.SpecPreview
+		*out = new(SpecPreview)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallStatus.
@@ -247,6 +267,16 @@ func (in *AgentClusterInstallStatus) DeepCopy() *AgentClusterInstallStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentMachinePool) DeepCopyInto(out *AgentMachinePool) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AgentSelector != nil {
+		in, out := &in.AgentSelector, &out.AgentSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentMachinePool.
@@ -259,6 +289,21 @@ func (in *AgentMachinePool) DeepCopy() *AgentMachinePool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentMachinePoolStatus) DeepCopyInto(out *AgentMachinePoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentMachinePoolStatus.
+func (in *AgentMachinePoolStatus) DeepCopy() *AgentMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaCertificateReference) DeepCopyInto(out *CaCertificateReference) {
 	*out = *in
@@ -474,6 +519,25 @@ func (in *Networking) DeepCopy() *Networking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorStatus) DeepCopyInto(out *OperatorStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorStatus.
+func (in *OperatorStatus) DeepCopy() *OperatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisionRequirements) DeepCopyInto(out *ProvisionRequirements) {
 	*out = *in
@@ -503,3 +567,58 @@ func (in *Proxy) DeepCopy() *Proxy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecPreview) DeepCopyInto(out *SpecPreview) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]SpecPreviewFieldChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidationChanges != nil {
+		in, out := &in.ValidationChanges, &out.ValidationChanges
+		*out = make([]SpecPreviewValidationChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecPreview.
+func (in *SpecPreview) DeepCopy() *SpecPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecPreviewFieldChange) DeepCopyInto(out *SpecPreviewFieldChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecPreviewFieldChange.
+func (in *SpecPreviewFieldChange) DeepCopy() *SpecPreviewFieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecPreviewFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecPreviewValidationChange) DeepCopyInto(out *SpecPreviewValidationChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecPreviewValidationChange.
+func (in *SpecPreviewValidationChange) DeepCopy() *SpecPreviewValidationChange {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecPreviewValidationChange)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -92,6 +92,18 @@ const (
 
 	CleanupCondition    conditionsv1.ConditionType = "Cleanup"
 	CleanupFailedReason string                     = "CleanupFailed"
+
+	NodeHealthyCondition       conditionsv1.ConditionType = "NodeHealthy"
+	NodeHealthyReason          string                     = "NodeHealthy"
+	NodeHealthyMsg             string                     = "The node is ready and matches the Agent's node labels and machine config pool"
+	NodeNotReadyReason         string                     = "NodeNotReady"
+	NodeNotReadyMsg            string                     = "The node is not ready"
+	NodeNotFoundReason         string                     = "NodeNotFound"
+	NodeNotFoundMsg            string                     = "The node was not found in the spoke cluster"
+	NodeDriftedReason          string                     = "NodeDrifted"
+	NodeDriftedMsg             string                     = "The node no longer matches the Agent spec:"
+	NodeSpokeUnreachableReason string                     = "SpokeUnreachable"
+	NodeSpokeUnreachableMsg    string                     = "The spoke cluster could not be reached:"
 )
 
 type HostMemory struct {
@@ -231,7 +243,8 @@ type AgentSpec struct {
 	// IgnitionConfigOverrides is a JSON-formatted string containing user-provided Ignition configuration
 	// that will be merged with the base Ignition config generated for this host. Use this to customize
 	// the host's initial configuration (files, systemd units, users, etc.). The override is applied
-	// during installation and takes precedence over generated configuration.
+	// during installation and takes precedence over generated configuration. A Butane config of the
+	// openshift or fcos variant can be used instead, and is translated to Ignition.
 	IgnitionConfigOverrides string `json:"ignitionConfigOverrides,omitempty"`
 	// IgnitionEndpointTokenReference references a secret containing an Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
 	IgnitionEndpointTokenReference *IgnitionEndpointTokenReference `json:"ignitionEndpointTokenReference,omitempty"`
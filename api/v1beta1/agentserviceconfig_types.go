@@ -39,6 +39,18 @@ type OSImage struct {
 	// The CPU architecture of the image (x86_64/arm64/etc).
 	// +optional
 	CPUArchitecture string `json:"cpuArchitecture"`
+	// KernelSHA256 is the expected SHA-256 digest of the kernel of the image, as served by the image service.
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	// +optional
+	KernelSHA256 string `json:"kernelSHA256,omitempty"`
+	// RootfsSHA256 is the expected SHA-256 digest of the rootfs of the image, as served by the image service.
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	// +optional
+	RootfsSHA256 string `json:"rootfsSHA256,omitempty"`
+	// KernelSignatureURL specifies the path to a detached signature of the kernel of the image, which iPXE
+	// verifies the kernel with before booting it.
+	// +optional
+	KernelSignatureURL string `json:"kernelSignatureURL,omitempty"`
 }
 
 type MustGatherImage struct {
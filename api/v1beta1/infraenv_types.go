@@ -97,7 +97,8 @@ type InfraEnvSpec struct {
 	// +optional
 	ClusterRef *ClusterReference `json:"clusterRef,omitempty"`
 
-	// Json formatted string containing the user overrides for the initial ignition config
+	// Json formatted string containing the user overrides for the initial ignition config, or a Butane config
+	// of the openshift or fcos variant that is translated to it
 	// +optional
 	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
 
@@ -245,9 +246,19 @@ type BootArtifacts struct {
 	// KernelURL specifies an HTTP/S URL that contains the kernel
 	// +optional
 	KernelURL string `json:"kernel"`
+	// KernelSHA256 specifies the expected SHA-256 digest of the kernel, when the OS image provides it
+	// +optional
+	KernelSHA256 string `json:"kernelSHA256,omitempty"`
+	// RootfsSHA256 specifies the expected SHA-256 digest of the rootfs, when the OS image provides it
+	// +optional
+	RootfsSHA256 string `json:"rootfsSHA256,omitempty"`
 	// IpxeScriptURL specifies an HTTP/S URL that contains the iPXE script
 	// +optional
 	IpxeScriptURL string `json:"ipxeScript"`
+	// GrubConfigURL specifies an HTTP/S URL that contains the GRUB config for hosts that boot with PXE and GRUB or
+	// with UEFI HTTP Boot and GRUB
+	// +optional
+	GrubConfigURL string `json:"grubConfig"`
 	// DiscoveryIgnitionURL specifies an HTTP/S URL that contains the discovery ignition
 	// +optional
 	DiscoveryIgnitionURL string `json:"discoveryIgnitionURL"`
@@ -63,7 +63,7 @@ type GetInfraEnvPresignedFileURLParams struct {
 
 	/* FileName.
 
-	   The file to be downloaded.
+	   The file to be downloaded. For uefi-http-boot, the URL of the discovery image that UEFI HTTP Boot clients boot from is returned.
 	*/
 	FileName string
 
@@ -77,7 +77,7 @@ type GetInfraEnvPresignedFileURLParams struct {
 
 	/* IpxeScriptType.
 
-	   Specify the script type to be served for iPXE.
+	   Specify the script type to be served for iPXE or GRUB.
 	*/
 	IpxeScriptType *string
 
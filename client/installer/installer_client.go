@@ -55,6 +55,9 @@ type API interface {
 	/*
 	   ListClusterHosts Get a list of cluster hosts according to supplied filters.*/
 	ListClusterHosts(ctx context.Context, params *ListClusterHostsParams) (*ListClusterHostsOK, error)
+	/*
+	   ListDiscoveryCustomizationRevisions Lists the revisions of the discovery customization of the infra-env, newest first.*/
+	ListDiscoveryCustomizationRevisions(ctx context.Context, params *ListDiscoveryCustomizationRevisionsParams) (*ListDiscoveryCustomizationRevisionsOK, error)
 	/*
 	   ListInfraEnvs Retrieves the list of infra-envs.*/
 	ListInfraEnvs(ctx context.Context, params *ListInfraEnvsParams) (*ListInfraEnvsOK, error)
@@ -139,9 +142,15 @@ type API interface {
 	/*
 	   V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error*/
 	V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitionParams) (*V2GetHostIgnitionOK, error)
+	/*
+	   V2GetHostIgnitionPreview Returns the pointer ignition of the host merged with its ignition config overrides, with secrets redacted, and the content generated by the service that the overrides replace.*/
+	V2GetHostIgnitionPreview(ctx context.Context, params *V2GetHostIgnitionPreviewParams) (*V2GetHostIgnitionPreviewOK, error)
 	/*
 	   V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.*/
 	V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnoredValidationsParams) (*V2GetIgnoredValidationsOK, error)
+	/*
+	   V2GetInfraEnvIgnitionPreview Returns the discovery ignition of the infra-env merged with its ignition config override, with secrets redacted, and the content generated by the service that the override replaces.*/
+	V2GetInfraEnvIgnitionPreview(ctx context.Context, params *V2GetInfraEnvIgnitionPreviewParams) (*V2GetInfraEnvIgnitionPreviewOK, error)
 	/*
 	   V2GetNextSteps Retrieves the next operations that the host agent needs to perform.*/
 	V2GetNextSteps(ctx context.Context, params *V2GetNextStepsParams) (*V2GetNextStepsOK, error)
@@ -160,6 +169,9 @@ type API interface {
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
+	/*
+	   V2ListDownloadAuditRecords Lists the recorded downloads of host ignitions, cluster ignitions and cluster credentials. Available to admin users only.*/
+	V2ListDownloadAuditRecords(ctx context.Context, params *V2ListDownloadAuditRecordsParams) (*V2ListDownloadAuditRecordsOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
@@ -175,6 +187,9 @@ type API interface {
 	/*
 	   V2RegisterHost Registers a new OpenShift agent.*/
 	V2RegisterHost(ctx context.Context, params *V2RegisterHostParams) (*V2RegisterHostCreated, error)
+	/*
+	   V2RenderClusterManifests Renders the manifests that the installer would use for the cluster installation, with the custom manifests, manifest patches and operator manifests applied. The cluster is not modified.*/
+	V2RenderClusterManifests(ctx context.Context, params *V2RenderClusterManifestsParams) (*V2RenderClusterManifestsOK, error)
 	/*
 	   V2ResetCluster Resets a failed installation.*/
 	V2ResetCluster(ctx context.Context, params *V2ResetClusterParams) (*V2ResetClusterAccepted, error)
@@ -216,6 +231,9 @@ type API interface {
 	/*
 	   V2UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	V2UploadClusterIngressCert(ctx context.Context, params *V2UploadClusterIngressCertParams) (*V2UploadClusterIngressCertCreated, error)
+	/*
+	   V2VerifyHostIgnition Verifies that the ignition served to the host matches the ignition that was generated for it, and returns the inputs it was generated with.*/
+	V2VerifyHostIgnition(ctx context.Context, params *V2VerifyHostIgnitionParams) (*V2VerifyHostIgnitionOK, error)
 }
 
 // New creates a new installer API client.
@@ -519,6 +537,31 @@ func (a *Client) ListClusterHosts(ctx context.Context, params *ListClusterHostsP
 
 }
 
+/*
+ListDiscoveryCustomizationRevisions Lists the revisions of the discovery customization of the infra-env, newest first.
+*/
+func (a *Client) ListDiscoveryCustomizationRevisions(ctx context.Context, params *ListDiscoveryCustomizationRevisionsParams) (*ListDiscoveryCustomizationRevisionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListDiscoveryCustomizationRevisions",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/discovery-customization-revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListDiscoveryCustomizationRevisionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListDiscoveryCustomizationRevisionsOK), nil
+
+}
+
 /*
 ListInfraEnvs Retrieves the list of infra-envs.
 */
@@ -1219,6 +1262,31 @@ func (a *Client) V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitio
 
 }
 
+/*
+V2GetHostIgnitionPreview Returns the pointer ignition of the host merged with its ignition config overrides, with secrets redacted, and the content generated by the service that the overrides replace.
+*/
+func (a *Client) V2GetHostIgnitionPreview(ctx context.Context, params *V2GetHostIgnitionPreviewParams) (*V2GetHostIgnitionPreviewOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetHostIgnitionPreview",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetHostIgnitionPreviewReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetHostIgnitionPreviewOK), nil
+
+}
+
 /*
 V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.
 */
@@ -1244,6 +1312,31 @@ func (a *Client) V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnor
 
 }
 
+/*
+V2GetInfraEnvIgnitionPreview Returns the discovery ignition of the infra-env merged with its ignition config override, with secrets redacted, and the content generated by the service that the override replaces.
+*/
+func (a *Client) V2GetInfraEnvIgnitionPreview(ctx context.Context, params *V2GetInfraEnvIgnitionPreviewParams) (*V2GetInfraEnvIgnitionPreviewOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetInfraEnvIgnitionPreview",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/ignition-preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetInfraEnvIgnitionPreviewReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetInfraEnvIgnitionPreviewOK), nil
+
+}
+
 /*
 V2GetNextSteps Retrieves the next operations that the host agent needs to perform.
 */
@@ -1394,6 +1487,31 @@ func (a *Client) V2ListClusters(ctx context.Context, params *V2ListClustersParam
 
 }
 
+/*
+V2ListDownloadAuditRecords Lists the recorded downloads of host ignitions, cluster ignitions and cluster credentials. Available to admin users only.
+*/
+func (a *Client) V2ListDownloadAuditRecords(ctx context.Context, params *V2ListDownloadAuditRecordsParams) (*V2ListDownloadAuditRecordsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListDownloadAuditRecords",
+		Method:             "GET",
+		PathPattern:        "/v2/download-audit-records",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListDownloadAuditRecordsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListDownloadAuditRecordsOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
@@ -1519,6 +1637,31 @@ func (a *Client) V2RegisterHost(ctx context.Context, params *V2RegisterHostParam
 
 }
 
+/*
+V2RenderClusterManifests Renders the manifests that the installer would use for the cluster installation, with the custom manifests, manifest patches and operator manifests applied. The cluster is not modified.
+*/
+func (a *Client) V2RenderClusterManifests(ctx context.Context, params *V2RenderClusterManifestsParams) (*V2RenderClusterManifestsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RenderClusterManifests",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/manifests/rendered",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RenderClusterManifestsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RenderClusterManifestsOK), nil
+
+}
+
 /*
 V2ResetCluster Resets a failed installation.
 */
@@ -1845,3 +1988,28 @@ func (a *Client) V2UploadClusterIngressCert(ctx context.Context, params *V2Uploa
 	return result.(*V2UploadClusterIngressCertCreated), nil
 
 }
+
+/*
+V2VerifyHostIgnition Verifies that the ignition served to the host matches the ignition that was generated for it, and returns the inputs it was generated with.
+*/
+func (a *Client) V2VerifyHostIgnition(ctx context.Context, params *V2VerifyHostIgnitionParams) (*V2VerifyHostIgnitionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2VerifyHostIgnition",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-verification",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2VerifyHostIgnitionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2VerifyHostIgnitionOK), nil
+
+}
@@ -69,7 +69,7 @@ type V2DownloadInfraEnvFilesParams struct {
 
 	/* FileName.
 
-	   The file to be downloaded.
+	   The file to be downloaded. boot-artifacts-sha256sums lists the expected SHA-256 digests of the boot artifacts that are identical for every infra-env, in the sha256sum format.
 	*/
 	FileName string
 
@@ -83,13 +83,13 @@ type V2DownloadInfraEnvFilesParams struct {
 
 	/* IpxeScriptType.
 
-	   Specify the script type to be served for iPXE.
+	   Specify the script type to be served for iPXE or GRUB.
 	*/
 	IpxeScriptType *string
 
 	/* Mac.
 
-	   Mac address of the host running ipxe script.
+	   Mac address of the host running ipxe script or grub config.
 
 	   Format: mac
 	*/
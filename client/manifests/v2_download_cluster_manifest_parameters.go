@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewV2DownloadClusterManifestParams creates a new V2DownloadClusterManifestParams object,
@@ -61,6 +62,12 @@ V2DownloadClusterManifestParams contains all the parameters to send to the API e
 */
 type V2DownloadClusterManifestParams struct {
 
+	/* ButaneSource.
+
+	   Download the Butane config that the manifest was translated from instead of the manifest.
+	*/
+	ButaneSource *bool
+
 	/* ClusterID.
 
 	   The cluster whose manifest should be downloaded.
@@ -101,11 +108,14 @@ func (o *V2DownloadClusterManifestParams) WithDefaults() *V2DownloadClusterManif
 // All values with no default are reset to their zero value.
 func (o *V2DownloadClusterManifestParams) SetDefaults() {
 	var (
+		butaneSourceDefault = bool(false)
+
 		folderDefault = string("manifests")
 	)
 
 	val := V2DownloadClusterManifestParams{
-		Folder: &folderDefault,
+		ButaneSource: &butaneSourceDefault,
+		Folder:       &folderDefault,
 	}
 
 	val.timeout = o.timeout
@@ -147,6 +157,17 @@ func (o *V2DownloadClusterManifestParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithButaneSource adds the butaneSource to the v2 download cluster manifest params
+func (o *V2DownloadClusterManifestParams) WithButaneSource(butaneSource *bool) *V2DownloadClusterManifestParams {
+	o.SetButaneSource(butaneSource)
+	return o
+}
+
+// SetButaneSource adds the butaneSource to the v2 download cluster manifest params
+func (o *V2DownloadClusterManifestParams) SetButaneSource(butaneSource *bool) {
+	o.ButaneSource = butaneSource
+}
+
 // WithClusterID adds the clusterID to the v2 download cluster manifest params
 func (o *V2DownloadClusterManifestParams) WithClusterID(clusterID strfmt.UUID) *V2DownloadClusterManifestParams {
 	o.SetClusterID(clusterID)
@@ -188,6 +209,23 @@ func (o *V2DownloadClusterManifestParams) WriteToRequest(r runtime.ClientRequest
 	}
 	var res []error
 
+	if o.ButaneSource != nil {
+
+		// query param butane_source
+		var qrButaneSource bool
+
+		if o.ButaneSource != nil {
+			qrButaneSource = *o.ButaneSource
+		}
+		qButaneSource := swag.FormatBool(qrButaneSource)
+		if qButaneSource != "" {
+
+			if err := r.SetQueryParam("butane_source", qButaneSource); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
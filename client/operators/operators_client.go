@@ -22,6 +22,9 @@ type API interface {
 
 	   Retrieves an array of operator properties for the specified bundle when some features are activated.*/
 	V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V2GetBundleOK, error)
+	/*
+	   V2InstallOperators Installs OLM operators in an installed cluster, and monitors them until they are available.*/
+	V2InstallOperators(ctx context.Context, params *V2InstallOperatorsParams) (*V2InstallOperatorsAccepted, error)
 	/*
 	   V2ListBundles gets list of available bundles
 
@@ -36,6 +39,16 @@ type API interface {
 	/*
 	   V2ListSupportedOperators Retrieves the list of supported operators.*/
 	V2ListSupportedOperators(ctx context.Context, params *V2ListSupportedOperatorsParams) (*V2ListSupportedOperatorsOK, error)
+	/*
+	   V2PlanCapacity plans the hosts needed to run a set of operators
+
+	   Finds the smallest layout of hosts, among the given host profiles, that satisfies the requirements of OpenShift and of the operators, without a cluster.*/
+	V2PlanCapacity(ctx context.Context, params *V2PlanCapacityParams) (*V2PlanCapacityOK, error)
+	/*
+	   V2ResolveOperators explains the resolution of a set of operators
+
+	   Resolves the dependencies of the requested operators and explains why each operator is part of the result, which constraints conflict with them and the resulting host requirements.*/
+	V2ResolveOperators(ctx context.Context, params *V2ResolveOperatorsParams) (*V2ResolveOperatorsOK, error)
 	/*
 	   V2ReportMonitoredOperatorStatus Controller API to report of monitored operators.*/
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params *V2ReportMonitoredOperatorStatusParams) (*V2ReportMonitoredOperatorStatusOK, error)
@@ -86,6 +99,31 @@ func (a *Client) V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V
 
 }
 
+/*
+V2InstallOperators Installs OLM operators in an installed cluster, and monitors them until they are available.
+*/
+func (a *Client) V2InstallOperators(ctx context.Context, params *V2InstallOperatorsParams) (*V2InstallOperatorsAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2InstallOperators",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/install-operators",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2InstallOperatorsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2InstallOperatorsAccepted), nil
+
+}
+
 /*
 V2ListBundles gets list of available bundles
 
@@ -188,6 +226,60 @@ func (a *Client) V2ListSupportedOperators(ctx context.Context, params *V2ListSup
 
 }
 
+/*
+V2PlanCapacity plans the hosts needed to run a set of operators
+
+Finds the smallest layout of hosts, among the given host profiles, that satisfies the requirements of OpenShift and of the operators, without a cluster.
+*/
+func (a *Client) V2PlanCapacity(ctx context.Context, params *V2PlanCapacityParams) (*V2PlanCapacityOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2PlanCapacity",
+		Method:             "POST",
+		PathPattern:        "/v2/operators/capacity-plan",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2PlanCapacityReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2PlanCapacityOK), nil
+
+}
+
+/*
+V2ResolveOperators explains the resolution of a set of operators
+
+Resolves the dependencies of the requested operators and explains why each operator is part of the result, which constraints conflict with them and the resulting host requirements.
+*/
+func (a *Client) V2ResolveOperators(ctx context.Context, params *V2ResolveOperatorsParams) (*V2ResolveOperatorsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ResolveOperators",
+		Method:             "GET",
+		PathPattern:        "/v2/operators/resolution",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ResolveOperatorsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ResolveOperatorsOK), nil
+
+}
+
 /*
 V2ReportMonitoredOperatorStatus Controller API to report of monitored operators.
 */
@@ -20,6 +20,7 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/system"
 	"github.com/openshift/assisted-service/models"
@@ -447,7 +448,7 @@ func processNMStateConfig(log log.FieldLogger, infraEnv aiv1beta1.InfraEnv, nmSt
 
 	var staticNetworkConfig []*models.HostStaticNetworkConfig
 	staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
-		MacInterfaceMap: controllers.BuildMacInterfaceMap(log, nmStateConfig),
+		MacInterfaceMap: network.BuildMacInterfaceMap(log, nmStateConfig),
 		NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
 	})
 	return staticNetworkConfig, nil
@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/assisted-service/internal/controller/controllers"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
+	"github.com/openshift/assisted-service/internal/encryption"
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
@@ -49,7 +50,9 @@ import (
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
 	"github.com/openshift/assisted-service/internal/operators/handler"
+	"github.com/openshift/assisted-service/internal/operators/planner"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/releasesources"
 	"github.com/openshift/assisted-service/internal/spec"
@@ -117,6 +120,7 @@ var Options struct {
 	Auth                                 auth.Config
 	BMConfig                             bminventory.Config
 	DBConfig                             dbPkg.Config
+	EncryptionConfig                     encryption.Config
 	HWValidatorConfig                    hardware.ValidatorCfg
 	GeneratorConfig                      generator.Config
 	InstructionConfig                    hostcommands.InstructionConfig
@@ -128,8 +132,11 @@ var Options struct {
 	ClusterStateMonitorInterval          time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	ClusterEventsUploaderInterval        time.Duration `envconfig:"CLUSTER_EVENTS_UPLOADER_INTERVAL" default:"15m"`
 	EventRateLimits                      string        `envconfig:"EVENT_RATE_LIMITS" default:""`
+	TrustedProxies                       string        `envconfig:"TRUSTED_PROXIES" default:""`
 	S3Config                             s3wrapper.Config
 	HostStateMonitorInterval             time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
+	Day2OperatorsMonitorInterval         time.Duration `envconfig:"DAY2_OPERATORS_MONITOR_INTERVAL" default:"30s"`
+	ManifestsLintBackfillInterval        time.Duration `envconfig:"MANIFESTS_LINT_BACKFILL_INTERVAL" default:"5m"`
 	Versions                             versions.Versions
 	EnableImageService                   bool          `envconfig:"ENABLE_IMAGE_SERVICE" default:"true"`
 	OsImages                             string        `envconfig:"OS_IMAGES" default:""`
@@ -173,6 +180,7 @@ var Options struct {
 	WorkDir                              string        `envconfig:"WORK_DIR" default:"/data/"`
 	LivenessValidationTimeout            time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	ApproveCsrsRequeueDuration           time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
+	SpokeHealthCheckInterval             time.Duration `envconfig:"SPOKE_HEALTH_CHECK_INTERVAL" default:"10m"`
 	HTTPListenPort                       string        `envconfig:"HTTP_LISTEN_PORT" default:""`
 	AllowConvergedFlow                   bool          `envconfig:"ALLOW_CONVERGED_FLOW" default:"true"`
 	EnableMetal3                         bool          `envconfig:"ENABLE_METAL3" default:"true"`
@@ -341,6 +349,7 @@ func startKubeAPIControllers(
 		VersionsHandler:               versionHandler,
 		SpokeK8sClientFactory:         spokeClientFactory,
 		MirrorRegistriesConfigBuilder: mirrorregistries.New(Options.ForceInsecurePolicyJson),
+		SpokeHealthCheckInterval:      Options.SpokeHealthCheckInterval,
 	}).SetupWithManager(ctrlMgr), "unable to create controller ClusterDeployment")
 
 	failOnError((&controllers.AgentReconciler{
@@ -358,6 +367,7 @@ func startKubeAPIControllers(
 		HostFSMountDir:             hostFSMountDir,
 		ImageServiceEnabled:        Options.EnableImageService,
 		EnableMetal3:               Options.EnableMetal3,
+		SpokeHealthCheckInterval:   Options.SpokeHealthCheckInterval,
 	}).SetupWithManager(ctrlMgr), "unable to create controller Agent")
 
 	if Options.EnableMetal3 && Options.EnableImageService {
@@ -425,6 +435,7 @@ func main() {
 	}
 
 	port := flag.String("port", "8090", "define port that the service will listen to")
+	rotateKeys := flag.Bool("rotate-encryption-keys", false, "re-encrypt the sensitive data with the active encryption key and exit")
 	flag.Parse()
 
 	log.Println("Starting bm service")
@@ -445,6 +456,9 @@ func main() {
 			"Failed to parse RELEASE_SOURCES json %s", Options.ReleaseSourcesConfig.ReleaseSources)
 	}
 
+	keyring, err := encryption.LoadKeyring(Options.EncryptionConfig)
+	failOnError(err, "Failed to load encryption keys")
+
 	var ignoredOpenshiftVersions = []string{}
 	versions.ParseIgnoredOpenshiftVersions(&ignoredOpenshiftVersions, Options.IgnoredOpenshiftVersions, failOnError)
 
@@ -469,7 +483,7 @@ func main() {
 	}
 
 	// Connect to db
-	db := setupDB(log, slowQueryConfig)
+	db := setupDB(log, slowQueryConfig, keyring)
 	defer common.CloseDB(db)
 
 	ctrlMgr, err := createControllerManager()
@@ -554,10 +568,23 @@ func main() {
 
 	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config,
 		Options.WorkDir, log, metricsManager, Options.FileSystemUsageThreshold, xattrClient)
+	var encryptedObjectHandler *s3wrapper.EncryptedClient
+	if keyring != nil {
+		encryptedObjectHandler = s3wrapper.NewEncryptedClient(objectHandler, keyring, Options.BMConfig.ServiceBaseURL,
+			log.WithField("pkg", "s3wrapper"))
+		objectHandler = encryptedObjectHandler
+	}
 	createS3Bucket(objectHandler, log)
 
+	if *rotateKeys {
+		failOnError(rotateEncryptionKeys(db, encryptedObjectHandler, keyring, log), "Failed to rotate encryption keys")
+		log.Info("Finished rotating encryption keys")
+		return
+	}
+
 	manifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "manifests"), objectHandler, usageManager)
-	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, objectHandler)
+	operatorsManager, err := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, objectHandler)
+	failOnError(err, "failed to create the operators manager")
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager, providerRegistry)
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	Options.InstructionConfig.HostFSMountDir = hostFSMountDir
@@ -657,6 +684,21 @@ func main() {
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
+	day2SpokeClientFactory, err := spoke_k8s_client.NewFactory(log, nil, sys)
+	failOnError(err, "failed to create spoke client factory")
+	day2OperatorsInstaller := day2.NewInstaller(log.WithField("pkg", "day2-operators"), db, operatorsManager, hwValidator, objectHandler,
+		day2SpokeClientFactory, eventsHandler, lead)
+	day2OperatorsMonitor := thread.New(
+		log.WithField("pkg", "day2-operators-monitor"), "Day2 Operators Monitor", Options.Day2OperatorsMonitorInterval, day2OperatorsInstaller.Monitor)
+	day2OperatorsMonitor.Start()
+	defer day2OperatorsMonitor.Stop()
+
+	manifestsLintBackfill := thread.New(
+		log.WithField("pkg", "manifests-lint-backfill"), "Manifests Lint Backfill", Options.ManifestsLintBackfillInterval,
+		manifests.NewLintBackfill(manifestsApi, lead).Run)
+	manifestsLintBackfill.Start()
+	defer manifestsLintBackfill.Stop()
+
 	failOnError(
 		versions.AddReleaseImagesToDBIfNeeded(db, releaseImagesArray, startupLeader, log, Options.EnableKubeAPI, Options.ReleaseSourcesConfig.ReleaseSources),
 		"error occurred while adding configuration release images to the DB if needed",
@@ -745,13 +787,16 @@ func main() {
 		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker, disconnectedIgnitionGenerator)
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"), db, metricsManager)
 
+	trustedProxies, err := paramctx.ParseTrustedProxies(Options.TrustedProxies)
+	failOnError(err, "Failed to parse TRUSTED_PROXIES %s", Options.TrustedProxies)
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
 			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
 
 			wrapped = slowquery.Middleware(slowQueryConfig)(wrapped)
-			wrapped = paramctx.ContextHandler()(wrapped)
+			wrapped = paramctx.ContextHandler(trustedProxies...)(wrapped)
 			return wrapped
 		}
 	}
@@ -761,7 +806,8 @@ func main() {
 		jsonConsumer = internaljson.UnknownFieldsRejectingConsumer()
 	}
 
-	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi, day2OperatorsInstaller,
+		day2OperatorsInstaller, planner.NewPlanner(log.WithField("pkg", "capacity-planner"), operatorsManager, hwValidator))
 	h, api, err := restapi.HandlerAPI(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
@@ -791,6 +837,10 @@ func main() {
 		h = app.SetupCORSMiddleware(h, allowedDomains)
 	}
 
+	if encryptedObjectHandler != nil {
+		h = encryptedObjectHandler.DownloadMiddleware(h)
+	}
+
 	h = gziphandler.GzipHandler(h)
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h, []*thread.Thread{hostStateMonitor, clusterStateMonitor},
@@ -838,7 +888,7 @@ func applyClusterTLSConfig(log *logrus.Logger, serverInfo *servers.ServerInfo) {
 	log.Infof("Applied cluster TLS profile: MinVersion=0x%04x, CipherSuites=%d", tlsCfg.MinVersion, len(tlsCfg.CipherSuites))
 }
 
-func setupDB(log logrus.FieldLogger, slowQueryConfig slowquery.Config) *gorm.DB {
+func setupDB(log logrus.FieldLogger, slowQueryConfig slowquery.Config, keyring *encryption.Keyring) *gorm.DB {
 	dbConnectionStr, validationErr := Options.DBConfig.LibpqDSN()
 	if validationErr != nil {
 		log.WithError(validationErr).Fatal("Invalid DB connection config")
@@ -859,6 +909,9 @@ func setupDB(log logrus.FieldLogger, slowQueryConfig slowquery.Config) *gorm.DB
 			log.WithError(err).Info("Failed to connect to DB, retrying")
 			return
 		}
+		if err = db.Use(encryption.NewPlugin(keyring)); err != nil {
+			log.WithError(err).Fatal("Failed to register DB encryption plugin")
+		}
 		sqlDB, err := db.DB()
 		if err != nil {
 			log.WithError(err).Info("Failed to get sqlDB, retrying")
@@ -927,6 +980,31 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 	return storageClient
 }
 
+// rotateEncryptionKeys re-encrypts the sensitive database columns and objects
+// that are stored in plaintext or encrypted with a key other than the active one
+func rotateEncryptionKeys(db *gorm.DB, objectHandler *s3wrapper.EncryptedClient, keyring *encryption.Keyring, log logrus.FieldLogger) error {
+	if keyring == nil {
+		return errors.New("ENCRYPTION_KEYS_PATH must be set in order to rotate encryption keys")
+	}
+	ctx := context.Background()
+	log.Infof("Re-encrypting sensitive data with encryption key %s", keyring.ActiveKeyID())
+	if err := encryption.ReencryptColumns(ctx, db, keyring, log, 500, common.EncryptedModels()...); err != nil {
+		return err
+	}
+	// Deleted clusters are included, as their objects are kept until they are
+	// garbage collected
+	var clusterIDs []string
+	if err := db.Unscoped().Model(&common.Cluster{}).Pluck("id", &clusterIDs).Error; err != nil {
+		return errors.Wrap(err, "failed to list clusters")
+	}
+	count, err := objectHandler.ReencryptObjects(ctx, clusterIDs)
+	if err != nil {
+		return err
+	}
+	log.Infof("Re-encrypted %d objects", count)
+	return nil
+}
+
 func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.DB, log logrus.FieldLogger) error {
 	return migrationLeader.RunWithLeader(context.Background(), func() error {
 		log.Infof("Starting manual pre migrations")
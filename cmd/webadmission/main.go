@@ -23,6 +23,7 @@ func main() {
 		agentinstallvalidatingwebhooks.NewInfraEnvValidatingAdmissionHook(decoder),
 		agentinstallvalidatingwebhooks.NewAgentValidatingAdmissionHook(decoder),
 		agentinstallvalidatingwebhooks.NewAgentClassificationValidatingAdmissionHook(decoder),
+		agentinstallvalidatingwebhooks.NewNMStateConfigValidatingAdmissionHook(decoder),
 
 		//mutating webhooks
 		hiveextwebhooks.NewAgentClusterInstallMutatingAdmissionHook(decoder),
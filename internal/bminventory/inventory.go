@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5" // #nosec
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,6 +18,7 @@ import (
 	"strings"
 	"time"
 
+	openapiruntime "github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -64,6 +67,7 @@ import (
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/transaction"
@@ -129,6 +133,9 @@ type Config struct {
 	ForceInsecurePolicyJson             bool              `envconfig:"FORCE_INSECURE_POLICY_JSON" default:"false"`
 	EnableImageService                  bool              `envconfig:"ENABLE_IMAGE_SERVICE" default:"true"`
 	NetworkDiscoveryDelaySeconds        int64             `envconfig:"NETWORK_DISCOVERY_DELAY_SECONDS" default:"0"`
+	BlockShadowingIgnitionOverrides     bool              `envconfig:"BLOCK_SHADOWING_IGNITION_OVERRIDES" default:"false"`
+	HostIgnitionDownloadOnce            bool              `envconfig:"HOST_IGNITION_DOWNLOAD_ONCE" default:"false"`
+	IPXEVerifyKernelSignature           bool              `envconfig:"IPXE_VERIFY_KERNEL_SIGNATURE" default:"false"`
 
 	// InfraEnv ID for the ephemeral installer. Should not be set explicitly.Ephemeral (agent) installer sets this env var
 	InfraEnvID strfmt.UUID `envconfig:"INFRA_ENV_ID" default:""`
@@ -158,6 +165,7 @@ type InstallerInternals interface {
 	RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error)
 	GetClusterInternal(ctx context.Context, params installer.V2GetClusterParams) (*common.Cluster, error)
 	UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error)
+	PreviewClusterUpdateInternal(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error)
 	GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error)
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error)
@@ -577,12 +585,22 @@ func (b *bareMetalInventory) getOLMMonitoredOperators(log *logrus.Entry, cluster
 		return nil, err
 	}
 
+	if err = b.operatorManagerApi.EnsureOperatorProperties(cluster, newOLMOperators); err != nil {
+		log.Error(err)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	err = b.operatorManagerApi.EnsureOperatorPrerequisite(cluster, releaseImageVersion, params.NewClusterParams.CPUArchitecture, newOLMOperators)
 	if err != nil {
 		log.Error(err)
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = b.operatorManagerApi.SetOperatorPropertiesDefaults(cluster, newOLMOperators); err != nil {
+		log.Error(err)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	return newOLMOperators, nil
 }
 
@@ -1391,6 +1409,10 @@ func (b *bareMetalInventory) getIgnitionConfigForLogging(ctx context.Context, in
 
 	msgDetails = append(msgDetails, sshExtra)
 
+	if infraEnv.DiscoveryCustomization != nil {
+		msgDetails = append(msgDetails, fmt.Sprintf("discovery customization revision is %d", infraEnv.DiscoveryCustomizationRevision))
+	}
+
 	return strings.Join(msgDetails, ", ")
 }
 
@@ -1972,6 +1994,24 @@ func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string
 }
 
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster, clusterInfraenvs []*common.InfraEnv) error {
+	log := logutil.FromContext(ctx, b.log)
+	cfg, releaseImage, installerReleaseImageOverride, err := b.getInstallConfigAndReleaseImages(ctx, cluster, clusterInfraenvs)
+	if err != nil {
+		return err
+	}
+
+	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, b.ForceInsecurePolicyJson); err != nil {
+		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
+		log.WithError(err).Error(msg)
+		return errors.Wrap(err, msg)
+	}
+
+	return nil
+}
+
+// getInstallConfigAndReleaseImages returns the install config of the cluster, its release image and the release
+// image to extract the installer from, if it differs from the cluster release image
+func (b *bareMetalInventory) getInstallConfigAndReleaseImages(ctx context.Context, cluster common.Cluster, clusterInfraenvs []*common.InfraEnv) ([]byte, string, string, error) {
 	log := logutil.FromContext(ctx, b.log)
 	rhRootCa := ignition.RedhatRootCA
 	if !b.Config.InstallRHCa {
@@ -1981,14 +2021,14 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, clusterInfraenvs, rhRootCa)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
-		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
+		return nil, "", "", errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 
 	releaseImage, err := b.versionsHandler.GetReleaseImage(ctx, cluster.OpenshiftVersion, cluster.CPUArchitecture, cluster.PullSecret)
 	if err != nil {
 		msg := fmt.Sprintf("failed to get OpenshiftVersion for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
 		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
+		return nil, "", "", errors.Wrap(err, msg)
 	}
 
 	installerReleaseImageOverride := ""
@@ -1998,20 +2038,14 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 			msg := fmt.Sprintf("failed to get image for installer image override "+
 				"for cluster %s with openshift version %s and %s arch", cluster.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture)
 			log.WithError(err).Error(msg)
-			return errors.Wrap(err, msg)
+			return nil, "", "", errors.Wrap(err, msg)
 		}
 		log.Infof("Overriding %s baremetal installer image image: %s with %s: %s", cluster.CPUArchitecture,
 			*releaseImage.URL, common.DefaultCPUArchitecture, *defaultArchImage.URL)
 		installerReleaseImageOverride = *defaultArchImage.URL
 	}
 
-	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, *releaseImage.URL, installerReleaseImageOverride, b.ForceInsecurePolicyJson); err != nil {
-		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
-		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
-	}
-
-	return nil
+	return cfg, *releaseImage.URL, installerReleaseImageOverride, nil
 }
 
 func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
@@ -2239,6 +2273,44 @@ func (b *bareMetalInventory) UpdateClusterNonInteractive(ctx context.Context, pa
 	return b.v2UpdateClusterInternal(ctx, params, NonInteractive, mirrorRegistryConfiguration)
 }
 
+// errClusterUpdatePreview is used to roll back the transaction of a cluster update preview
+var errClusterUpdatePreview = errors.New("cluster update preview")
+
+// PreviewClusterUpdateInternal validates and applies the update like UpdateClusterNonInteractive, but in a transaction
+// that is always rolled back. It returns the cluster as it would be after the update, with its validations info
+// recalculated for the updated cluster.
+func (b *bareMetalInventory) PreviewClusterUpdateInternal(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster *common.Cluster
+	log.Infof("preview update of cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
+
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if _, _, _, err = b.applyClusterUpdate(ctx, tx, log, params, NonInteractive, mirrorRegistryConfiguration); err != nil {
+			return err
+		}
+		if cluster, err = common.GetClusterFromDBWithHosts(tx, params.ClusterID); err != nil {
+			log.WithError(err).Errorf("failed to get cluster %s after update preview", params.ClusterID)
+			return err
+		}
+		validationRes, err := b.clusterApi.PreviewValidations(ctx, cluster, tx)
+		if err != nil {
+			log.WithError(err).Errorf("failed to calculate validations of cluster %s for update preview", params.ClusterID)
+			return err
+		}
+		validationsInfo, err := json.Marshal(validationRes)
+		if err != nil {
+			return err
+		}
+		cluster.ValidationsInfo = string(validationsInfo)
+		return errClusterUpdatePreview
+	})
+	if err != nil && !errors.Is(err, errClusterUpdatePreview) {
+		return nil, err
+	}
+	return cluster, nil
+}
+
 func getPlatformType(platform *models.Platform) string {
 	if platform != nil && platform.Type != nil {
 		return string(*platform.Type)
@@ -2331,43 +2403,11 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	log := logutil.FromContext(ctx, b.log)
 	var cluster *common.Cluster
 	var err error
-	var primaryIPStackUpdated bool
-	var primaryIPStack *common.PrimaryIPStack
 	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
 	err = b.db.Transaction(func(tx *gorm.DB) error {
-		// in case host monitor already updated the state we need to use FOR UPDATE option
-		if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-			log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
-			return common.NewApiError(http.StatusNotFound, err)
-		}
-
-		// compute PrimaryIPStack before validations (it’s needed by some validations)
-		// if the value changed, we set the updated PrimaryIPStack to the cluster object and to the DB later in updateClusterData.
-		primaryIPStackUpdated, primaryIPStack, err = b.updatePrimaryIPStack(params, cluster)
-		if err != nil {
-			return err
-		}
-
-		params, err = b.validateUpdateCluster(ctx, log, cluster, params, primaryIPStack)
-		if err != nil {
-			return err
-		}
-
 		var usages usage.FeatureUsage
-		usages, err = usage.Unmarshal(cluster.Cluster.FeatureUsage)
-		if err != nil {
-			log.WithError(err).Errorf("failed to read feature usage from cluster %s", params.ClusterID)
-			return err
-		}
-
-		err = b.updateClusterData(ctx, cluster, params, usages, tx, log, interactivity, mirrorRegistryConfiguration, primaryIPStackUpdated, primaryIPStack)
-		if err != nil {
-			log.WithError(err).Error("updateClusterData")
-			return err
-		}
-
-		err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
+		cluster, params, usages, err = b.applyClusterUpdate(ctx, tx, log, params, interactivity, mirrorRegistryConfiguration)
 		if err != nil {
 			return err
 		}
@@ -2427,6 +2467,49 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	return cluster, nil
 }
 
+// applyClusterUpdate validates the update params and writes the updated cluster and operators data in the given transaction.
+// It returns the params as completed by the validation.
+func (b *bareMetalInventory) applyClusterUpdate(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger, params installer.V2UpdateClusterParams,
+	interactivity Interactivity, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, installer.V2UpdateClusterParams, usage.FeatureUsage, error) {
+	// in case host monitor already updated the state we need to use FOR UPDATE option
+	cluster, err := common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
+		return nil, params, nil, common.NewApiError(http.StatusNotFound, err)
+	}
+
+	// compute PrimaryIPStack before validations (it’s needed by some validations)
+	// if the value changed, we set the updated PrimaryIPStack to the cluster object and to the DB later in updateClusterData.
+	primaryIPStackUpdated, primaryIPStack, err := b.updatePrimaryIPStack(params, cluster)
+	if err != nil {
+		return nil, params, nil, err
+	}
+
+	params, err = b.validateUpdateCluster(ctx, log, cluster, params, primaryIPStack)
+	if err != nil {
+		return nil, params, nil, err
+	}
+
+	usages, err := usage.Unmarshal(cluster.Cluster.FeatureUsage)
+	if err != nil {
+		log.WithError(err).Errorf("failed to read feature usage from cluster %s", params.ClusterID)
+		return nil, params, nil, err
+	}
+
+	err = b.updateClusterData(ctx, cluster, params, usages, tx, log, interactivity, mirrorRegistryConfiguration, primaryIPStackUpdated, primaryIPStack)
+	if err != nil {
+		log.WithError(err).Error("updateClusterData")
+		return nil, params, nil, err
+	}
+
+	err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
+	if err != nil {
+		return nil, params, nil, err
+	}
+
+	return cluster, params, usages, nil
+}
+
 func (b *bareMetalInventory) integrateWithAMSClusterUpdateName(ctx context.Context, cluster *common.Cluster, newClusterName string) error {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Updating AMS subscription for cluster %s with new name %s", *cluster.ID, newClusterName)
@@ -3405,6 +3488,12 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 		return err
 	}
 
+	// Only the properties of the operators that are added, or whose properties are updated, are validated
+	if err = b.operatorManagerApi.EnsureOperatorProperties(cluster, updateOLMOperators); err != nil {
+		log.Error(err)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	infraEnvs, err := b.ListInfraEnvsInternal(ctx, cluster.ID, nil)
 	if err != nil {
 		return err
@@ -3426,6 +3515,11 @@ func (b *bareMetalInventory) updateOperatorsData(ctx context.Context, cluster *c
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = b.operatorManagerApi.SetOperatorPropertiesDefaults(cluster, updateOLMOperators); err != nil {
+		log.Error(err)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	for _, updatedOperator := range updateOLMOperators {
 		updatedOperator.ClusterID = *cluster.ID
 		if err = db.Save(updatedOperator).Error; err != nil {
@@ -3520,6 +3614,8 @@ func (b *bareMetalInventory) getOLMOperators(cluster *common.Cluster, newOperato
 		}
 
 		operator.Properties = newOperator.Properties
+		operator.Subscription = newOperator.Subscription
+		operators.SetFailurePolicy(operator, newOperator)
 		if bundleIDs, ok := sourceBundlesMap[newOperator.Name]; ok {
 			operator.SourceBundles = bundleIDs
 		}
@@ -4306,7 +4402,10 @@ func (b *bareMetalInventory) V2GetPresignedForClusterFiles(ctx context.Context,
 	url, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, fullFileName, downloadFilename, duration)
 	if err != nil {
 		log.WithError(err).Errorf("failed to generate presigned URL: %s from cluster: %s", params.FileName, params.ClusterID.String())
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusInternalServerError)
+	}
+	if err = b.auditPresignedDownload(ctx, params.ClusterID, params.FileName); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 	return installer.NewV2GetPresignedForClusterFilesOK().WithPayload(&models.PresignedURL{URL: &url})
 }
@@ -4466,49 +4565,246 @@ func (b *bareMetalInventory) checkFileDownloadAccess(ctx context.Context, fileNa
 	return nil
 }
 
+// downloadAuditFileType returns the audited file type of a cluster file, or an
+// empty string if downloads of the file are not audited
+func downloadAuditFileType(fileName string) string {
+	switch {
+	case strings.HasSuffix(fileName, ".ign"):
+		return models.DownloadAuditRecordFileTypeClusterIgnition
+	case fileName == constants.Kubeconfig || fileName == constants.KubeconfigNoIngress:
+		return models.DownloadAuditRecordFileTypeKubeconfig
+	case fileName == constants.KubeadminPassword:
+		return models.DownloadAuditRecordFileTypeKubeadminPassword
+	default:
+		return ""
+	}
+}
+
+func newDownloadAuditRecord(ctx context.Context, fileType, fileName string, clusterID *strfmt.UUID, content []byte) *common.DownloadAuditRecord {
+	payload := ocm.PayloadFromContext(ctx)
+	principal := payload.Username
+	if principal == "" {
+		principal = payload.Email
+	}
+	var contentSha256 string
+	if content != nil {
+		contentHash := sha256.Sum256(content)
+		contentSha256 = hex.EncodeToString(contentHash[:])
+	}
+	downloadedAt := strfmt.DateTime(time.Now())
+	return &common.DownloadAuditRecord{
+		DownloadAuditRecord: models.DownloadAuditRecord{
+			ClusterID:     clusterID,
+			FileType:      swag.String(fileType),
+			FileName:      fileName,
+			Principal:     principal,
+			PrincipalRole: string(payload.Role),
+			OrgID:         payload.Organization,
+			SourceIP:      ctxparams.GetParam(ctx, ctxparams.SourceIP),
+			RequestID:     requestid.FromContext(ctx),
+			ContentSha256: contentSha256,
+			DownloadedAt:  &downloadedAt,
+		},
+	}
+}
+
+// auditDownload records the download in the audit table and streams the record
+func (b *bareMetalInventory) auditDownload(ctx context.Context, record *common.DownloadAuditRecord) error {
+	if err := b.db.Create(record).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to record download"))
+	}
+	b.notifyDownload(ctx, record)
+	return nil
+}
+
+func (b *bareMetalInventory) notifyDownload(ctx context.Context, record *common.DownloadAuditRecord) {
+	if err := b.stream.Notify(ctx, record); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warning("failed to notify download audit record")
+	}
+}
+
+// auditPresignedDownload records the issuing of a presigned download URL of an
+// audited cluster file. The content is served by the object store, so the
+// record has no content hash.
+func (b *bareMetalInventory) auditPresignedDownload(ctx context.Context, clusterID strfmt.UUID, fileName string) error {
+	fileType := downloadAuditFileType(fileName)
+	if fileType == "" {
+		return nil
+	}
+	return b.auditDownload(ctx, newDownloadAuditRecord(ctx, fileType, fileName, &clusterID, nil))
+}
+
+// auditClusterFileResponder responds with the content of a cluster file. The
+// download of audited files is recorded once the response was written, so
+// their content is read to hash it.
+func (b *bareMetalInventory) auditClusterFileResponder(ctx context.Context, clusterID strfmt.UUID, fileName string,
+	respBody io.ReadCloser, contentLength int64, newOK func(io.ReadCloser) middleware.Responder) middleware.Responder {
+	fileType := downloadAuditFileType(fileName)
+	if fileType == "" {
+		return filemiddleware.NewResponder(newOK(respBody), fileName, contentLength, nil)
+	}
+	defer respBody.Close()
+	content, err := io.ReadAll(respBody)
+	if err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to read %s", fileName)))
+	}
+	return &downloadAuditResponder{
+		b:      b,
+		ctx:    ctx,
+		record: newDownloadAuditRecord(ctx, fileType, fileName, &clusterID, content),
+		next:   filemiddleware.NewResponder(newOK(io.NopCloser(bytes.NewReader(content))), fileName, int64(len(content)), nil),
+	}
+}
+
+func newHostIgnitionDownloadAuditRecord(ctx context.Context, host *common.Host, fileName string, content []byte) *common.DownloadAuditRecord {
+	record := newDownloadAuditRecord(ctx, models.DownloadAuditRecordFileTypeHostIgnition, fileName, host.ClusterID, content)
+	record.HostID = host.ID
+	record.InfraEnvID = &host.InfraEnvID
+	return record
+}
+
+// isHostIgnitionDownloaded returns true if the ignition of the host was
+// already downloaded, either as a file or as JSON
+func isHostIgnitionDownloaded(db *gorm.DB, host *common.Host) (bool, error) {
+	var count int64
+	err := db.Model(&common.DownloadAuditRecord{}).
+		Where("host_id = ? and infra_env_id = ? and file_type in (?)", host.ID.String(), host.InfraEnvID.String(),
+			[]string{models.DownloadAuditRecordFileTypeHostIgnition, models.DownloadAuditRecordFileTypeHostIgnitionParams}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// checkHostIgnitionDownloadOnce fails when HostIgnitionDownloadOnce is set and
+// the ignition of the host was already downloaded
+func (b *bareMetalInventory) checkHostIgnitionDownloadOnce(host *common.Host) error {
+	if !b.HostIgnitionDownloadOnce {
+		return nil
+	}
+	downloaded, err := isHostIgnitionDownloaded(b.db, host)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if downloaded {
+		return errHostIgnitionDownloaded(host)
+	}
+	return nil
+}
+
+func errHostIgnitionDownloaded(host *common.Host) error {
+	return common.NewApiError(http.StatusConflict, errors.Errorf("the ignition of host %s was already downloaded", host.ID.String()))
+}
+
+// downloadAuditResponder records a download once its response was written, so
+// that failed responses aren't recorded. When once is set, the download is
+// recorded before the response is written instead: the host is locked only
+// while checking that its ignition wasn't downloaded yet and recording it, so
+// that concurrent downloads can't both pass the check.
+type downloadAuditResponder struct {
+	b      *bareMetalInventory
+	ctx    context.Context
+	record *common.DownloadAuditRecord
+	host   *common.Host
+	once   bool
+	next   middleware.Responder
+}
+
+func (r *downloadAuditResponder) WriteResponse(rw http.ResponseWriter, producer openapiruntime.Producer) {
+	if !r.once {
+		r.next.WriteResponse(rw, producer)
+		if err := r.b.auditDownload(r.ctx, r.record); err != nil {
+			logutil.FromContext(r.ctx, r.b.log).WithError(err).Errorf("failed to record the download of %s", r.record.FileName)
+		}
+		return
+	}
+
+	err := r.b.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := common.GetHostFromDB(transaction.AddForUpdateQueryOption(tx), r.host.InfraEnvID.String(), r.host.ID.String()); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		downloaded, err := isHostIgnitionDownloaded(tx, r.host)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if downloaded {
+			return errHostIgnitionDownloaded(r.host)
+		}
+		if err = tx.Create(r.record).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		logutil.FromContext(r.ctx, r.b.log).WithError(err).Errorf("failed to record the download of %s", r.record.FileName)
+		common.GenerateErrorResponder(err).WriteResponse(rw, producer)
+		return
+	}
+	r.b.notifyDownload(r.ctx, r.record)
+	r.next.WriteResponse(rw, producer)
+}
+
+func (r *downloadAuditResponder) GetNext() middleware.Responder {
+	return r.next
+}
+
 func (b *bareMetalInventory) V2DownloadHostIgnition(ctx context.Context, params installer.V2DownloadHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	fileName, respBody, contentLength, err := b.v2DownloadHostIgnition(ctx, params.InfraEnvID.String(), params.HostID.String())
+	host, fileName, content, err := b.v2DownloadHostIgnition(ctx, params.InfraEnvID.String(), params.HostID.String())
+	if err == nil {
+		err = b.checkHostIgnitionDownloadOnce(host)
+	}
 	if err != nil {
 		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
 		return common.GenerateErrorResponder(err)
 	}
 
-	return filemiddleware.NewResponder(installer.NewV2DownloadHostIgnitionOK().WithPayload(respBody), fileName, contentLength, nil)
+	return &downloadAuditResponder{
+		b:      b,
+		ctx:    ctx,
+		record: newHostIgnitionDownloadAuditRecord(ctx, host, fileName, content),
+		host:   host,
+		once:   b.HostIgnitionDownloadOnce,
+		next: filemiddleware.NewResponder(installer.NewV2DownloadHostIgnitionOK().WithPayload(io.NopCloser(bytes.NewReader(content))),
+			fileName, int64(len(content)), nil),
+	}
 }
 
-// v2DownloadHostIgnition returns the ignition file name, the content as an io.ReadCloser, and the file content length
-func (b *bareMetalInventory) v2DownloadHostIgnition(ctx context.Context, infraEnvID string, hostID string) (string, io.ReadCloser, int64, error) {
+// v2DownloadHostIgnition returns the host, its ignition file name and the ignition content
+func (b *bareMetalInventory) v2DownloadHostIgnition(ctx context.Context, infraEnvID string, hostID string) (*common.Host, string, []byte, error) {
 	infraEnvHost, err := common.GetHostFromDB(b.db, infraEnvID, hostID)
 	if err != nil {
 		err = errors.Errorf("host %s not found in infra env %s", hostID, infraEnvID)
-		return "", nil, 0, common.NewApiError(http.StatusNotFound, err)
+		return nil, "", nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
 	// If host is not assigned to any cluster, we fail the ignition download.
 	if infraEnvHost.ClusterID == nil {
 		err = errors.Errorf("Cluster not found for host %s in infra env %s", hostID, infraEnvID)
-		return "", nil, 0, common.NewApiError(http.StatusNotFound, err)
+		return nil, "", nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
 	c, err := b.getCluster(ctx, infraEnvHost.ClusterID.String(), common.SkipEagerLoading)
 	if err != nil {
-		return "", nil, 0, err
+		return nil, "", nil, err
 	}
 
 	// check if cluster is in the correct state to download files
 	err = clusterPkg.CanDownloadFiles(c)
 	if err != nil {
-		return "", nil, 0, common.NewApiError(http.StatusConflict, err)
+		return nil, "", nil, common.NewApiError(http.StatusConflict, err)
 	}
 
 	fileName := hostutil.IgnitionFileName(&infraEnvHost.Host)
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", infraEnvHost.ClusterID.String(), fileName))
+	respBody, _, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", infraEnvHost.ClusterID.String(), fileName))
+	if err != nil {
+		return nil, "", nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	defer respBody.Close()
+	content, err := io.ReadAll(respBody)
 	if err != nil {
-		return "", nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, "", nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	return fileName, respBody, contentLength, nil
+	return infraEnvHost, fileName, content, nil
 }
 
 func (b *bareMetalInventory) GetCredentialsInternal(ctx context.Context, params installer.V2GetCredentialsParams) (*models.Credentials, error) {
@@ -5223,6 +5519,17 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 		// quirk.
 		params.InfraenvCreateParams.AdditionalTrustBundle = strings.TrimSpace(params.InfraenvCreateParams.AdditionalTrustBundle)
 
+		ignitionOpenshiftVersion := params.InfraenvCreateParams.OpenshiftVersion
+		if ignitionOpenshiftVersion == "" && cluster != nil {
+			ignitionOpenshiftVersion = cluster.OpenshiftVersion
+		}
+		var ignitionConfigOverrideSource string
+		params.InfraenvCreateParams.IgnitionConfigOverride, ignitionConfigOverrideSource, err = ignitioncommon.TranslateIgnitionConfigOverride(
+			params.InfraenvCreateParams.IgnitionConfigOverride, ignitionOpenshiftVersion)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
 		if err = b.validateInfraEnvCreateParams(ctx, params, cluster); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
@@ -5268,6 +5575,12 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 			kernelArguments = swag.String(string(b))
 		}
 
+		var discoveryCustomization *string
+		discoveryCustomization, err = formatDiscoveryCustomization(params.InfraenvCreateParams.DiscoveryCustomization)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
 		infraEnv = common.InfraEnv{
 			Generated: false,
 			InfraEnv: models.InfraEnv{
@@ -5280,6 +5593,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 				EmailDomain:                  ocm.EmailDomainFromContext(ctx),
 				OpenshiftVersion:             openshiftVersion,
 				IgnitionConfigOverride:       params.InfraenvCreateParams.IgnitionConfigOverride,
+				IgnitionConfigOverrideSource: ignitionConfigOverrideSource,
 				StaticNetworkConfig:          staticNetworkConfig,
 				Type:                         common.ImageTypePtr(params.InfraenvCreateParams.ImageType),
 				AdditionalNtpSources:         swag.StringValue(params.InfraenvCreateParams.AdditionalNtpSources),
@@ -5288,6 +5602,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 				RendezvousIP:                 params.InfraenvCreateParams.RendezvousIP,
 				CPUArchitecture:              params.InfraenvCreateParams.CPUArchitecture,
 				KernelArguments:              kernelArguments,
+				DiscoveryCustomization:       discoveryCustomization,
 				AdditionalTrustBundle:        params.InfraenvCreateParams.AdditionalTrustBundle,
 				NetworkDiscoveryDelaySeconds: params.InfraenvCreateParams.NetworkDiscoveryDelaySeconds,
 			},
@@ -5339,7 +5654,14 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
-		if params.InfraenvCreateParams.IgnitionConfigOverride != "" {
+		if discoveryCustomization != nil {
+			infraEnv.DiscoveryCustomizationRevision = 1
+			if err = b.validateDiscoveryCustomizationConflicts(ctx, &infraEnv, params.InfraenvCreateParams.DiscoveryCustomization); err != nil {
+				return err
+			}
+		}
+
+		if params.InfraenvCreateParams.IgnitionConfigOverride != "" || discoveryCustomization != nil {
 			var discoveryIgnition string
 			discoveryIgnition, err = b.IgnitionBuilder.FormatDiscoveryIgnitionFile(
 				ctx, &infraEnv, b.IgnitionConfig,
@@ -5351,6 +5673,9 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 			if err = validations.ValidateIgnitionImageSize(discoveryIgnition); err != nil {
 				return common.NewApiError(http.StatusBadRequest, err)
 			}
+			if err = b.validateInfraEnvIgnitionOverrideConflicts(ctx, &infraEnv); err != nil {
+				return err
+			}
 		}
 
 		if err = tx.Create(&infraEnv).Error; err != nil {
@@ -5358,6 +5683,13 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 
+		if discoveryCustomization != nil {
+			if err = createDiscoveryCustomizationRevision(ctx, tx, &infraEnv); err != nil {
+				log.WithError(err).Error("failed to create discovery customization revision")
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -5622,6 +5954,18 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 			}
 		}
 
+		openshiftVersion := infraEnv.OpenshiftVersion
+		if params.InfraEnvUpdateParams.OpenshiftVersion != nil {
+			openshiftVersion = *params.InfraEnvUpdateParams.OpenshiftVersion
+		}
+
+		var ignitionConfigOverrideSource string
+		params.InfraEnvUpdateParams.IgnitionConfigOverride, ignitionConfigOverrideSource, err = ignitioncommon.TranslateIgnitionConfigOverride(
+			params.InfraEnvUpdateParams.IgnitionConfigOverride, openshiftVersion)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
 		if err = b.validateInfraEnvIgnitionParams(ctx, params.InfraEnvUpdateParams.IgnitionConfigOverride, internalIgnitionConfig); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
@@ -5658,11 +6002,6 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
-		openshiftVersion := infraEnv.OpenshiftVersion
-		if params.InfraEnvUpdateParams.OpenshiftVersion != nil {
-			openshiftVersion = *params.InfraEnvUpdateParams.OpenshiftVersion
-		}
-
 		if b.EnableImageService {
 			_, err = b.osImages.GetOsImageOrLatest(openshiftVersion, infraEnv.CPUArchitecture)
 			if err != nil {
@@ -5678,12 +6017,16 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
-		err = b.updateInfraEnvData(infraEnv, params, internalIgnitionConfig, tx, log, currentImageType, targetImageType, params.InfraEnvUpdateParams.RendezvousIP)
+		err = b.updateInfraEnvData(infraEnv, params, internalIgnitionConfig, ignitionConfigOverrideSource, tx, log, currentImageType, targetImageType, params.InfraEnvUpdateParams.RendezvousIP)
 		if err != nil {
 			log.WithError(err).Error("updateInfraEnvData")
 			return err
 		}
 
+		if err = b.updateInfraEnvDiscoveryCustomization(ctx, tx, infraEnv, params.InfraEnvUpdateParams.DiscoveryCustomization); err != nil {
+			return err
+		}
+
 		// Validate discovery ignition after updating InfraEnv data
 		if err = b.validateDiscoveryIgnitionImageSize(ctx, infraEnv, params, tx, log); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
@@ -5714,7 +6057,8 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 }
 
 func (b *bareMetalInventory) validateDiscoveryIgnitionImageSize(ctx context.Context, infraEnv *common.InfraEnv, params installer.UpdateInfraEnvParams, db *gorm.DB, log logrus.FieldLogger) error {
-	if params.InfraEnvUpdateParams.IgnitionConfigOverride != "" && params.InfraEnvUpdateParams.IgnitionConfigOverride != infraEnv.IgnitionConfigOverride {
+	overrideChanged := params.InfraEnvUpdateParams.IgnitionConfigOverride != "" && params.InfraEnvUpdateParams.IgnitionConfigOverride != infraEnv.IgnitionConfigOverride
+	if overrideChanged || params.InfraEnvUpdateParams.DiscoveryCustomization != nil {
 		infraEnvAfterUpdate, err := common.GetInfraEnvFromDB(db, params.InfraEnvID)
 		if err != nil {
 			log.WithError(err).Errorf("Failed to get infraEnv: %s", params.InfraEnvID)
@@ -5730,11 +6074,19 @@ func (b *bareMetalInventory) validateDiscoveryIgnitionImageSize(ctx context.Cont
 		if err := validations.ValidateIgnitionImageSize(discoveryIgnition); err != nil {
 			return err
 		}
+		if err := b.validateInfraEnvIgnitionOverrideConflicts(ctx, infraEnvAfterUpdate); err != nil {
+			return err
+		}
+		if infraEnvAfterUpdate.DiscoveryCustomization != nil {
+			if err := b.validateDiscoveryCustomizationConflicts(ctx, infraEnvAfterUpdate, params.InfraEnvUpdateParams.DiscoveryCustomization); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
-func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, db *gorm.DB, log logrus.FieldLogger, currentImageType, targetImageType models.ImageType, rendezvousIP *string) error {
+func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, ignitionConfigOverrideSource string, db *gorm.DB, log logrus.FieldLogger, currentImageType, targetImageType models.ImageType, rendezvousIP *string) error {
 	updates := map[string]interface{}{}
 	if err := b.updateInfraEnvProxy(params, infraEnv, updates); err != nil {
 		return err
@@ -5758,8 +6110,10 @@ func (b *bareMetalInventory) updateInfraEnvData(infraEnv *common.InfraEnv, param
 		return err
 	}
 
-	if params.InfraEnvUpdateParams.IgnitionConfigOverride != "" && params.InfraEnvUpdateParams.IgnitionConfigOverride != infraEnv.IgnitionConfigOverride {
+	if params.InfraEnvUpdateParams.IgnitionConfigOverride != "" && (params.InfraEnvUpdateParams.IgnitionConfigOverride != infraEnv.IgnitionConfigOverride ||
+		ignitionConfigOverrideSource != infraEnv.IgnitionConfigOverrideSource) {
 		updates["ignition_config_override"] = params.InfraEnvUpdateParams.IgnitionConfigOverride
+		updates["ignition_config_override_source"] = ignitionConfigOverrideSource
 		// Set the feature usage for ignition config overrides since it changed
 		if err := b.setIgnitionConfigOverrideUsage(infraEnv.ClusterID, params.InfraEnvUpdateParams.IgnitionConfigOverride, "infra-env", db); err != nil {
 			// Failure to set the feature usage isn't a failure to update the infraenv so we only print the error instead of returning it
@@ -6029,6 +6383,90 @@ func (b *bareMetalInventory) updateInfraEnvKernelArguments(params installer.Upda
 	return nil
 }
 
+// formatDiscoveryCustomization validates the discovery customization and formats it as JSON for the DB.
+// It returns nil if the customization doesn't customize anything.
+func formatDiscoveryCustomization(customization *models.DiscoveryCustomization) (*string, error) {
+	if ignition.IsDiscoveryCustomizationEmpty(customization) {
+		return nil, nil
+	}
+	if err := ignition.ValidateDiscoveryCustomization(customization); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(customization)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format discovery customization as json")
+	}
+	return swag.String(string(b)), nil
+}
+
+// createDiscoveryCustomizationRevision records the current discovery customization of the infra-env in its history
+func createDiscoveryCustomizationRevision(ctx context.Context, db *gorm.DB, infraEnv *common.InfraEnv) error {
+	revision := models.DiscoveryCustomizationRevision{
+		InfraEnvID:             infraEnv.ID,
+		Revision:               swag.Int64(infraEnv.DiscoveryCustomizationRevision),
+		DiscoveryCustomization: swag.StringValue(infraEnv.DiscoveryCustomization),
+		UserName:               ocm.UserNameFromContext(ctx),
+	}
+	return db.Create(&revision).Error
+}
+
+func (b *bareMetalInventory) updateInfraEnvDiscoveryCustomization(ctx context.Context, db *gorm.DB, infraEnv *common.InfraEnv, customization *models.DiscoveryCustomization) error {
+	if customization == nil {
+		return nil
+	}
+	formatted, err := formatDiscoveryCustomization(customization)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if swag.StringValue(formatted) == swag.StringValue(infraEnv.DiscoveryCustomization) {
+		return nil
+	}
+
+	updates := map[string]interface{}{
+		// Incremented in the DB so that the row stays locked until the revision is recorded
+		"discovery_customization_revision": gorm.Expr("discovery_customization_revision + 1"),
+		"discovery_customization":          gorm.Expr("NULL"),
+		"generated":                        false,
+	}
+	if formatted != nil {
+		updates["discovery_customization"] = *formatted
+	}
+	if err = db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).Updates(updates).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update discovery customization of infraEnv %s", infraEnv.ID))
+	}
+	if err = db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).
+		Pluck("discovery_customization_revision", &infraEnv.DiscoveryCustomizationRevision).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	infraEnv.DiscoveryCustomization = formatted
+	if err = createDiscoveryCustomizationRevision(ctx, db, infraEnv); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to record discovery customization revision of infraEnv %s", infraEnv.ID))
+	}
+	return nil
+}
+
+// validateDiscoveryCustomizationConflicts fails if the discovery customization replaces files or units that
+// the service generates for the discovery ignition
+func (b *bareMetalInventory) validateDiscoveryCustomizationConflicts(ctx context.Context, infraEnv *common.InfraEnv, customization *models.DiscoveryCustomization) error {
+	infraEnvWithoutCustomization := *infraEnv
+	infraEnvWithoutCustomization.IgnitionConfigOverride = ""
+	infraEnvWithoutCustomization.DiscoveryCustomization = nil
+	base, err := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, &infraEnvWithoutCustomization, b.IgnitionConfig,
+		false, b.authHandler.AuthType(), string(common.ImageTypeValue(infraEnv.Type)))
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	conflicts, err := ignition.FindDiscoveryCustomizationConflicts([]byte(base), customization)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if len(conflicts) > 0 {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf(
+			"discovery customization replaces content generated by the service: %s", ignitioncommon.FormatOverrideConflicts(conflicts)))
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) GetInfraEnvByKubeKey(key types.NamespacedName) (*common.InfraEnv, error) {
 	infraEnv, err := common.GetInfraEnvFromDBWhere(b.db, "name = ? and kube_key_namespace = ?", key.Name, key.Namespace)
 	if err != nil {
@@ -6214,19 +6652,299 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 func (b *bareMetalInventory) V2GetHostIgnition(ctx context.Context, params installer.V2GetHostIgnitionParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	_, respBody, _, err := b.v2DownloadHostIgnition(ctx, params.InfraEnvID.String(), params.HostID.String())
+	host, fileName, content, err := b.v2DownloadHostIgnition(ctx, params.InfraEnvID.String(), params.HostID.String())
+	if err == nil {
+		err = b.checkHostIgnitionDownloadOnce(host)
+	}
 	if err != nil {
 		log.WithError(err).Errorf("failed to download host %s ignition", params.HostID)
 		return common.GenerateErrorResponder(err)
 	}
 
-	respBytes, err := io.ReadAll(respBody)
+	record := newHostIgnitionDownloadAuditRecord(ctx, host, fileName, content)
+	record.FileType = swag.String(models.DownloadAuditRecordFileTypeHostIgnitionParams)
+	return &downloadAuditResponder{
+		b:      b,
+		ctx:    ctx,
+		record: record,
+		host:   host,
+		once:   b.HostIgnitionDownloadOnce,
+		next:   installer.NewV2GetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(content)}),
+	}
+}
+
+func (b *bareMetalInventory) V2VerifyHostIgnition(ctx context.Context, params installer.V2VerifyHostIgnitionParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	verification, err := b.verifyHostIgnition(ctx, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to verify host %s ignition", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2VerifyHostIgnitionOK().WithPayload(verification)
+}
+
+// verifyHostIgnition compares the ignition that is served to the host with the content hash that was recorded
+// when the ignitions of its cluster were generated. The served ignition is read directly from the storage so
+// that the verification is not recorded as a download of the ignition.
+func (b *bareMetalInventory) verifyHostIgnition(ctx context.Context, infraEnvID string, hostID string) (*models.HostIgnitionVerification, error) {
+	infraEnvHost, err := common.GetHostFromDB(b.db, infraEnvID, hostID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("host %s not found in infra env %s", hostID, infraEnvID))
+	}
+	if infraEnvHost.ClusterID == nil {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("Cluster not found for host %s in infra env %s", hostID, infraEnvID))
+	}
+
+	c, err := b.getCluster(ctx, infraEnvHost.ClusterID.String(), common.SkipEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	if err = clusterPkg.CanDownloadFiles(c); err != nil {
+		return nil, common.NewApiError(http.StatusConflict, err)
+	}
+
+	recordContent, err := b.downloadObject(ctx, fmt.Sprintf("%s/%s", infraEnvHost.ClusterID.String(), ignition.GenerationRecordFileName))
+	if err != nil {
+		return nil, err
+	}
+	record, err := ignition.ParseGenerationRecord(recordContent)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	fileName := hostutil.IgnitionFileName(&infraEnvHost.Host)
+	var expected *models.IgnitionContentHash
+	for _, ignitionHash := range record.Ignitions {
+		if ignitionHash.FileName == fileName {
+			expected = ignitionHash
+			break
+		}
+	}
+	if expected == nil {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("no ignition was generated for host %s", hostID))
+	}
+
+	served, err := b.downloadObject(ctx, fmt.Sprintf("%s/%s", infraEnvHost.ClusterID.String(), fileName))
+	if err != nil {
+		return nil, err
+	}
+	servedHash := ignition.ContentSHA256(served)
+
+	return &models.HostIgnitionVerification{
+		HostID:           *infraEnvHost.ID,
+		FileName:         fileName,
+		ExpectedSha256:   expected.Sha256,
+		ServedSha256:     servedHash,
+		Matches:          servedHash == expected.Sha256,
+		GenerationRecord: record,
+	}, nil
+}
+
+// downloadObject returns the content of an object, failing with 404 when it doesn't exist
+func (b *bareMetalInventory) downloadObject(ctx context.Context, objectName string) ([]byte, error) {
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !exists {
+		return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("object %s was not found", objectName))
+	}
+	respBody, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	defer respBody.Close()
+	content, err := io.ReadAll(respBody)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return content, nil
+}
+
+func (b *bareMetalInventory) V2GetInfraEnvIgnitionPreview(ctx context.Context, params installer.V2GetInfraEnvIgnitionPreviewParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
 	if err != nil {
-		log.WithError(err).Errorf("failed to read ignition content for host %s", params.HostID)
+		log.WithError(err).Errorf("failed to get infra env %s", params.InfraEnvID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	// The conflicts are found in the discovery ignition that the hosts actually boot from, and only then the
+	// credentials are redacted from the previewed ignition
+	imageType := string(common.ImageTypeValue(infraEnv.Type))
+	merged, err := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, infraEnv, b.IgnitionConfig, false, b.authHandler.AuthType(), imageType)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format discovery ignition of infra env %s", params.InfraEnvID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusBadRequest)
+	}
+	infraEnvWithoutOverride := *infraEnv
+	infraEnvWithoutOverride.IgnitionConfigOverride = ""
+	base, err := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, &infraEnvWithoutOverride, b.IgnitionConfig, false, b.authHandler.AuthType(), imageType)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format discovery ignition of infra env %s", params.InfraEnvID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusBadRequest)
+	}
+	redacted, err := ignition.RedactDiscoveryIgnition([]byte(merged))
+	if err != nil {
+		log.WithError(err).Errorf("failed to redact discovery ignition of infra env %s", params.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	preview, err := ignitionPreview([]byte(base), redacted, infraEnv.IgnitionConfigOverride)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find ignition override conflicts of infra env %s", params.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewV2GetInfraEnvIgnitionPreviewOK().WithPayload(preview)
+}
+
+func (b *bareMetalInventory) V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host %s in infra env %s", params.HostID, params.InfraEnvID)
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+
+	merged, err := b.hostPointerIgnition(ctx, b.db, host, host.IgnitionConfigOverrides, true)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format pointer ignition of host %s", params.HostID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusBadRequest)
+	}
+	base, err := b.hostPointerIgnition(ctx, b.db, host, "", true)
+	if err != nil {
+		log.WithError(err).Errorf("failed to format pointer ignition of host %s", params.HostID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusBadRequest)
+	}
+
+	preview, err := ignitionPreview(base, merged, host.IgnitionConfigOverrides)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find ignition override conflicts of host %s", params.HostID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	return installer.NewV2GetHostIgnitionPreviewOK().WithPayload(preview)
+}
 
-	return installer.NewV2GetHostIgnitionOK().WithPayload(&models.HostIgnitionParams{Config: string(respBytes)})
+func ignitionPreview(base, merged []byte, override string) (*models.IgnitionPreview, error) {
+	preview := &models.IgnitionPreview{
+		Ignition:  string(merged),
+		Conflicts: []*models.IgnitionOverrideConflict{},
+	}
+	if override == "" {
+		return preview, nil
+	}
+	conflicts, err := ignitioncommon.FindOverrideConflicts(base, []byte(override))
+	if err != nil {
+		return nil, err
+	}
+	preview.Conflicts = conflicts
+	return preview, nil
+}
+
+// hostPointerIgnition builds the pointer ignition that the host boots from once it is written to disk, with the
+// given ignition config overrides applied. When safeForLogs is set the credentials used to pull the rest of the
+// ignition from the cluster are redacted.
+func (b *bareMetalInventory) hostPointerIgnition(ctx context.Context, db *gorm.DB, host *common.Host, ignitionConfigOverrides string, safeForLogs bool) ([]byte, error) {
+	if host.ClusterID == nil {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("host %s is not bound to any cluster", host.ID))
+	}
+	cluster, err := common.GetClusterFromDB(db, *host.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+
+	h := host.Host
+	h.IgnitionConfigOverrides = ignitionConfigOverrides
+	if hostutil.IsDay2Host(&h) {
+		ignitionEndpointUrl, cert, err := hostutil.GetIgnitionEndpointAndCert(cluster, &h, b.log)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		token, headers := host.IgnitionEndpointToken, host.IgnitionEndpointHTTPHeaders
+		if safeForLogs {
+			if token != "" {
+				token = "*****"
+			}
+			if headers, err = redactIgnitionEndpointHTTPHeaders(headers); err != nil {
+				return nil, err
+			}
+		}
+		return b.IgnitionBuilder.FormatSecondDayWorkerIgnitionFile(ignitionEndpointUrl, cert, token, headers, &h)
+	}
+
+	// The role ignition is generated by the installer when the installation starts, until then the pointer
+	// ignition is built on top of an empty config
+	roleIgnition := []byte(`{"ignition":{"version":"3.2.0"}}`)
+	objectName := fmt.Sprintf("%s/%s", cluster.ID, ignition.RoleIgnitionFileName(common.GetEffectiveRole(&h)))
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if exists {
+		reader, _, err := b.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		defer reader.Close()
+		if roleIgnition, err = io.ReadAll(reader); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return ignition.FormatHostPointerIgnition(b.log, roleIgnition, cluster, &h, ignitionConfigOverrides)
+}
+
+func redactIgnitionEndpointHTTPHeaders(headers string) (string, error) {
+	if headers == "" {
+		return "", nil
+	}
+	parsed := map[string]string{}
+	if err := json.Unmarshal([]byte(headers), &parsed); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal ignition endpoint HTTP headers")
+	}
+	for k := range parsed {
+		parsed[k] = "*****"
+	}
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return "", err
+	}
+	return string(redacted), nil
+}
+
+// validateIgnitionOverrideConflicts fails when the service is configured to block ignition config overrides that
+// replace content generated by the service and the given override does so
+func (b *bareMetalInventory) validateIgnitionOverrideConflicts(base []byte, override string) error {
+	if !b.BlockShadowingIgnitionOverrides || override == "" {
+		return nil
+	}
+	conflicts, err := ignitioncommon.FindOverrideConflicts(base, []byte(override))
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if len(conflicts) > 0 {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf(
+			"ignition config override replaces content generated by the service: %s",
+			ignitioncommon.FormatOverrideConflicts(conflicts)))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) validateInfraEnvIgnitionOverrideConflicts(ctx context.Context, infraEnv *common.InfraEnv) error {
+	if !b.BlockShadowingIgnitionOverrides || infraEnv.IgnitionConfigOverride == "" {
+		return nil
+	}
+	infraEnvWithoutOverride := *infraEnv
+	infraEnvWithoutOverride.IgnitionConfigOverride = ""
+	base, err := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, &infraEnvWithoutOverride, b.IgnitionConfig,
+		false, b.authHandler.AuthType(), string(common.ImageTypeValue(infraEnv.Type)))
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return b.validateIgnitionOverrideConflicts([]byte(base), infraEnv.IgnitionConfigOverride)
 }
 
 func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder {
@@ -6646,18 +7364,50 @@ func (b *bareMetalInventory) V2UpdateHostIgnitionInternal(ctx context.Context, p
 			return err
 		}
 
+		var ignitionConfig, ignitionConfigSource string
 		if params.HostIgnitionParams.Config != "" {
-			_, err = ignitioncommon.ParseToLatest([]byte(params.HostIgnitionParams.Config))
+			ignitionConfig = params.HostIgnitionParams.Config
+			if ignitioncommon.IsButaneConfig([]byte(ignitionConfig)) {
+				var openshiftVersion string
+				if h.ClusterID != nil {
+					var cluster *common.Cluster
+					if cluster, err = common.GetClusterFromDB(tx, *h.ClusterID, common.SkipEagerLoading); err != nil {
+						return err
+					}
+					openshiftVersion = cluster.OpenshiftVersion
+				}
+				var translated []byte
+				translated, err = ignitioncommon.TranslateButaneToIgnition([]byte(params.HostIgnitionParams.Config), openshiftVersion)
+				if err != nil {
+					log.WithError(err).Errorf("Failed to translate host ignition config patch %s", params.HostIgnitionParams)
+					return common.NewApiError(http.StatusBadRequest, err)
+				}
+				ignitionConfig, ignitionConfigSource = string(translated), params.HostIgnitionParams.Config
+			}
+			_, err = ignitioncommon.ParseToLatest([]byte(ignitionConfig))
 			if err != nil {
 				log.WithError(err).Errorf("Failed to parse host ignition config patch %s", params.HostIgnitionParams)
 				return common.NewApiError(http.StatusBadRequest, err)
 			}
+			if b.BlockShadowingIgnitionOverrides && h.ClusterID != nil {
+				var base []byte
+				if base, err = b.hostPointerIgnition(ctx, tx, h, "", false); err != nil {
+					log.WithError(err).Errorf("Failed to format pointer ignition of host %s", params.HostID)
+					return err
+				}
+				if err = b.validateIgnitionOverrideConflicts(base, ignitionConfig); err != nil {
+					return err
+				}
+			}
 		} else {
 			log.Infof("Removing custom ignition override from host %s in infra-env %s", params.HostID, params.InfraEnvID)
 		}
 
 		err = tx.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", params.HostID, params.InfraEnvID).
-			Update("ignition_config_overrides", params.HostIgnitionParams.Config).Error
+			Updates(map[string]interface{}{
+				"ignition_config_overrides":        ignitionConfig,
+				"ignition_config_overrides_source": ignitionConfigSource,
+			}).Error
 		if err != nil {
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
@@ -6666,7 +7416,7 @@ func (b *bareMetalInventory) V2UpdateHostIgnitionInternal(ctx context.Context, p
 
 		// Set the feature usage for ignition config overrides
 		if h.ClusterID != nil {
-			if err = b.setIgnitionConfigOverrideUsage(*h.ClusterID, params.HostIgnitionParams.Config, "host", tx); err != nil {
+			if err = b.setIgnitionConfigOverrideUsage(*h.ClusterID, ignitionConfig, "host", tx); err != nil {
 				// Failure to set the feature usage isn't a failure to update the host ignition so we only print the error instead of returning it
 				log.WithError(err).Warnf("failed to set ignition config override usage for cluster %s", h.ClusterID)
 			}
@@ -6690,8 +7440,8 @@ func (b *bareMetalInventory) V2UpdateHostIgnitionInternal(ctx context.Context, p
 }
 
 func (b *bareMetalInventory) V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder {
-	if params.IpxeScriptType != nil && params.FileName != "ipxe-script" {
-		return common.NewApiError(http.StatusBadRequest, errors.New(`"ipxe_script_type"" can be set only for "ipxe-script"`))
+	if params.IpxeScriptType != nil && params.FileName != "ipxe-script" && params.FileName != "grub-config" {
+		return common.NewApiError(http.StatusBadRequest, errors.New(`"ipxe_script_type"" can be set only for "ipxe-script" and "grub-config"`))
 	}
 	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
 	if err != nil {
@@ -6729,6 +7479,26 @@ func (b *bareMetalInventory) V2DownloadInfraEnvFiles(ctx context.Context, params
 			return common.GenerateErrorResponder(err)
 		}
 		filename = fmt.Sprintf("%s-%s", params.InfraEnvID, params.FileName)
+	case "grub-config":
+		content, err = b.infraEnvGrubConfig(ctx, infraEnv, params.Mac, params.IpxeScriptType)
+		if err != nil {
+			b.log.WithError(err).Error("Failed to create grub config")
+			return common.GenerateErrorResponder(err)
+		}
+		filename = fmt.Sprintf("%s-grub.cfg", params.InfraEnvID)
+	case "boot-artifacts-sha256sums":
+		var bootArtifactURLs *imageservice.BootArtifactURLs
+		bootArtifactURLs, _, err = b.bootArtifacts(ctx, infraEnv)
+		if err != nil {
+			b.log.WithError(err).Error("Failed to get boot artifacts")
+			return common.GenerateErrorResponder(err)
+		}
+		content = bootArtifactURLs.SHA256Sums()
+		if content == "" {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf(
+				"the OS image of infra-env %s doesn't provide the digests of its boot artifacts", params.InfraEnvID))
+		}
+		filename = fmt.Sprintf("%s-SHA256SUMS", params.InfraEnvID)
 	case "static-network-config":
 		var netFiles []staticnetworkconfig.StaticNetworkConfigData
 		if infraEnv.StaticNetworkConfig != "" {
@@ -6807,7 +7577,9 @@ func (b *bareMetalInventory) V2DownloadClusterCredentials(ctx context.Context, p
 		return common.GenerateErrorResponder(err)
 	}
 
-	return filemiddleware.NewResponder(installer.NewV2DownloadClusterCredentialsOK().WithPayload(respBody), fileName, contentLength, nil)
+	return b.auditClusterFileResponder(ctx, params.ClusterID, fileName, respBody, contentLength, func(body io.ReadCloser) middleware.Responder {
+		return installer.NewV2DownloadClusterCredentialsOK().WithPayload(body)
+	})
 }
 
 func (b *bareMetalInventory) V2DownloadClusterFiles(ctx context.Context, params installer.V2DownloadClusterFilesParams) middleware.Responder {
@@ -6815,7 +7587,9 @@ func (b *bareMetalInventory) V2DownloadClusterFiles(ctx context.Context, params
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return filemiddleware.NewResponder(installer.NewV2DownloadClusterFilesOK().WithPayload(respBody), params.FileName, contentLength, nil)
+	return b.auditClusterFileResponder(ctx, params.ClusterID, params.FileName, respBody, contentLength, func(body io.ReadCloser) middleware.Responder {
+		return installer.NewV2DownloadClusterFilesOK().WithPayload(body)
+	})
 }
 
 func (b *bareMetalInventory) V2DownloadClusterFilesInternal(ctx context.Context, params installer.V2DownloadClusterFilesParams) (io.ReadCloser, int64, error) {
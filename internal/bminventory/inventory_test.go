@@ -5,7 +5,9 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,6 +44,7 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
@@ -75,6 +78,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/k8sclient"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/restapi"
@@ -2751,7 +2755,9 @@ var _ = Describe("cluster", func() {
 					clusterParams.OlmOperators = []*models.OperatorCreateParams{
 						{Name: newOperatorName, Properties: newProperties},
 					}
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 						NewClusterParams: clusterParams,
 					})
@@ -2785,7 +2791,9 @@ var _ = Describe("cluster", func() {
 						{Name: newOperatorName},
 					}
 
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 						NewClusterParams: clusterParams,
 					})
@@ -2851,6 +2859,7 @@ var _ = Describe("cluster", func() {
 						{Name: "lvm"},
 						{Name: "cnv"},
 					}
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 						errors.New("Currently, you can not install OpenShift Data Foundation Logical Volume Manager operator at the same time as Virtualization operator"))
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
@@ -2874,7 +2883,9 @@ var _ = Describe("cluster", func() {
 						{Name: "cnv"},
 						{Name: "lvm"},
 					}
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 						NewClusterParams: clusterParams,
 					})
@@ -2900,7 +2911,9 @@ var _ = Describe("cluster", func() {
 					clusterParams.OlmOperators = []*models.OperatorCreateParams{
 						{Name: "cnv"},
 					}
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 						NewClusterParams: clusterParams,
 					})
@@ -2932,7 +2945,9 @@ var _ = Describe("cluster", func() {
 					}
 					clusterParams.OpenshiftVersion = swag.String("4.12")
 
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 						NewClusterParams: clusterParams,
 					})
@@ -2961,7 +2976,9 @@ var _ = Describe("cluster", func() {
 						DoAndReturn(func(commonCluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 							return operators, nil
 						}).Times(1)
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().GetBundle("openshift-ai", nil).Return(&models.Bundle{
 						ID:                "openshift-ai",
 						Operators:         []string{},
@@ -3114,7 +3131,9 @@ var _ = Describe("cluster", func() {
 						}
 
 						if test.updateOperators != nil {
+							mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 							mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+							mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 						}
 
 						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
@@ -3160,7 +3179,9 @@ var _ = Describe("cluster", func() {
 					DoAndReturn(func(commonCluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 						return operators, nil
 					}).Times(1)
+				mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 				mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 				mockOperatorManager.EXPECT().GetBundle("openshift-ai", nil).Return(&models.Bundle{
 					ID:                "openshift-ai",
 					Operators:         []string{},
@@ -3215,7 +3236,9 @@ var _ = Describe("cluster", func() {
 							return append(operators, testOLMOperators[0]), nil
 						}).Times(1)
 
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
 						ClusterID: clusterID,
 						ClusterUpdateParams: &models.V2ClusterUpdateParams{
@@ -3260,6 +3283,7 @@ var _ = Describe("cluster", func() {
 							return append(operators, testOLMOperators[0]), nil
 						}).Times(1)
 
+					mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 						errors.New("Currently, you can not install OpenShift Data Foundation Logical Volume Manager operator at the same time as Virtualization operator"))
 					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
@@ -3572,7 +3596,9 @@ var _ = Describe("cluster", func() {
 						return append(operators, testOLMOperators[0]), nil
 					}).Times(1)
 
+				mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 				mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
 					ClusterID: clusterID,
 					ClusterUpdateParams: &models.V2ClusterUpdateParams{
@@ -9044,6 +9070,51 @@ var _ = Describe("infraEnvs", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(swag.StringValue(dbInfraEnv.KernelArguments)).To(Equal(string(jsonEncodedKernelParameters)))
 		})
+		It("happy flow with discovery customization", func() {
+			mockInfraEnvRegisterSuccess()
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(discovery_ignition_3_1, nil).Times(2)
+			mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegisteredEventName))).Times(1)
+			reply := bm.RegisterInfraEnv(ctx, installer.RegisterInfraEnvParams{
+				InfraenvCreateParams: &models.InfraEnvCreateParams{
+					Name:                   swag.String("some-infra-env-name"),
+					OpenshiftVersion:       common.TestDefaultConfig.OpenShiftVersion,
+					PullSecret:             swag.String(fakePullSecret),
+					DiscoveryCustomization: &models.DiscoveryCustomization{KernelModules: []string{"br_netfilter"}},
+				},
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewRegisterInfraEnvCreated()))
+			actual := reply.(*installer.RegisterInfraEnvCreated)
+			Expect(swag.StringValue(actual.Payload.DiscoveryCustomization)).To(Equal(`{"kernel_modules":["br_netfilter"]}`))
+			Expect(actual.Payload.DiscoveryCustomizationRevision).To(Equal(int64(1)))
+
+			var revisions []*models.DiscoveryCustomizationRevision
+			Expect(db.Where("infra_env_id = ?", actual.Payload.ID.String()).Find(&revisions).Error).To(Succeed())
+			Expect(revisions).To(HaveLen(1))
+			Expect(*revisions[0].Revision).To(Equal(int64(1)))
+			Expect(revisions[0].DiscoveryCustomization).To(Equal(`{"kernel_modules":["br_netfilter"]}`))
+		})
+
+		It("fails when the discovery customization replaces files generated by the service", func() {
+			mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).AnyTimes()
+			mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).Return("", nil).Times(1)
+			mockSecretValidator.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(discovery_ignition_3_1, nil).Times(1)
+			mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegistrationFailedEventName))).Times(1)
+			reply := bm.RegisterInfraEnv(ctx, installer.RegisterInfraEnvParams{
+				InfraenvCreateParams: &models.InfraEnvCreateParams{
+					Name:             swag.String("some-infra-env-name"),
+					OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+					PullSecret:       swag.String(fakePullSecret),
+					DiscoveryCustomization: &models.DiscoveryCustomization{
+						Files: []*models.DiscoveryCustomizationFile{{Path: swag.String("/tmp/example"), Contents: swag.String("example")}},
+					},
+				},
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "discovery customization replaces content generated by the service: file /tmp/example")
+		})
+
 		It("happy flow with kernel parameters + cluster and usage", func() {
 			mockInfraEnvRegisterSuccess()
 			cluster := createCluster(db, models.ClusterStatusInsufficient)
@@ -9685,6 +9756,57 @@ var _ = Describe("infraEnvs", func() {
 				err = db.Model(&common.InfraEnv{}).Where("id = ?", i.ID).Update("generated_at", strfmt.DateTime(time.Now().AddDate(0, 0, -1))).Error
 				Expect(err).ToNot(HaveOccurred())
 			})
+			Context("Update discovery customization", func() {
+				update := func(customization *models.DiscoveryCustomization) middleware.Responder {
+					return bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+						InfraEnvID:           *i.ID,
+						InfraEnvUpdateParams: &models.InfraEnvUpdateParams{DiscoveryCustomization: customization},
+					})
+				}
+
+				It("records a revision for every change", func() {
+					mockInfraEnvUpdateSuccess()
+					mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(discovery_ignition_3_1, nil).Times(6)
+					sysctls := &models.DiscoveryCustomization{
+						Sysctls: []*models.DiscoveryCustomizationSysctl{{Key: swag.String("net.ipv4.ip_forward"), Value: swag.String("1")}},
+					}
+					Expect(update(sysctls)).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+					By("not recording a revision when the customization didn't change")
+					Expect(update(sysctls)).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+					By("clearing the customization")
+					reply := update(&models.DiscoveryCustomization{})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+					Expect(reply.(*installer.UpdateInfraEnvCreated).Payload.DiscoveryCustomization).To(BeNil())
+					Expect(reply.(*installer.UpdateInfraEnvCreated).Payload.DiscoveryCustomizationRevision).To(Equal(int64(2)))
+
+					reply = bm.ListDiscoveryCustomizationRevisions(ctx, installer.ListDiscoveryCustomizationRevisionsParams{InfraEnvID: *i.ID})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewListDiscoveryCustomizationRevisionsOK()))
+					revisions := reply.(*installer.ListDiscoveryCustomizationRevisionsOK).Payload
+					Expect(revisions).To(HaveLen(2))
+					Expect(*revisions[0].Revision).To(Equal(int64(2)))
+					Expect(revisions[0].DiscoveryCustomization).To(BeEmpty())
+					Expect(*revisions[1].Revision).To(Equal(int64(1)))
+					Expect(revisions[1].DiscoveryCustomization).To(ContainSubstring("net.ipv4.ip_forward"))
+				})
+
+				It("rejects invalid customizations", func() {
+					reply := update(&models.DiscoveryCustomization{
+						Files: []*models.DiscoveryCustomizationFile{
+							{Path: swag.String("/etc/example"), Contents: swag.String("a")},
+							{Path: swag.String("/etc/example"), Contents: swag.String("b")},
+						},
+					})
+					verifyApiError(reply, http.StatusBadRequest)
+				})
+
+				It("fails to list the revisions of a missing infra-env", func() {
+					reply := bm.ListDiscoveryCustomizationRevisions(ctx, installer.ListDiscoveryCustomizationRevisionsParams{
+						InfraEnvID: strfmt.UUID(uuid.New().String()),
+					})
+					verifyApiError(reply, http.StatusNotFound)
+				})
+			})
+
 			Context("Update discovery kernel arguments", func() {
 				jsonEncodeKernelArguments := func(array models.KernelArguments) string {
 					b, e := json.Marshal(&array)
@@ -11739,6 +11861,11 @@ var _ = Describe("KubeConfig download", func() {
 		Expect(generateReply).Should(BeAssignableToTypeOf(&installer.V2GetPresignedForClusterFilesOK{}))
 		replyPayload := generateReply.(*installer.V2GetPresignedForClusterFilesOK).Payload
 		Expect(*replyPayload.URL).Should(Equal("url"))
+		var record common.DownloadAuditRecord
+		Expect(db.Where("cluster_id = ?", clusterID.String()).Take(&record).Error).ToNot(HaveOccurred())
+		Expect(*record.FileType).To(Equal(models.DownloadAuditRecordFileTypeKubeconfig))
+		Expect(record.FileName).To(Equal(constants.Kubeconfig))
+		Expect(record.ContentSha256).To(BeEmpty())
 	})
 
 	It("V2 presigned rejects path traversal in additional_name", func() {
@@ -12966,6 +13093,132 @@ var _ = Describe("GetClusterInstallConfig", func() {
 
 })
 
+var _ = Describe("RenderClusterManifests", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		c         common.Cluster
+		dbName    string
+		rendered  *ignition.RenderedManifests
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			BaseDNSDomain:    "example.com",
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+		}}
+		err := db.Create(&c).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		rendered = &ignition.RenderedManifests{
+			Defaults: map[string][]byte{"manifests/cvo-overrides.yaml": []byte("spec: {}\n")},
+			Rendered: map[string][]byte{
+				"manifests/cvo-overrides.yaml": []byte("spec: {}\n"),
+				"openshift/99-operator.yaml":   []byte("kind: Namespace\n"),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("renders the manifests with the operator manifests", func() {
+		Expect(db.Create(&models.MonitoredOperator{
+			ClusterID:    clusterID,
+			Name:         "lso",
+			OperatorType: models.OperatorTypeOlm,
+		}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&models.MonitoredOperator{
+			ClusterID:    clusterID,
+			Name:         "console",
+			OperatorType: models.OperatorTypeBuiltin,
+		}).Error).ToNot(HaveOccurred())
+		operatorManifests := map[string][]byte{"99-operator.yaml": []byte("kind: Namespace\n")}
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockOperatorManager.EXPECT().GenerateOperatorManifests(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *common.Cluster, operator *models.MonitoredOperator) (map[string][]byte, []byte, error) {
+				Expect(operator.Name).To(Equal("lso"))
+				return operatorManifests, []byte("custom"), nil
+			}).Times(1)
+		mockGenerator.EXPECT().RenderManifests(gomock.Any(), gomock.Any(), []byte("{}"), *common.TestDefaultConfig.ReleaseImage.URL, "", false, operatorManifests).
+			Return(rendered, nil).Times(1)
+
+		response := bm.V2RenderClusterManifests(ctx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID, Diff: swag.Bool(false)})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2RenderClusterManifestsOK()))
+		manifests := response.(*installer.V2RenderClusterManifestsOK).Payload
+		Expect(manifests).To(HaveLen(2))
+		Expect(manifests[1].Change).To(Equal(models.RenderedManifestChangeAdded))
+		Expect(manifests[1].Content).To(Equal("kind: Namespace\n"))
+	})
+
+	It("redacts the Secret manifests for read-only admins", func() {
+		rendered.Rendered["openshift/99_kubeadmin-password-secret.yaml"] = []byte("kind: Secret\nstringData:\n  kubeadmin: hash\n")
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().RenderManifests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+			Return(rendered, nil).Times(1)
+
+		authCtx := context.WithValue(ctx, restapi.AuthKey, &ocm.AuthPayload{Role: ocm.ReadOnlyAdminRole})
+		response := bm.V2RenderClusterManifests(authCtx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2RenderClusterManifestsOK()))
+		manifests := response.(*installer.V2RenderClusterManifestsOK).Payload
+		Expect(manifests).To(HaveLen(3))
+		Expect(manifests[2].FileName).To(Equal("99_kubeadmin-password-secret.yaml"))
+		Expect(manifests[2].Content).To(Equal("kind: Secret\nstringData:\n  kubeadmin: '*****'\n"))
+	})
+
+	It("renders only the changed manifests when a diff is requested", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().RenderManifests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+			Return(rendered, nil).Times(1)
+
+		response := bm.V2RenderClusterManifests(ctx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID, Diff: swag.Bool(true)})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2RenderClusterManifestsOK()))
+		manifests := response.(*installer.V2RenderClusterManifestsOK).Payload
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].FileName).To(Equal("99-operator.yaml"))
+		Expect(manifests[0].Diff).To(ContainSubstring("+kind: Namespace"))
+	})
+
+	It("fails when the user manifests can't be applied", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().RenderManifests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("failed to patch manifest"))).Times(1)
+
+		response := bm.V2RenderClusterManifests(ctx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID})
+		verifyApiErrorString(response, http.StatusBadRequest, "failed to patch manifest")
+	})
+
+	It("fails with an internal error when the manifests can't be rendered", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().RenderManifests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false, gomock.Any()).
+			Return(nil, errors.New("failed to download manifest")).Times(1)
+
+		response := bm.V2RenderClusterManifests(ctx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID})
+		verifyApiErrorString(response, http.StatusInternalServerError, "failed to download manifest")
+	})
+
+	It("fails for Day2 cluster", func() {
+		c.Kind = swag.String(models.ClusterKindAddHostsCluster)
+		db.Save(&c)
+
+		response := bm.V2RenderClusterManifests(ctx, installer.V2RenderClusterManifestsParams{ClusterID: clusterID})
+		verifyApiError(response, http.StatusBadRequest)
+	})
+})
+
 var _ = Describe("UpdateClusterInstallConfig", func() {
 	var (
 		bm        *bareMetalInventory
@@ -13468,6 +13721,133 @@ var _ = Describe("V2DownloadInfraEnvFiles", func() {
 		Expect(match[3]).To(Equal(strToCompare))
 	})
 
+	It("returns grub-config successfully", func() {
+		mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+		content := getResponseData("grub-config", false, nil, "", infraEnvID)
+		lines := strings.Split(string(content), "\n")
+
+		Expect(lines[0]).To(Equal("set timeout=1"))
+		Expect(lines[1]).To(Equal("menuentry 'Discovery' {"))
+
+		By("validating the linux line")
+		linuxRegex := regexp.MustCompile(`^\s+linux '\((\w+),([^)]+)\)([^']+)' 'coreos.live.rootfs_url=([^']+)' (.+)`)
+		match := linuxRegex.FindStringSubmatch(lines[2])
+		Expect(match).NotTo(BeNil())
+		Expect(match[1]).To(Equal("http"))
+		Expect(match[2]).To(Equal(imageServiceHost))
+		kernelURL, err := url.Parse(match[3])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kernelURL.Path).To(Equal(imageServicePath + "/boot-artifacts/kernel"))
+		Expect(kernelURL.Query().Get("version")).To(Equal(*common.TestDefaultConfig.OsImage.OpenshiftVersion))
+
+		rootfsURL, err := url.Parse(match[4])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rootfsURL.Host).To(Equal(imageServiceHost))
+		Expect(rootfsURL.Path).To(Equal(imageServicePath + "/boot-artifacts/rootfs"))
+
+		Expect(match[5]).To(Equal(`random.trust_cpu=on rd.luks.options=discard ignition.firstboot ignition.platform.id=metal console=tty1 console=ttyS1,115200n8 'coreos.inst.persistent-kargs="console=tty1 console=ttyS1,115200n8"'`))
+
+		By("validating the initrd line")
+		initrdRegex := regexp.MustCompile(`^\s+initrd '\((\w+),([^)]+)\)([^']+)'$`)
+		match = initrdRegex.FindStringSubmatch(lines[3])
+		Expect(match).NotTo(BeNil())
+		Expect(match[1]).To(Equal("http"))
+		Expect(match[2]).To(Equal(imageServiceHost))
+		initrdURL, err := url.Parse(match[3])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(initrdURL.Path).To(Equal(fmt.Sprintf("%s/images/%s/pxe-initrd", imageServicePath, infraEnvID)))
+		Expect(initrdURL.Query().Get("arch")).To(Equal(*common.TestDefaultConfig.OsImage.CPUArchitecture))
+
+		Expect(lines[4]).To(Equal("}"))
+	})
+
+	It("returns grub-config successfully - with kernel arguments", func() {
+		mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).AnyTimes()
+		mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String())))
+		mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any()).Return(discovery_ignition_3_1, nil).Times(1)
+		updateKernelArgs("p1", "p2=it's")
+
+		content := getResponseData("grub-config", false, nil, "", infraEnvID)
+		lines := strings.Split(string(content), "\n")
+		Expect(lines[2]).To(HaveSuffix(` 'p1' 'p2=it'\''s'`))
+	})
+
+	It("returns bad request for uefi-http-boot downloads", func() {
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "uefi-http-boot"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	Context("with boot artifact digests", func() {
+		var (
+			kernelDigest = strings.Repeat("a", 64)
+			rootfsDigest = strings.Repeat("b", 64)
+			osImage      *models.OsImage
+		)
+
+		BeforeEach(func() {
+			image := *common.TestDefaultConfig.OsImage
+			image.KernelSha256 = kernelDigest
+			image.RootfsSha256 = rootfsDigest
+			osImage = &image
+		})
+
+		It("returns the boot artifact digests", func() {
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(osImage, nil).Times(1)
+			content := getResponseData("boot-artifacts-sha256sums", false, nil, "", infraEnvID)
+			Expect(string(content)).To(Equal(kernelDigest + "  kernel\n" + rootfsDigest + "  rootfs\n"))
+		})
+
+		It("returns not found when the OS image has no digests", func() {
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+			params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "boot-artifacts-sha256sums"}
+			response := bm.V2DownloadInfraEnvFiles(ctx, params)
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("includes the digests in the ipxe-script", func() {
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(osImage, nil).Times(1)
+			content := getResponseData("ipxe-script", false, nil, "", infraEnvID)
+			lines := strings.Split(string(content), "\n")
+			Expect(lines[0]).To(Equal("#!ipxe"))
+			Expect(lines[1]).To(Equal("# sha256 " + kernelDigest + "  kernel"))
+			Expect(lines[2]).To(Equal("# sha256 " + rootfsDigest + "  rootfs"))
+			Expect(lines[3]).To(HavePrefix("initrd --name initrd "))
+			Expect(lines[4]).To(MatchRegexp(`^kernel \S+ initrd=initrd `))
+			Expect(lines[5]).To(Equal("boot"))
+		})
+
+		It("doesn't verify the kernel in the ipxe-script unless kernel signature verification is enabled", func() {
+			osImage.KernelSignatureURL = "https://mirror.example.com/kernel.sig"
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(osImage, nil).Times(1)
+			content := getResponseData("ipxe-script", false, nil, "", infraEnvID)
+			Expect(string(content)).NotTo(ContainSubstring("imgverify"))
+			Expect(string(content)).NotTo(ContainSubstring("--name kernel"))
+		})
+
+		It("verifies the kernel in the ipxe-script when the OS image has a kernel signature", func() {
+			bm.IPXEVerifyKernelSignature = true
+			osImage.KernelSignatureURL = "https://mirror.example.com/kernel.sig"
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(osImage, nil).Times(1)
+			content := getResponseData("ipxe-script", false, nil, "", infraEnvID)
+			lines := strings.Split(string(content), "\n")
+			Expect(lines[4]).To(MatchRegexp(`^kernel --name kernel \S+ initrd=initrd `))
+			Expect(lines[5]).To(Equal("imgverify kernel https://mirror.example.com/kernel.sig"))
+			Expect(lines[6]).To(Equal("boot"))
+		})
+
+		It("includes the digests in the grub-config", func() {
+			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(osImage, nil).Times(1)
+			content := getResponseData("grub-config", false, nil, "", infraEnvID)
+			lines := strings.Split(string(content), "\n")
+			Expect(lines[0]).To(Equal("# sha256 " + kernelDigest + "  kernel"))
+			Expect(lines[1]).To(Equal("# sha256 " + rootfsDigest + "  rootfs"))
+			Expect(lines[2]).To(Equal("set timeout=1"))
+		})
+	})
+
 	It("returns ipxe-script successfully with mac", func() {
 
 		mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
@@ -13675,6 +14055,20 @@ var _ = Describe("V2DownloadInfraEnvFiles", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("GRUB without mac with script type BootOrderControl", func() {
+			content := getResponseData("grub-config", false, swag.String(BootOrderControl), "", infraEnvID)
+			configfileRegex := regexp.MustCompile(`^configfile '\((\w+),([^)]+)\)(.*file_name=grub-config)'"&mac=[$]{net_default_mac}"$`)
+			match := configfileRegex.FindStringSubmatch(strings.Split(string(content), "\n")[0])
+			Expect(match).NotTo(BeNil())
+			Expect(string(content)).To(HaveSuffix("set default=0\nset timeout=1\nmenuentry 'Local disk' {\n    exit\n}\n"))
+
+			configURL, err := url.Parse(match[3])
+			Expect(err).NotTo(HaveOccurred())
+			tok := configURL.Query().Get("api_key")
+			_, err = bm.authHandler.AuthURLAuth(tok)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("signs the initrd ipxe-script url correctly", func() {
 
 			mockOSImages.EXPECT().GetOsImageOrLatest(common.TestDefaultConfig.OpenShiftVersion, gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
@@ -15754,7 +16148,9 @@ var _ = Describe("RegisterCluster", func() {
 				}).Times(1)
 
 			mockOSImages.EXPECT().GetCPUArchitectures(gomock.Any()).Return([]string{common.X86CPUArchitecture, common.S390xCPUArchitecture}).Times(1)
+			mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 			mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 
 			params := getDefaultClusterCreateParams()
 			params.Platform = &models.Platform{
@@ -15839,7 +16235,9 @@ var _ = Describe("RegisterCluster", func() {
 						TimeoutSeconds: 30 * 60,
 					}), nil
 				}).Times(1)
+			mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 			mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 
 			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
 				NewClusterParams: params,
@@ -15857,7 +16255,9 @@ var _ = Describe("RegisterCluster", func() {
 				}).Times(1)
 			mockOSImages.EXPECT().GetCPUArchitectures(gomock.Any()).Return([]string{common.X86CPUArchitecture, common.S390xCPUArchitecture}).Times(1)
 
+			mockOperatorManager.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).Return(nil)
 			mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockOperatorManager.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
 
 			params := getDefaultClusterCreateParams()
 			params.Platform = &models.Platform{
@@ -18360,17 +18760,31 @@ var _ = Describe("V2GetHostIgnition and V2DownloadHostIgnition", func() {
 		verifyApiError(resp, http.StatusInternalServerError)
 	})
 
+	downloadReturnsContent := func(times int) {
+		mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/master-%s.ign", clusterID, hostID)).
+			DoAndReturn(func(context.Context, string) (io.ReadCloser, int64, error) {
+				return io.NopCloser(bytes.NewReader([]byte("test"))), int64(4), nil
+			}).Times(times)
+	}
+
+	writeResponse := func(resp middleware.Responder, producer runtime.Producer) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		resp.WriteResponse(recorder, producer)
+		return recorder
+	}
+
 	It("return the correct content", func() {
-		r := io.NopCloser(bytes.NewReader([]byte("test")))
-		mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/master-%s.ign", clusterID, hostID)).Return(r, int64(4), nil).Times(2)
+		downloadReturnsContent(2)
 
 		getParams := installer.V2GetHostIgnitionParams{
 			InfraEnvID: infraEnvID,
 			HostID:     hostID,
 		}
 		resp := bm.V2GetHostIgnition(ctx, getParams)
-		Expect(resp).To(BeAssignableToTypeOf(&installer.V2GetHostIgnitionOK{}))
-		replyPayload := resp.(*installer.V2GetHostIgnitionOK).Payload
+		Expect(resp).To(BeAssignableToTypeOf(&downloadAuditResponder{}))
+		next := resp.(*downloadAuditResponder).GetNext()
+		Expect(next).To(BeAssignableToTypeOf(&installer.V2GetHostIgnitionOK{}))
+		replyPayload := next.(*installer.V2GetHostIgnitionOK).Payload
 		Expect(replyPayload.Config).Should(Equal("test"))
 
 		downloadParams := installer.V2DownloadHostIgnitionParams{
@@ -18378,9 +18792,219 @@ var _ = Describe("V2GetHostIgnition and V2DownloadHostIgnition", func() {
 			HostID:     hostID,
 		}
 		resp = bm.V2DownloadHostIgnition(ctx, downloadParams)
-		Expect(resp).Should(Equal(filemiddleware.NewResponder(installer.NewV2DownloadHostIgnitionOK().WithPayload(r),
+		Expect(resp).To(BeAssignableToTypeOf(&downloadAuditResponder{}))
+		Expect(resp.(*downloadAuditResponder).GetNext()).Should(Equal(filemiddleware.NewResponder(installer.NewV2DownloadHostIgnitionOK().WithPayload(io.NopCloser(bytes.NewReader([]byte("test")))),
 			fmt.Sprintf("master-%s.ign", hostID), 4, nil)))
 	})
+
+	It("records an audit record for every download once the response is written", func() {
+		downloadReturnsContent(2)
+
+		getResp := bm.V2GetHostIgnition(ctx, installer.V2GetHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		downloadResp := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		var count int64
+		Expect(db.Model(&common.DownloadAuditRecord{}).Where("host_id = ?", hostID).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+
+		Expect(writeResponse(getResp, runtime.JSONProducer()).Code).To(Equal(http.StatusOK))
+		recorder := writeResponse(downloadResp, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal("test"))
+
+		var records []*common.DownloadAuditRecord
+		Expect(db.Where("host_id = ?", hostID).Order("id").Find(&records).Error).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(*records[0].FileType).To(Equal(models.DownloadAuditRecordFileTypeHostIgnitionParams))
+		Expect(*records[1].FileType).To(Equal(models.DownloadAuditRecordFileTypeHostIgnition))
+		contentHash := sha256.Sum256([]byte("test"))
+		for _, record := range records {
+			Expect(record.FileName).To(Equal(fmt.Sprintf("master-%s.ign", hostID)))
+			Expect(*record.ClusterID).To(Equal(clusterID))
+			Expect(*record.InfraEnvID).To(Equal(infraEnvID))
+			Expect(record.ContentSha256).To(Equal(hex.EncodeToString(contentHash[:])))
+		}
+	})
+
+	Context("when the host ignition may only be downloaded once", func() {
+		BeforeEach(func() {
+			bm.HostIgnitionDownloadOnce = true
+		})
+
+		It("allows a single download", func() {
+			downloadReturnsContent(2)
+
+			resp := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(writeResponse(resp, runtime.ByteStreamProducer()).Code).To(Equal(http.StatusOK))
+			resp = bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			verifyApiError(resp, http.StatusConflict)
+		})
+
+		It("allows a single download of concurrently issued responses", func() {
+			downloadReturnsContent(2)
+
+			first := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			second := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(writeResponse(first, runtime.ByteStreamProducer()).Code).To(Equal(http.StatusOK))
+			Expect(writeResponse(second, runtime.ByteStreamProducer()).Code).To(Equal(http.StatusConflict))
+		})
+
+		It("doesn't count responses that weren't written", func() {
+			downloadReturnsContent(2)
+
+			_ = bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			resp := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(writeResponse(resp, runtime.ByteStreamProducer()).Code).To(Equal(http.StatusOK))
+		})
+
+		It("records the download before writing the response", func() {
+			downloadReturnsContent(1)
+
+			resp := bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(resp).To(BeAssignableToTypeOf(&downloadAuditResponder{}))
+			next := resp.(*downloadAuditResponder).next
+			resp.(*downloadAuditResponder).next = middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+				var count int64
+				Expect(db.Model(&common.DownloadAuditRecord{}).Where("host_id = ?", hostID).Count(&count).Error).ToNot(HaveOccurred())
+				Expect(count).To(Equal(int64(1)))
+				next.WriteResponse(rw, producer)
+			})
+			Expect(writeResponse(resp, runtime.ByteStreamProducer()).Code).To(Equal(http.StatusOK))
+		})
+
+		It("counts fetching the ignition as JSON", func() {
+			downloadReturnsContent(1)
+
+			resp := bm.V2GetHostIgnition(ctx, installer.V2GetHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			Expect(writeResponse(resp, runtime.JSONProducer()).Code).To(Equal(http.StatusOK))
+			downloadReturnsContent(1)
+			resp = bm.V2DownloadHostIgnition(ctx, installer.V2DownloadHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			verifyApiError(resp, http.StatusConflict)
+			downloadReturnsContent(1)
+			resp = bm.V2GetHostIgnition(ctx, installer.V2GetHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+			verifyApiError(resp, http.StatusConflict)
+		})
+	})
+})
+
+var _ = Describe("V2VerifyHostIgnition", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		record     *models.IgnitionGenerationRecord
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = clusterID
+		status := models.ClusterStatusInstalling
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Status: &status}}).Error).ShouldNot(HaveOccurred())
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
+
+		record = &models.IgnitionGenerationRecord{
+			ClusterID:         clusterID,
+			ReleaseImage:      "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64",
+			InstallerDigest:   ignition.ContentSHA256([]byte("installer")),
+			ManifestsRevision: ignition.ContentSHA256([]byte("manifests")),
+			InstallConfigHash: ignition.ContentSHA256([]byte("install-config")),
+			Ignitions: []*models.IgnitionContentHash{
+				{FileName: "master.ign", Role: models.HostRoleMaster, Sha256: ignition.ContentSHA256([]byte("pointer"))},
+				{FileName: fmt.Sprintf("master-%s.ign", hostID), Role: models.HostRoleMaster, HostID: hostID, Sha256: ignition.ContentSHA256([]byte("test"))},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	mockObject := func(fileName string, content []byte) {
+		objectName := fmt.Sprintf("%s/%s", clusterID, fileName)
+		mockS3Client.EXPECT().DoesObjectExist(ctx, objectName).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().Download(ctx, objectName).
+			Return(io.NopCloser(bytes.NewReader(content)), int64(len(content)), nil).Times(1)
+	}
+
+	mockRecord := func() {
+		content, err := json.Marshal(record)
+		Expect(err).ShouldNot(HaveOccurred())
+		mockObject(ignition.GenerationRecordFileName, content)
+	}
+
+	verify := func() *models.HostIgnitionVerification {
+		resp := bm.V2VerifyHostIgnition(ctx, installer.V2VerifyHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(resp).To(BeAssignableToTypeOf(&installer.V2VerifyHostIgnitionOK{}))
+		return resp.(*installer.V2VerifyHostIgnitionOK).Payload
+	}
+
+	It("matches when the served ignition is the generated ignition", func() {
+		mockRecord()
+		mockObject(fmt.Sprintf("master-%s.ign", hostID), []byte("test"))
+
+		verification := verify()
+		Expect(verification.Matches).To(BeTrue())
+		Expect(verification.HostID).To(Equal(hostID))
+		Expect(verification.FileName).To(Equal(fmt.Sprintf("master-%s.ign", hostID)))
+		Expect(verification.ServedSha256).To(Equal(ignition.ContentSHA256([]byte("test"))))
+		Expect(verification.ExpectedSha256).To(Equal(verification.ServedSha256))
+		Expect(verification.GenerationRecord.ReleaseImage).To(Equal(record.ReleaseImage))
+		Expect(verification.GenerationRecord.InstallerDigest).To(Equal(record.InstallerDigest))
+		Expect(verification.GenerationRecord.ManifestsRevision).To(Equal(record.ManifestsRevision))
+		Expect(verification.GenerationRecord.InstallConfigHash).To(Equal(record.InstallConfigHash))
+	})
+
+	It("doesn't match when the served ignition was modified", func() {
+		mockRecord()
+		mockObject(fmt.Sprintf("master-%s.ign", hostID), []byte("modified"))
+
+		verification := verify()
+		Expect(verification.Matches).To(BeFalse())
+		Expect(verification.ServedSha256).To(Equal(ignition.ContentSHA256([]byte("modified"))))
+		Expect(verification.ExpectedSha256).To(Equal(ignition.ContentSHA256([]byte("test"))))
+	})
+
+	It("doesn't record the verification as a download", func() {
+		bm.HostIgnitionDownloadOnce = true
+		mockRecord()
+		mockObject(fmt.Sprintf("master-%s.ign", hostID), []byte("test"))
+
+		Expect(verify().Matches).To(BeTrue())
+		var count int64
+		Expect(db.Model(&common.DownloadAuditRecord{}).Where("host_id = ?", hostID).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("returns not found when the ignitions were generated without a record", func() {
+		objectName := fmt.Sprintf("%s/%s", clusterID, ignition.GenerationRecordFileName)
+		mockS3Client.EXPECT().DoesObjectExist(ctx, objectName).Return(false, nil).Times(1)
+
+		resp := bm.V2VerifyHostIgnition(ctx, installer.V2VerifyHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiError(resp, http.StatusNotFound)
+	})
+
+	It("returns not found when no ignition was generated for the host", func() {
+		record.Ignitions = record.Ignitions[:1]
+		mockRecord()
+
+		resp := bm.V2VerifyHostIgnition(ctx, installer.V2VerifyHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiError(resp, http.StatusNotFound)
+	})
+
+	It("returns conflict when the cluster is in the incorrect status", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("status", models.ClusterStatusInsufficient).Error).ShouldNot(HaveOccurred())
+
+		resp := bm.V2VerifyHostIgnition(ctx, installer.V2VerifyHostIgnitionParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiError(resp, http.StatusConflict)
+	})
 })
 
 var _ = Describe("V2UpdateHostIgnition", func() {
@@ -18437,6 +19061,42 @@ var _ = Describe("V2UpdateHostIgnition", func() {
 		Expect(updated.IgnitionConfigOverrides).To(Equal(override))
 	})
 
+	It("translates a Butane config and saves its source", func() {
+		override := "variant: fcos\nversion: 1.3.0\nstorage:\n  files:\n  - path: /tmp/example\n    contents:\n      inline: hello\n"
+		params := installer.V2UpdateHostIgnitionParams{
+			InfraEnvID:         infraEnvID,
+			HostID:             hostID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: override},
+		}
+		mockUsageReports()
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostDiscoveryIgnitionConfigAppliedEventName),
+			eventstest.WithHostIdMatcher(params.HostID.String()),
+			eventstest.WithInfraEnvIdMatcher(params.InfraEnvID.String())))
+		response := bm.V2UpdateHostIgnition(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateHostIgnitionCreated{}))
+
+		var updated models.Host
+		err := db.First(&updated, "id = ?", hostID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(updated.IgnitionConfigOverridesSource).To(Equal(override))
+		config, err := ignitioncommon.ParseToLatest([]byte(updated.IgnitionConfigOverrides))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.2.0"))
+		Expect(config.Storage.Files[0].Path).To(Equal("/tmp/example"))
+	})
+
+	It("returns bad request when provided an invalid Butane config", func() {
+		override := "variant: fcos\nversion: 1.3.0\nstorage:\n  files:\n  - path: relative\n"
+		params := installer.V2UpdateHostIgnitionParams{
+			InfraEnvID:         infraEnvID,
+			HostID:             hostID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: override},
+		}
+		response := bm.V2UpdateHostIgnition(ctx, params)
+		verifyApiErrorString(response, http.StatusBadRequest, "line 5")
+	})
+
 	It("returns not found with a non-existant infra-env", func() {
 		override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
 		params := installer.V2UpdateHostIgnitionParams{
@@ -18543,6 +19203,160 @@ var _ = Describe("V2UpdateHostIgnition", func() {
 	})
 })
 
+var _ = Describe("Ignition preview", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     string
+	)
+
+	const hostnameOverride = `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/etc/hostname", "contents": {"source": "data:,other"}}]}}`
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		err = db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("V2GetHostIgnitionPreview", func() {
+		It("returns not found for a non-existent host", func() {
+			resp := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{
+				InfraEnvID: infraEnvID,
+				HostID:     strfmt.UUID(uuid.New().String()),
+			})
+			verifyApiError(resp, http.StatusNotFound)
+		})
+
+		It("returns bad request for an unbound host", func() {
+			unboundHostID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{
+				ID:         &unboundHostID,
+				InfraEnvID: infraEnvID,
+				Kind:       swag.String(models.HostKindHost),
+				Status:     swag.String(models.HostStatusKnownUnbound),
+				Inventory:  "{}",
+			}).Error).ShouldNot(HaveOccurred())
+			resp := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{
+				InfraEnvID: infraEnvID,
+				HostID:     unboundHostID,
+			})
+			verifyApiError(resp, http.StatusBadRequest)
+		})
+
+		It("returns the merged pointer ignition and the conflicts", func() {
+			Expect(db.Model(&models.Host{}).Where("id = ?", hostID).Update("ignition_config_overrides", hostnameOverride).Error).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().DoesObjectExist(ctx, fmt.Sprintf("%s/master.ign", clusterID)).Return(false, nil).Times(2)
+
+			resp := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+			})
+			Expect(resp).To(BeAssignableToTypeOf(&installer.V2GetHostIgnitionPreviewOK{}))
+			preview := resp.(*installer.V2GetHostIgnitionPreviewOK).Payload
+			Expect(preview.Ignition).To(ContainSubstring("data:,other"))
+			Expect(preview.Conflicts).To(Equal([]*models.IgnitionOverrideConflict{
+				{Type: models.IgnitionOverrideConflictTypeFile, Name: "/etc/hostname"},
+			}))
+		})
+
+		It("builds the pointer ignition on top of the role ignition", func() {
+			mockS3Client.EXPECT().DoesObjectExist(ctx, fmt.Sprintf("%s/master.ign", clusterID)).Return(true, nil).Times(2)
+			mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/master.ign", clusterID)).DoAndReturn(
+				func(_ context.Context, _ string) (io.ReadCloser, int64, error) {
+					content := `{"ignition": {"version": "3.2.0", "config": {"merge": [{"source": "https://api-int.example.com:22623/config/master"}]}}}`
+					return io.NopCloser(strings.NewReader(content)), int64(len(content)), nil
+				}).Times(2)
+
+			resp := bm.V2GetHostIgnitionPreview(ctx, installer.V2GetHostIgnitionPreviewParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+			})
+			Expect(resp).To(BeAssignableToTypeOf(&installer.V2GetHostIgnitionPreviewOK{}))
+			preview := resp.(*installer.V2GetHostIgnitionPreviewOK).Payload
+			Expect(preview.Ignition).To(ContainSubstring("https://api-int.example.com:22623/config/master"))
+			Expect(preview.Conflicts).To(BeEmpty())
+		})
+	})
+
+	Context("V2GetInfraEnvIgnitionPreview", func() {
+		It("returns not found for a non-existent infra env", func() {
+			resp := bm.V2GetInfraEnvIgnitionPreview(ctx, installer.V2GetInfraEnvIgnitionPreviewParams{
+				InfraEnvID: strfmt.UUID(uuid.New().String()),
+			})
+			verifyApiError(resp, http.StatusNotFound)
+		})
+
+		It("returns the redacted discovery ignition and the conflicts", func() {
+			override := `{"ignition": {"version": "3.2.0"}, "systemd": {"units": [{"name": "agent.service", "enabled": false}]}}`
+			Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("ignition_config_override", override).Error).ShouldNot(HaveOccurred())
+			mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(ctx, gomock.Any(), gomock.Any(), false, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, infraEnv *common.InfraEnv, _ ignition.IgnitionConfig, _ bool, _ auth.AuthType, _ string) (string, error) {
+					const users = `"passwd": {"users": [{"name": "core", "sshAuthorizedKeys": ["ssh-rsa secret-key"]}]}`
+					if infraEnv.IgnitionConfigOverride != "" {
+						return `{"ignition": {"version": "3.2.0"}, ` + users + `, "systemd": {"units": [{"name": "agent.service", "enabled": false}]}}`, nil
+					}
+					return `{"ignition": {"version": "3.2.0"}, ` + users + `, "systemd": {"units": [{"name": "agent.service", "enabled": true}]}}`, nil
+				}).Times(2)
+
+			resp := bm.V2GetInfraEnvIgnitionPreview(ctx, installer.V2GetInfraEnvIgnitionPreviewParams{InfraEnvID: infraEnvID})
+			Expect(resp).To(BeAssignableToTypeOf(&installer.V2GetInfraEnvIgnitionPreviewOK{}))
+			preview := resp.(*installer.V2GetInfraEnvIgnitionPreviewOK).Payload
+			Expect(preview.Ignition).To(ContainSubstring(`"enabled":false`))
+			Expect(preview.Ignition).NotTo(ContainSubstring("secret-key"))
+			Expect(preview.Conflicts).To(Equal([]*models.IgnitionOverrideConflict{
+				{Type: models.IgnitionOverrideConflictTypeUnit, Name: "agent.service"},
+			}))
+		})
+	})
+
+	Context("with shadowing overrides blocked", func() {
+		BeforeEach(func() {
+			bm.BlockShadowingIgnitionOverrides = true
+		})
+
+		It("rejects a host override that replaces the hostname", func() {
+			mockS3Client.EXPECT().DoesObjectExist(ctx, fmt.Sprintf("%s/master.ign", clusterID)).Return(false, nil).Times(1)
+			resp := bm.V2UpdateHostIgnition(ctx, installer.V2UpdateHostIgnitionParams{
+				InfraEnvID:         infraEnvID,
+				HostID:             hostID,
+				HostIgnitionParams: &models.HostIgnitionParams{Config: hostnameOverride},
+			})
+			verifyApiErrorString(resp, http.StatusBadRequest, "ignition config override replaces content generated by the service: file /etc/hostname")
+		})
+
+		It("accepts a host override that only adds content", func() {
+			override := `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:,hello"}}]}}`
+			mockS3Client.EXPECT().DoesObjectExist(ctx, fmt.Sprintf("%s/master.ign", clusterID)).Return(false, nil).Times(1)
+			mockUsageReports()
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiscoveryIgnitionConfigAppliedEventName),
+				eventstest.WithHostIdMatcher(hostID.String())))
+			resp := bm.V2UpdateHostIgnition(ctx, installer.V2UpdateHostIgnitionParams{
+				InfraEnvID:         infraEnvID,
+				HostID:             hostID,
+				HostIgnitionParams: &models.HostIgnitionParams{Config: override},
+			})
+			Expect(resp).To(BeAssignableToTypeOf(&installer.V2UpdateHostIgnitionCreated{}))
+		})
+	})
+})
+
 var _ = Describe("V2UpdateHostIgnition - with rhsso auth", func() {
 	var (
 		authCtx      context.Context
@@ -20719,7 +21533,7 @@ var _ = Describe("GetCredentials", func() {
 		mockS3Client.EXPECT().Download(ctx, objectName).Return(io.NopCloser(strings.NewReader("my_password")), int64(0), nil)
 
 		reply := bm.V2GetCredentials(ctx, installer.V2GetCredentialsParams{ClusterID: *c.ID})
-		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetCredentialsOK()))
+		Expect(downloadAuditNext(reply)).Should(BeAssignableToTypeOf(installer.NewV2GetCredentialsOK()))
 	})
 
 	It("Console operator not available", func() {
@@ -21181,10 +21995,10 @@ var _ = Describe("DownloadClusterFiles", func() {
 			}
 
 			r := io.NopCloser(bytes.NewReader([]byte("testfile")))
-			expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterFilesOK().WithPayload(r), fileName, int64(8), nil)
+			expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterFilesOK().WithPayload(io.NopCloser(bytes.NewReader([]byte("testfile")))), fileName, int64(8), nil)
 			mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", *newCluster.ID, fileName)).Return(r, int64(8), nil)
 			resp := bm.V2DownloadClusterFiles(ctx, params)
-			Expect(resp).Should(Equal(expected))
+			Expect(downloadAuditNext(resp)).Should(Equal(expected))
 		}
 	})
 	It("allows downloading kubeconfig-noingress when cluster is installing pending user action", func() {
@@ -21197,10 +22011,10 @@ var _ = Describe("DownloadClusterFiles", func() {
 		}
 
 		r := io.NopCloser(bytes.NewReader([]byte("testfile")))
-		expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterFilesOK().WithPayload(r), fileName, int64(8), nil)
+		expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterFilesOK().WithPayload(io.NopCloser(bytes.NewReader([]byte("testfile")))), fileName, int64(8), nil)
 		mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", *newCluster.ID, fileName)).Return(r, int64(8), nil)
 		resp := bm.V2DownloadClusterFiles(ctx, params)
-		Expect(resp).Should(Equal(expected))
+		Expect(downloadAuditNext(resp)).Should(Equal(expected))
 	})
 })
 
@@ -21279,10 +22093,10 @@ var _ = Describe("[V2] V2DownloadClusterCredentials", func() {
 		}
 
 		r := io.NopCloser(bytes.NewReader([]byte("testfile")))
-		expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterCredentialsOK().WithPayload(r), constants.KubeconfigNoIngress, int64(8), nil)
+		expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterCredentialsOK().WithPayload(io.NopCloser(bytes.NewReader([]byte("testfile")))), constants.KubeconfigNoIngress, int64(8), nil)
 		mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", clusterID, constants.KubeconfigNoIngress)).Return(r, int64(8), nil)
 		resp := bm.V2DownloadClusterCredentials(ctx, params)
-		Expect(resp).Should(Equal(expected))
+		Expect(downloadAuditNext(resp)).Should(Equal(expected))
 	})
 
 	It("v2 allows downloading cluster credentials files", func() {
@@ -21301,15 +22115,102 @@ var _ = Describe("[V2] V2DownloadClusterCredentials", func() {
 				}
 
 				r := io.NopCloser(bytes.NewReader([]byte("testfile")))
-				expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterCredentialsOK().WithPayload(r), fileName, int64(8), nil)
+				expected := filemiddleware.NewResponder(installer.NewV2DownloadClusterCredentialsOK().WithPayload(io.NopCloser(bytes.NewReader([]byte("testfile")))), fileName, int64(8), nil)
 				mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", clusterID, fileName)).Return(r, int64(8), nil)
 				resp := bm.V2DownloadClusterCredentials(ctx, params)
-				Expect(resp).Should(Equal(expected))
+				Expect(downloadAuditNext(resp)).Should(Equal(expected))
 			}
 		}
 	})
+
+	Context("download audit", func() {
+		BeforeEach(func() {
+			c.Status = swag.String(models.ClusterStatusInstalled)
+			Expect(db.Save(c).Error).ShouldNot(HaveOccurred())
+		})
+
+		download := func(authCtx context.Context, fileName string) {
+			mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", clusterID, fileName)).
+				Return(io.NopCloser(bytes.NewReader([]byte("testfile"))), int64(8), nil)
+			resp := bm.V2DownloadClusterCredentials(authCtx, installer.V2DownloadClusterCredentialsParams{
+				ClusterID: clusterID,
+				FileName:  fileName,
+			})
+			Expect(downloadAuditNext(resp)).To(BeAssignableToTypeOf(&filemiddleware.FileMiddlewareResponder{}))
+			recorder := httptest.NewRecorder()
+			resp.WriteResponse(recorder, runtime.JSONProducer())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		}
+
+		It("records the download only once the response was written", func() {
+			mockS3Client.EXPECT().Download(ctx, fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)).
+				Return(io.NopCloser(bytes.NewReader([]byte("testfile"))), int64(8), nil)
+			resp := bm.V2DownloadClusterCredentials(ctx, installer.V2DownloadClusterCredentialsParams{
+				ClusterID: clusterID,
+				FileName:  constants.Kubeconfig,
+			})
+
+			var count int64
+			Expect(db.Model(&common.DownloadAuditRecord{}).Where("cluster_id = ?", clusterID).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).To(BeZero())
+
+			resp.WriteResponse(httptest.NewRecorder(), runtime.JSONProducer())
+			Expect(db.Model(&common.DownloadAuditRecord{}).Where("cluster_id = ?", clusterID).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(1))
+		})
+
+		It("records the principal that downloaded the credentials", func() {
+			payload := &ocm.AuthPayload{Username: "jdoe", Organization: "org", Role: ocm.UserRole}
+			authCtx := context.WithValue(ctx, restapi.AuthKey, payload)
+			authCtx = requestid.ToContext(authCtx, "request-id")
+			download(authCtx, constants.Kubeconfig)
+
+			var record common.DownloadAuditRecord
+			Expect(db.Where("cluster_id = ?", clusterID).Take(&record).Error).ShouldNot(HaveOccurred())
+			Expect(*record.FileType).To(Equal(models.DownloadAuditRecordFileTypeKubeconfig))
+			Expect(record.FileName).To(Equal(constants.Kubeconfig))
+			Expect(record.Principal).To(Equal("jdoe"))
+			Expect(record.PrincipalRole).To(Equal(string(ocm.UserRole)))
+			Expect(record.OrgID).To(Equal("org"))
+			Expect(record.RequestID).To(Equal("request-id"))
+			Expect(record.HostID).To(BeNil())
+		})
+
+		It("lists the audit records to admins", func() {
+			download(ctx, constants.Kubeconfig)
+			download(ctx, constants.KubeadminPassword)
+
+			resp := bm.V2ListDownloadAuditRecords(ctx, installer.V2ListDownloadAuditRecordsParams{
+				ClusterID: &clusterID,
+				FileType:  swag.String(models.DownloadAuditRecordFileTypeKubeadminPassword),
+				Limit:     swag.Int64(1000),
+				Offset:    swag.Int64(0),
+			})
+			Expect(resp).To(BeAssignableToTypeOf(&installer.V2ListDownloadAuditRecordsOK{}))
+			records := resp.(*installer.V2ListDownloadAuditRecordsOK).Payload
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].FileName).To(Equal(constants.KubeadminPassword))
+		})
+
+		It("refuses to list the audit records to other users", func() {
+			payload := &ocm.AuthPayload{Role: ocm.UserRole}
+			authCtx := context.WithValue(ctx, restapi.AuthKey, payload)
+			bm.authzHandler = auth.NewAuthzHandler(auth.GetConfigRHSSO(), nil, common.GetTestLog().WithField("pkg", "auth"), db)
+			resp := bm.V2ListDownloadAuditRecords(authCtx, installer.V2ListDownloadAuditRecordsParams{
+				Limit:  swag.Int64(1000),
+				Offset: swag.Int64(0),
+			})
+			verifyApiError(resp, http.StatusForbidden)
+		})
+	})
 })
 
+// downloadAuditNext returns the responder that records the download wraps
+func downloadAuditNext(resp middleware.Responder) middleware.Responder {
+	ExpectWithOffset(1, resp).To(BeAssignableToTypeOf(&downloadAuditResponder{}))
+	return resp.(*downloadAuditResponder).GetNext()
+}
+
 func validateNetworkConfiguration(cluster *models.Cluster, clusterNetworks *[]*models.ClusterNetwork,
 	serviceNetworks *[]*models.ServiceNetwork, machineNetworks *[]*models.MachineNetwork) {
 	if clusterNetworks != nil {
@@ -21643,6 +22544,12 @@ var _ = Describe("Download presigned cluster credentials", func() {
 		Expect(generateReply).Should(BeAssignableToTypeOf(&installer.V2GetPresignedForClusterCredentialsOK{}))
 		replyPayload := generateReply.(*installer.V2GetPresignedForClusterCredentialsOK).Payload
 		Expect(*replyPayload.URL).Should(Equal("url"))
+
+		var record common.DownloadAuditRecord
+		Expect(db.Where("cluster_id = ?", clusterID.String()).Take(&record).Error).ToNot(HaveOccurred())
+		Expect(*record.FileType).To(Equal(models.DownloadAuditRecordFileTypeKubeconfig))
+		Expect(record.FileName).To(Equal(constants.KubeconfigNoIngress))
+		Expect(record.ContentSha256).To(BeEmpty())
 	})
 
 	It("presigned cluster credentials happy flow", func() {
@@ -21990,6 +22897,36 @@ var _ = Describe("GetInfraEnvPresignedFileURL", func() {
 			Expect(u.Path).To(Equal(fmt.Sprintf("/api/assisted-install/v2/infra-envs/%s/downloads/files", infraEnvID.String())))
 		})
 
+		It("generates a url with no token for grub-config with boot control", func() {
+			payload := getNewURL("grub-config", swag.String(BootOrderControl))
+
+			Expect(payload.ExpiresAt.String()).To(Equal("0001-01-01T00:00:00.000Z"))
+			u, err := url.Parse(*payload.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u.Host).To(Equal(serviceHost))
+			Expect(u.Query().Get("api_key")).To(Equal(""))
+			Expect(u.Query().Get("file_name")).To(Equal("grub-config"))
+			Expect(u.Query().Get("ipxe_script_type")).To(Equal(BootOrderControl))
+			Expect(u.Path).To(Equal(fmt.Sprintf("/api/assisted-install/v2/infra-envs/%s/downloads/files", infraEnvID.String())))
+		})
+
+		It("generates a byid image url for uefi-http-boot", func() {
+			bm.EnableImageService = true
+			bm.ImageServiceBaseURL = "https://images.example.com"
+			Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("type", models.ImageTypeMinimalIso).Error).To(Succeed())
+			mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+			payload := getNewURL("uefi-http-boot", nil)
+
+			Expect(payload.ExpiresAt.String()).To(Equal("0001-01-01T00:00:00.000Z"))
+			Expect(*payload.URL).To(Equal(fmt.Sprintf("https://images.example.com/byid/%s/%s/%s/minimal.iso", infraEnvID,
+				*common.TestDefaultConfig.OsImage.OpenshiftVersion, *common.TestDefaultConfig.OsImage.CPUArchitecture)))
+		})
+
+		It("returns bad request for uefi-http-boot when the image service is disabled", func() {
+			payload := tryGetUrl("uefi-http-boot", nil)
+			verifyApiError(payload, http.StatusBadRequest)
+		})
+
 		It("returns bad request when boot_control is used with discovery.ign", func() {
 			payload := tryGetUrl("discovery.ign", swag.String(BootOrderControl))
 			verifyApiError(payload, http.StatusBadRequest)
@@ -22043,6 +22980,18 @@ var _ = Describe("GetInfraEnvPresignedFileURL", func() {
 			_, err = bm.authHandler.AuthImageAuth(u.Query().Get("image_token"))
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("generates an expiring bytoken image url for uefi-http-boot", func() {
+			bm.EnableImageService = true
+			bm.ImageServiceBaseURL = "https://images.example.com"
+			Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("type", models.ImageTypeFullIso).Error).To(Succeed())
+			mockOSImages.EXPECT().GetOsImageOrLatest(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+			payload := getNewURL("uefi-http-boot", nil)
+
+			Expect(payload.ExpiresAt.String()).ToNot(Equal("0001-01-01T00:00:00.000Z"))
+			Expect(*payload.URL).To(HavePrefix("https://images.example.com/bytoken/"))
+			Expect(*payload.URL).To(HaveSuffix("/full.iso"))
+		})
 	})
 
 	It("returns not found for a missing infra-env", func() {
@@ -268,6 +268,74 @@ func (b *bareMetalInventory) V2GetClusterInstallConfig(ctx context.Context, para
 	return installer.NewV2GetClusterInstallConfigOK().WithPayload(string(cfg))
 }
 
+func (b *bareMetalInventory) V2RenderClusterManifests(ctx context.Context, params installer.V2RenderClusterManifestsParams) middleware.Responder {
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(fmt.Errorf("Failed to get cluster %s: %w", params.ClusterID, err))
+	}
+
+	if common.IsDay2Cluster(cluster) {
+		return common.GenerateErrorResponderWithDefault(
+			fmt.Errorf("The manifests are not available because this cluster resource is used only for adding additional hosts to an existing cluster"),
+			http.StatusBadRequest,
+		)
+	}
+
+	clusterInfraenvs, err := b.getClusterInfraenvs(cluster)
+	if err != nil {
+		return common.GenerateErrorResponder(fmt.Errorf("Failed to get cluster %s infraenvs: %w", params.ClusterID, err))
+	}
+
+	cfg, releaseImage, installerReleaseImageOverride, err := b.getInstallConfigAndReleaseImages(ctx, *cluster, clusterInfraenvs)
+	if err != nil {
+		return common.GenerateErrorResponderWithDefault(err, http.StatusBadRequest)
+	}
+
+	operatorManifests, err := b.getOperatorsOpenshiftManifests(cluster)
+	if err != nil {
+		return common.GenerateErrorResponder(fmt.Errorf("Failed to get cluster %s operator manifests: %w", params.ClusterID, err))
+	}
+
+	rendered, err := b.generator.RenderManifests(ctx, *cluster, cfg, releaseImage, installerReleaseImageOverride, b.ForceInsecurePolicyJson, operatorManifests)
+	if err != nil {
+		log := logutil.FromContext(ctx, b.log)
+		log.WithError(err).Errorf("Failed to render cluster %s manifests", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	// Read-only admins may view the manifests of any cluster, but not its credentials
+	if ocm.PayloadFromContext(ctx).Role == ocm.ReadOnlyAdminRole {
+		if err = rendered.RedactSecrets(); err != nil {
+			return common.GenerateErrorResponder(fmt.Errorf("Failed to redact cluster %s rendered manifests: %w", params.ClusterID, err))
+		}
+	}
+
+	manifests, err := rendered.List(swag.BoolValue(params.Diff))
+	if err != nil {
+		return common.GenerateErrorResponder(fmt.Errorf("Failed to list cluster %s rendered manifests: %w", params.ClusterID, err))
+	}
+	return installer.NewV2RenderClusterManifestsOK().WithPayload(manifests)
+}
+
+// getOperatorsOpenshiftManifests returns the openshift folder manifests of the OLM operators of the cluster, as they
+// are generated when the installation starts
+func (b *bareMetalInventory) getOperatorsOpenshiftManifests(cluster *common.Cluster) (map[string][]byte, error) {
+	result := map[string][]byte{}
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType != models.OperatorTypeOlm {
+			continue
+		}
+		openshiftManifests, _, err := b.operatorManagerApi.GenerateOperatorManifests(cluster, operator)
+		if err != nil {
+			return nil, err
+		}
+		for name, content := range openshiftManifests {
+			result[name] = content
+		}
+	}
+	return result, nil
+}
+
 func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
 	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
 	if err != nil {
@@ -654,7 +722,66 @@ func (b *bareMetalInventory) V2GetCredentials(ctx context.Context, params instal
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewV2GetCredentialsOK().WithPayload(cluster)
+	return &downloadAuditResponder{
+		b:   b,
+		ctx: ctx,
+		record: newDownloadAuditRecord(ctx, models.DownloadAuditRecordFileTypeCredentials, constants.KubeadminPassword,
+			&params.ClusterID, []byte(cluster.Password)),
+		next: installer.NewV2GetCredentialsOK().WithPayload(cluster),
+	}
+}
+
+func (b *bareMetalInventory) V2ListDownloadAuditRecords(ctx context.Context, params installer.V2ListDownloadAuditRecordsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if !b.authzHandler.IsAdmin(ctx) {
+		return common.NewApiError(http.StatusForbidden, errors.New("only admin users are allowed to list download audit records"))
+	}
+
+	db := b.db
+	if params.ClusterID != nil {
+		db = db.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	if params.HostID != nil {
+		db = db.Where("host_id = ?", params.HostID.String())
+	}
+	if params.FileType != nil {
+		db = db.Where("file_type = ?", *params.FileType)
+	}
+	if params.Since != nil {
+		db = db.Where("downloaded_at >= ?", time.Time(*params.Since))
+	}
+
+	var records []*common.DownloadAuditRecord
+	err := db.Order("downloaded_at desc, id desc").
+		Limit(int(swag.Int64Value(params.Limit))).
+		Offset(int(swag.Int64Value(params.Offset))).
+		Find(&records).Error
+	if err != nil {
+		log.WithError(err).Error("failed to list download audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	result := make(models.DownloadAuditRecordList, 0, len(records))
+	for _, record := range records {
+		result = append(result, &record.DownloadAuditRecord)
+	}
+	return installer.NewV2ListDownloadAuditRecordsOK().WithPayload(result)
+}
+
+func (b *bareMetalInventory) ListDiscoveryCustomizationRevisions(ctx context.Context, params installer.ListDiscoveryCustomizationRevisionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+		log.WithError(err).Errorf("failed to get infra env %s", params.InfraEnvID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	revisions := models.DiscoveryCustomizationRevisionList{}
+	err := b.db.Where("infra_env_id = ?", params.InfraEnvID.String()).Order("revision desc").Find(&revisions).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to list discovery customization revisions of infra env %s", params.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewListDiscoveryCustomizationRevisionsOK().WithPayload(revisions)
 }
 
 func (b *bareMetalInventory) V2ImportCluster(ctx context.Context, params installer.V2ImportClusterParams) middleware.Responder {
@@ -870,7 +997,10 @@ func (b *bareMetalInventory) V2GetPresignedForClusterCredentials(ctx context.Con
 	url, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, fullFileName, fileName, duration)
 	if err != nil {
 		log.WithError(err).Errorf("failed to generate presigned URL: %s from cluster: %s", params.FileName, params.ClusterID.String())
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusInternalServerError)
+	}
+	if err = b.auditPresignedDownload(ctx, params.ClusterID, fileName); err != nil {
+		return common.GenerateErrorResponder(err)
 	}
 
 	return installer.NewV2GetPresignedForClusterCredentialsOK().WithPayload(&models.PresignedURL{URL: &url})
@@ -988,11 +1118,23 @@ chain %s&mac=${net0/mac}
 `
 
 const ipxeBootScriptFormat = `#!ipxe
-initrd --name initrd %s
-kernel %s initrd=initrd coreos.live.rootfs_url=%s random.trust_cpu=on rd.luks.options=discard ignition.firstboot ignition.platform.id=metal console=tty1 console=ttyS1,115200n8 coreos.inst.persistent-kargs="console=tty1 console=ttyS1,115200n8"%s
-boot
+%sinitrd --name initrd %s
+kernel %s%s initrd=initrd coreos.live.rootfs_url=%s random.trust_cpu=on rd.luks.options=discard ignition.firstboot ignition.platform.id=metal console=tty1 console=ttyS1,115200n8 coreos.inst.persistent-kargs="console=tty1 console=ttyS1,115200n8"%s
+%sboot
 `
 
+// bootArtifactDigestComments returns the expected digests of the boot artifacts as script comments, so that the
+// infrastructure that serves or caches the boot artifacts can verify them
+func bootArtifactDigestComments(bootArtifactURLs *imageservice.BootArtifactURLs) string {
+	var comments strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(bootArtifactURLs.SHA256Sums(), "\n"), "\n") {
+		if line != "" {
+			fmt.Fprintf(&comments, "# sha256 %s\n", line)
+		}
+	}
+	return comments.String()
+}
+
 func (b *bareMetalInventory) hostRedirectIPXEScript(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
 	parsedURL, err := url.Parse(b.ServiceBaseURL)
 	if err != nil {
@@ -1062,62 +1204,191 @@ func kernelArgsToSlice(infraEnv *common.InfraEnv) ([]string, error) {
 	return args, nil
 }
 
-func kernelArgsAppendStr(infraEnv *common.InfraEnv) (string, error) {
+// bootArtifacts returns the kernel, rootfs and signed initrd URLs and the additional kernel arguments that network
+// booted hosts of the infra-env boot with
+func (b *bareMetalInventory) bootArtifacts(ctx context.Context, infraEnv *common.InfraEnv) (*imageservice.BootArtifactURLs, []string, error) {
+	osImage, err := b.osImages.GetOsImageOrLatest(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
+	if err != nil {
+		return nil, nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if osImage.OpenshiftVersion == nil {
+		return nil, nil, errors.Errorf("OS image entry '%+v' missing OpenshiftVersion field", osImage)
+	}
+
+	bootArtifactURLs, err := imageservice.GetBootArtifactURLs(b.ImageServiceBaseURL, infraEnv.ID.String(), osImage, b.insecureIPXEURLs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate boot artifact URLs")
+	}
+
+	bootArtifactURLs.InitrdURL, err = b.signURL(ctx, infraEnv.ID.String(), bootArtifactURLs.InitrdURL, infraEnv.ImageTokenKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign initrd URL")
+	}
 	kernelArguments, err := kernelArgsToSlice(infraEnv)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse kernel arguments %s", swag.StringValue(infraEnv.KernelArguments))
+	}
+	return bootArtifactURLs, kernelArguments, nil
+}
+
+func (b *bareMetalInventory) bootIPXEScript(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
+	bootArtifactURLs, kernelArguments, err := b.bootArtifacts(ctx, infraEnv)
 	if err != nil {
 		return "", err
 	}
+	var kernelArgumentsStr string
 	if len(kernelArguments) > 0 {
-		return " " + strings.Join(kernelArguments, " "), nil
+		kernelArgumentsStr = " " + strings.Join(kernelArguments, " ")
+	}
+	// iPXE can only verify the kernel, as the initrd embeds the discovery ignition of the infra-env. The rootfs is
+	// fetched by the initrd, which verifies it against the digest that the initrd of the OS image embeds.
+	// Verification is opt-in, as iPXE builds without image trust support fail on imgverify and can't detect it.
+	var kernelName, verification string
+	if b.IPXEVerifyKernelSignature && bootArtifactURLs.KernelSignatureURL != "" {
+		kernelName = "--name kernel "
+		verification = fmt.Sprintf("imgverify kernel %s\n", bootArtifactURLs.KernelSignatureURL)
+	}
+	return fmt.Sprintf(ipxeBootScriptFormat, bootArtifactDigestComments(bootArtifactURLs), bootArtifactURLs.InitrdURL,
+		kernelName, bootArtifactURLs.KernelURL, bootArtifactURLs.RootFSURL, kernelArgumentsStr, verification), nil
+}
+
+func (b *bareMetalInventory) infraEnvIPXEScript(ctx context.Context, infraEnv *common.InfraEnv, mac *strfmt.MAC, ipxeScriptType *string) (string, error) {
+	if mac != nil && *mac != "" {
+		if err := b.canServeHostIPXEScript(infraEnv, mac); err != nil {
+			return "", err
+		}
+	} else if swag.StringValue(ipxeScriptType) == BootOrderControl {
+		return b.hostRedirectIPXEScript(ctx, infraEnv)
 	}
-	return "", nil
+	return b.bootIPXEScript(ctx, infraEnv)
 }
 
-func (b *bareMetalInventory) bootIPXEScript(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
-	osImage, err := b.osImages.GetOsImageOrLatest(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
+// grubRedirectConfigFormat loads the config of the host. When the service doesn't serve one, for example because the
+// host is already installed, GRUB carries on with the fallback entry, which exits to the firmware so that the host
+// boots from the next device, its local disk, as it does when iPXE fails to chain the script of the host.
+const grubRedirectConfigFormat = `configfile %s"&mac=${net_default_mac}"
+set default=0
+set timeout=1
+menuentry 'Local disk' {
+    exit
+}
+`
+
+const grubBootConfigFormat = `%sset timeout=1
+menuentry 'Discovery' {
+    linux %s %s random.trust_cpu=on rd.luks.options=discard ignition.firstboot ignition.platform.id=metal console=tty1 console=ttyS1,115200n8 'coreos.inst.persistent-kargs="console=tty1 console=ttyS1,115200n8"'%s
+    initrd %s
+}
+`
+
+// grubQuote quotes a word of a GRUB config so that GRUB doesn't interpret the special characters of URLs
+func grubQuote(word string) string {
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
+
+// grubURL converts an HTTP/S URL to a GRUB file path on the (http) or (https) network device
+func grubURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
 	if err != nil {
-		return "", common.NewApiError(http.StatusBadRequest, err)
+		return "", err
 	}
-	if osImage.OpenshiftVersion == nil {
-		return "", errors.Errorf("OS image entry '%+v' missing OpenshiftVersion field", osImage)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("unsupported scheme %s in GRUB URL %s", u.Scheme, rawURL)
 	}
+	return grubQuote(fmt.Sprintf("(%s,%s)%s", u.Scheme, u.Host, u.RequestURI())), nil
+}
 
-	bootArtifactURLs, err := imageservice.GetBootArtifactURLs(b.ImageServiceBaseURL, infraEnv.ID.String(), osImage, b.insecureIPXEURLs)
+func (b *bareMetalInventory) hostRedirectGrubConfig(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
+	parsedURL, err := url.Parse(b.ServiceBaseURL)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to generate boot artifact URLs")
+		return "", err
+	}
+	if b.insecureIPXEURLs {
+		parsedURL.Scheme = "http"
 	}
+	builder := installer.V2DownloadInfraEnvFilesURL{
+		InfraEnvID: *infraEnv.ID,
+		FileName:   "grub-config",
+	}
+	redirectUrl, err := b.signURL(ctx, infraEnv.ID.String(), builder.StringFull(parsedURL.Scheme, parsedURL.Host), infraEnv.ImageTokenKey)
+	if err != nil {
+		return "", err
+	}
+	configPath, err := grubURL(redirectUrl)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(grubRedirectConfigFormat, configPath), nil
+}
 
-	initrdURL, err := b.signURL(ctx, infraEnv.ID.String(), bootArtifactURLs.InitrdURL, infraEnv.ImageTokenKey)
+func (b *bareMetalInventory) bootGrubConfig(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
+	bootArtifactURLs, kernelArguments, err := b.bootArtifacts(ctx, infraEnv)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to sign initrd URL")
+		return "", err
 	}
-	kernelArgumentsStr, err := kernelArgsAppendStr(infraEnv)
+	kernelPath, err := grubURL(bootArtifactURLs.KernelURL)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to parse kernel arguments %s", swag.StringValue(infraEnv.KernelArguments))
+		return "", err
 	}
-	return fmt.Sprintf(ipxeBootScriptFormat, initrdURL, bootArtifactURLs.KernelURL, bootArtifactURLs.RootFSURL, kernelArgumentsStr), nil
+	initrdPath, err := grubURL(bootArtifactURLs.InitrdURL)
+	if err != nil {
+		return "", err
+	}
+	var kernelArgumentsStr string
+	for _, arg := range kernelArguments {
+		kernelArgumentsStr += " " + grubQuote(arg)
+	}
+	return fmt.Sprintf(grubBootConfigFormat, bootArtifactDigestComments(bootArtifactURLs), kernelPath, grubQuote("coreos.live.rootfs_url="+bootArtifactURLs.RootFSURL),
+		kernelArgumentsStr, initrdPath), nil
 }
 
-func (b *bareMetalInventory) infraEnvIPXEScript(ctx context.Context, infraEnv *common.InfraEnv, mac *strfmt.MAC, ipxeScriptType *string) (string, error) {
+// infraEnvGrubConfig returns the grub.cfg of hosts that boot the discovery image with PXE and GRUB or with UEFI HTTP
+// Boot and GRUB. It is served with the same semantics as the iPXE script.
+func (b *bareMetalInventory) infraEnvGrubConfig(ctx context.Context, infraEnv *common.InfraEnv, mac *strfmt.MAC, ipxeScriptType *string) (string, error) {
 	if mac != nil && *mac != "" {
 		if err := b.canServeHostIPXEScript(infraEnv, mac); err != nil {
 			return "", err
 		}
 	} else if swag.StringValue(ipxeScriptType) == BootOrderControl {
-		return b.hostRedirectIPXEScript(ctx, infraEnv)
+		return b.hostRedirectGrubConfig(ctx, infraEnv)
 	}
-	return b.bootIPXEScript(ctx, infraEnv)
+	return b.bootGrubConfig(ctx, infraEnv)
+}
+
+// uefiHTTPBootURL returns the URL of the discovery image that hosts boot from with UEFI HTTP Boot. The URL uses the
+// same short image URL scheme, and expires at the same time, as the discovery image download URL.
+func (b *bareMetalInventory) uefiHTTPBootURL(infraEnv *common.InfraEnv) (string, *strfmt.DateTime, error) {
+	if !b.EnableImageService {
+		return "", nil, common.NewApiError(http.StatusBadRequest, errors.New("image service is disabled"))
+	}
+	osImage, err := b.osImages.GetOsImageOrLatest(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
+	if err != nil {
+		return "", nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if osImage.OpenshiftVersion == nil {
+		return "", nil, errors.Errorf("OS image entry '%+v' missing OpenshiftVersion field", osImage)
+	}
+	return b.generateShortImageDownloadURL(infraEnv.ID.String(), string(common.ImageTypeValue(infraEnv.Type)),
+		*osImage.OpenshiftVersion, infraEnv.CPUArchitecture, infraEnv.ImageTokenKey)
 }
 
 func (b *bareMetalInventory) GetInfraEnvPresignedFileURL(ctx context.Context, params installer.GetInfraEnvPresignedFileURLParams) middleware.Responder {
-	if params.IpxeScriptType != nil && params.FileName != "ipxe-script" {
-		return common.NewApiError(http.StatusBadRequest, errors.New(`ipxe_script_type can be set only for "ipxe-script"`))
+	if params.IpxeScriptType != nil && params.FileName != "ipxe-script" && params.FileName != "grub-config" {
+		return common.NewApiError(http.StatusBadRequest, errors.New(`ipxe_script_type can be set only for "ipxe-script" and "grub-config"`))
 	}
 	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
 
+	if params.FileName == "uefi-http-boot" {
+		bootURL, expiresAt, bootErr := b.uefiHTTPBootURL(infraEnv)
+		if bootErr != nil {
+			return common.GenerateErrorResponder(bootErr)
+		}
+		return installer.NewGetInfraEnvPresignedFileURLOK().WithPayload(&models.PresignedURL{URL: &bootURL, ExpiresAt: *expiresAt})
+	}
+
 	builder := &installer.V2DownloadInfraEnvFilesURL{
 		InfraEnvID:     params.InfraEnvID,
 		FileName:       params.FileName,
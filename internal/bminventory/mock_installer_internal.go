@@ -343,6 +343,21 @@ func (mr *MockInstallerInternalsMockRecorder) InstallSingleDay2HostInternal(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallSingleDay2HostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).InstallSingleDay2HostInternal), ctx, clusterId, infraEnvId, hostId)
 }
 
+// PreviewClusterUpdateInternal mocks base method.
+func (m *MockInstallerInternals) PreviewClusterUpdateInternal(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewClusterUpdateInternal", ctx, params, mirrorRegistryConfiguration)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewClusterUpdateInternal indicates an expected call of PreviewClusterUpdateInternal.
+func (mr *MockInstallerInternalsMockRecorder) PreviewClusterUpdateInternal(ctx, params, mirrorRegistryConfiguration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewClusterUpdateInternal", reflect.TypeOf((*MockInstallerInternals)(nil).PreviewClusterUpdateInternal), ctx, params, mirrorRegistryConfiguration)
+}
+
 // RegisterClusterInternal mocks base method.
 func (m *MockInstallerInternals) RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
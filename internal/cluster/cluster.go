@@ -100,6 +100,8 @@ type API interface {
 	ProgressAPI
 	// Refresh state in case of hosts update
 	RefreshStatus(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error)
+	// Calculate the cluster validations without storing them or running the state machine
+	PreviewValidations(ctx context.Context, c *common.Cluster, db *gorm.DB) (ValidationsStatus, error)
 	ClusterMonitoring()
 	IsOperatorAvailable(c *common.Cluster, operatorName string) bool
 	UploadIngressCert(c *common.Cluster) (err error)
@@ -385,6 +387,21 @@ func (m *Manager) RefreshStatus(ctx context.Context, c *common.Cluster, db *gorm
 	return m.refreshStatusInternal(ctx, cluster, db)
 }
 
+func (m *Manager) PreviewValidations(ctx context.Context, c *common.Cluster, db *gorm.DB) (ValidationsStatus, error) {
+	if db == nil {
+		db = m.db
+	}
+	cluster, err := common.GetClusterFromDBWithHosts(db, *c.ID)
+	if err != nil {
+		return nil, err
+	}
+	_, validationRes, err := m.rp.preprocess(ctx, newClusterValidationContext(cluster, db))
+	if err != nil {
+		return nil, err
+	}
+	return validationRes, nil
+}
+
 func (m *Manager) refreshStatusInternal(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error) {
 	//new transition code
 	if db == nil {
@@ -1847,6 +1864,7 @@ func (m *Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfm
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
+			&common.ManifestLintResult{},
 		}
 		for _, model := range modelsToDelete {
 			if err := common.DeleteRecordsByClusterID(m.db.Unscoped(), *c.ID, []interface{}{model}); err != nil {
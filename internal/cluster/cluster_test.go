@@ -119,6 +119,9 @@ var _ = Describe("stateMachine", func() {
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDKmmRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 			}, nil)
 		})
 
@@ -212,6 +215,9 @@ var _ = Describe("TestClusterMonitoring", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -835,6 +841,9 @@ var _ = Describe("lease timeout event", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -977,6 +986,9 @@ var _ = Describe("Auto assign machine CIDR", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -2463,6 +2475,9 @@ var _ = Describe("Majority groups", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -2810,6 +2825,9 @@ var _ = Describe("ready_state", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -4470,6 +4488,9 @@ var _ = Describe("TestClusterMonitoring - deadlines and blacklisting", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDNodeFeatureDiscoveryRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -30,6 +30,7 @@ const (
 	statusInfoPreparingForInstallation        = "Preparing cluster for installation"
 	statusInfoPreparingForInstallationTimeout = "Preparing cluster for installation timeout"
 	statusInfoFinalizingTimeout               = "Cluster installation timeout while finalizing"
+	statusInfoRequiredOperatorsFailed         = "Cluster installation failed because required OLM operators failed: %s"
 	statusInfoFinalizingStageTimeout          = "Cluster finalizing stage '%s' has been timed out after timeout duration of %d minutes"
 	statusInfoFinalizingStageSoftTimeout      = "Cluster finalizing stage '%s' is taking longer than the expected duration (%d minutes). To troubleshoot use kubeconfig or connect to any node using ssh"
 	statusInfoPendingForInput                 = "User input required"
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/models"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -87,13 +88,14 @@ func finalizingStageDefaultTimeout(stage models.FinalizingStage, softTimeoutEnab
 	return generalWaitTimeout
 }
 
-func finalizingStageTimeout(stage models.FinalizingStage, operators []*models.MonitoredOperator, softTimeoutEnabled bool, log logrus.FieldLogger) time.Duration {
+func finalizingStageTimeout(stage models.FinalizingStage, monitoredOperators []*models.MonitoredOperator, softTimeoutEnabled bool, log logrus.FieldLogger) time.Duration {
 	timeout := finalizingStageDefaultTimeout(stage, softTimeoutEnabled, log)
 	if funk.Contains(olmOperatorFinalizingStages, stage) {
 		timeoutSeconds := timeout.Seconds()
-		for _, m := range operators {
+		for _, m := range monitoredOperators {
 			if m.OperatorType == models.OperatorTypeOlm {
-				timeoutSeconds = math.Max(timeoutSeconds, float64(m.TimeoutSeconds))
+				// Every time that the subscription of the operator is reapplied it has its whole timeout again
+				timeoutSeconds = math.Max(timeoutSeconds, float64(m.TimeoutSeconds*(operators.MaxRetries(m)+1)))
 			}
 		}
 		timeout = time.Duration(timeoutSeconds) * time.Second
@@ -58,6 +58,14 @@ var _ = DescribeTable("finalizing stage timeouts",
 					TimeoutSeconds: toSeconds(21 * time.Second),
 				},
 			}
+			retryOperator = []*models.MonitoredOperator{
+				{
+					OperatorType:   models.OperatorTypeOlm,
+					TimeoutSeconds: toSeconds(10 * time.Hour),
+					FailurePolicy:  models.OperatorFailurePolicyRetry,
+					MaxRetries:     2,
+				},
+			}
 		)
 		// End of variables declaration section
 
@@ -89,6 +97,12 @@ var _ = DescribeTable("finalizing stage timeouts",
 					Entry(fmt.Sprintf("uses the operator timeout  in stage '%s' with environment setting and with operators %s", stage, timeoutStr), stage, operators, softTimeoutEnabled, "123m", 21*time.Hour))
 			}
 
+			// Test cases for OLM stages with an operator whose subscription may be reapplied.  Every attempt has the operator timeout
+			for _, stage := range olmStages {
+				ret = append(ret,
+					Entry(fmt.Sprintf("uses the operator timeout of every attempt in stage '%s' with retry operator %s", stage, timeoutStr), stage, retryOperator, softTimeoutEnabled, "", 30*time.Hour))
+			}
+
 			// Test cases that use the default timeout because operator timeout is too short
 			for _, stage := range olmStages {
 				defaultTimeout := finalizingStageDefaultTimeout(stage, softTimeoutEnabled, log)
@@ -484,6 +484,21 @@ func (mr *MockAPIMockRecorder) PrepareHostLogFile(ctx, c, host, objectHandler an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostLogFile", reflect.TypeOf((*MockAPI)(nil).PrepareHostLogFile), ctx, c, host, objectHandler)
 }
 
+// PreviewValidations mocks base method.
+func (m *MockAPI) PreviewValidations(ctx context.Context, c *common.Cluster, db *gorm.DB) (ValidationsStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewValidations", ctx, c, db)
+	ret0, _ := ret[0].(ValidationsStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewValidations indicates an expected call of PreviewValidations.
+func (mr *MockAPIMockRecorder) PreviewValidations(ctx, c, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewValidations", reflect.TypeOf((*MockAPI)(nil).PreviewValidations), ctx, c, db)
+}
+
 // RefreshSchedulableMastersForcedTrue mocks base method.
 func (m *MockAPI) RefreshSchedulableMastersForcedTrue(ctx context.Context, cluster *common.Cluster) error {
 	m.ctrl.T.Helper()
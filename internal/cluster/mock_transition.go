@@ -427,6 +427,21 @@ func (mr *MockTransitionHandlerMockRecorder) hasClusterCompleteInstallation(sw,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "hasClusterCompleteInstallation", reflect.TypeOf((*MockTransitionHandler)(nil).hasClusterCompleteInstallation), sw, args)
 }
 
+// hasRequiredOperatorsFailed mocks base method.
+func (m *MockTransitionHandler) hasRequiredOperatorsFailed(sw stateswitch.StateSwitch, arg1 stateswitch.TransitionArgs) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "hasRequiredOperatorsFailed", sw, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// hasRequiredOperatorsFailed indicates an expected call of hasRequiredOperatorsFailed.
+func (mr *MockTransitionHandlerMockRecorder) hasRequiredOperatorsFailed(sw, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "hasRequiredOperatorsFailed", reflect.TypeOf((*MockTransitionHandler)(nil).hasRequiredOperatorsFailed), sw, arg1)
+}
+
 // MockEventHandler is a mock of EventHandler interface.
 type MockEventHandler struct {
 	ctrl     *gomock.Controller
@@ -149,6 +149,9 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(AreMetallbRequirementsSatisfied),
 		If(IsLokiRequirementsSatisfied),
 		If(IsOpenShiftLoggingRequirementsSatisfied),
+		If(IsSriovRequirementsSatisfied),
+		If(IsStorageCapacityRequirementsSatisfied),
+		If(AreDeclarativeOperatorsRequirementsSatisfied),
 	)
 
 	// Refresh cluster status conditions - Non DHCP
@@ -523,6 +526,21 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		},
 	})
 
+	sm.AddTransitionRule(stateswitch.TransitionRule{
+		TransitionType:   TransitionTypeRefreshStatus,
+		Condition:        th.hasRequiredOperatorsFailed,
+		SourceStates:     []stateswitch.State{stateswitch.State(models.ClusterStatusFinalizing)},
+		DestinationState: stateswitch.State(models.ClusterStatusError),
+		PostTransition: SequentialPostTransitions(
+			th.PostRefreshCluster(statusInfoRequiredOperatorsFailed, FailedRequiredOperators),
+			th.SendClusterInstallationFailedEvent(statusInfoRequiredOperatorsFailed, FailedRequiredOperators),
+		),
+		Documentation: stateswitch.TransitionRuleDoc{
+			Name:        "Required operators failed",
+			Description: "The installation fails when an OLM operator whose failure policy is required has failed",
+		},
+	})
+
 	sm.AddTransitionRule(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefreshStatus,
 		Condition: stateswitch.And(
@@ -72,6 +72,7 @@ type TransitionHandler interface {
 	IsLogCollectionTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error)
 	areAllHostsDone(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error)
 	hasClusterCompleteInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error)
+	hasRequiredOperatorsFailed(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error)
 	IsFinalizingStageTimedOut(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error)
 	PostRefreshFinalizingStageSoftTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error
 	SoftTimeoutsEnabled(_ stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error)
@@ -298,6 +299,26 @@ func (th *transitionHandler) hasClusterCompleteInstallation(sw stateswitch.State
 	return isComplete, nil
 }
 
+// hasRequiredOperatorsFailed checks if an OLM operator whose failure policy is required has failed, which fails the
+// installation instead of leaving the cluster degraded
+func (th *transitionHandler) hasRequiredOperatorsFailed(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return false, errors.New("hasRequiredOperatorsFailed incompatible type of StateSwitch")
+	}
+	return len(failedRequiredOperators(sCluster.cluster)) > 0, nil
+}
+
+func failedRequiredOperators(cluster *common.Cluster) []string {
+	var ret []string
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.Status == models.OperatorStatusFailed && operators.IsRequired(operator) {
+			ret = append(ret, operator.Name)
+		}
+	}
+	return ret
+}
+
 func (th *transitionHandler) getClusterMonitoringOperatorsStatus(cluster *common.Cluster) (bool, MonitoredOperatorStatuses) {
 	operatorsStatuses := MonitoredOperatorStatuses{
 		models.OperatorTypeOlm: {
@@ -669,6 +690,10 @@ func (th *transitionHandler) PostRefreshCluster(template string, formatFuncs ...
 	return ret
 }
 
+func FailedRequiredOperators(sCluster *stateCluster) interface{} {
+	return strings.Join(failedRequiredOperators(sCluster.cluster), ", ")
+}
+
 func FinalizingStage(sCluster *stateCluster) interface{} {
 	return sCluster.cluster.Progress.FinalizingStage
 }
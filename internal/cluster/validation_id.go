@@ -59,6 +59,9 @@ const (
 	AreMetallbRequirementsSatisfied                = ValidationID(models.ClusterValidationIDMetallbRequirementsSatisfied)
 	IsLokiRequirementsSatisfied                    = ValidationID(models.ClusterValidationIDLokiRequirementsSatisfied)
 	IsOpenShiftLoggingRequirementsSatisfied        = ValidationID(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied)
+	IsSriovRequirementsSatisfied                   = ValidationID(models.ClusterValidationIDSriovRequirementsSatisfied)
+	IsStorageCapacityRequirementsSatisfied         = ValidationID(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)
+	AreDeclarativeOperatorsRequirementsSatisfied   = ValidationID(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)
 )
 
 func (v ValidationID) Category() (string, error) {
@@ -99,7 +102,10 @@ func (v ValidationID) Category() (string, error) {
 		AreOADPRequirementsSatisfied,
 		AreMetallbRequirementsSatisfied,
 		IsLokiRequirementsSatisfied,
-		IsOpenShiftLoggingRequirementsSatisfied:
+		IsOpenShiftLoggingRequirementsSatisfied,
+		IsSriovRequirementsSatisfied,
+		IsStorageCapacityRequirementsSatisfied,
+		AreDeclarativeOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
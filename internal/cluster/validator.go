@@ -13,6 +13,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
@@ -341,7 +342,23 @@ func (v *clusterValidator) isNetworkTypeValid(c *clusterPreprocessContext) (Vali
 	return ValidationSuccess, "The cluster has a valid network type"
 }
 
+// maxReportedManifestLintErrors is the number of manifest lint errors that are listed in the validation message
+const maxReportedManifestLintErrors = 5
+
 func (v *clusterValidator) isCustomManifestsRequirementsSatisfied(c *clusterPreprocessContext) (ValidationStatus, string) {
+	issues, err := manifests.LintStoredClusterManifests(c.db, c.cluster)
+	if err != nil {
+		v.log.Errorf("Custom manifest validation failure, failed to lint manifests: %s", err.Error())
+		return ValidationError, "Failed to lint the custom manifests"
+	}
+	if lintErrors := manifests.LintErrors(issues); len(lintErrors) > 0 {
+		if len(lintErrors) > maxReportedManifestLintErrors {
+			lintErrors = append(lintErrors[:maxReportedManifestLintErrors],
+				fmt.Sprintf("and %d more", len(lintErrors)-maxReportedManifestLintErrors))
+		}
+		return ValidationFailure, fmt.Sprintf("Custom manifests have errors: %s.", strings.Join(lintErrors, "; "))
+	}
+
 	requirements := v.getCustomManifestRequirements(c.cluster)
 	if len(requirements) == 0 {
 		return ValidationSuccess, "No custom manifests are required"
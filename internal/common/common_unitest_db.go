@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/encryption"
 	dbpkg "github.com/openshift/assisted-service/pkg/db"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -354,10 +355,14 @@ func openTestDB(dbName string) (*gorm.DB, error) {
 	)
 
 	open := func() (*gorm.DB, error) {
-		return gorm.Open(postgres.Open(dsn), &gorm.Config{
+		db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 			DisableForeignKeyConstraintWhenMigrating: true,
 			Logger:                                   newLogger,
 		})
+		if err != nil {
+			return nil, err
+		}
+		return db, db.Use(encryption.NewPlugin(nil))
 	}
 
 	for attempts := 0; attempts < 30; attempts++ {
@@ -28,6 +28,7 @@ const (
 	NotificationTypeEvent    = "Event"
 	NotificationTypeHost     = "HostState"
 	NotificationTypeInfraEnv = "InfraEnv"
+	NotificationTypeDownload = "DownloadAudit"
 )
 
 type Notifiable interface {
@@ -52,7 +53,7 @@ const (
 type Cluster struct {
 	models.Cluster
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT;serializer:encrypted"`
 
 	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
 	// if the proxy settings were changed while downloading ISO
@@ -169,6 +170,43 @@ func (e *Event) Payload() any {
 	return &e.Event
 }
 
+type DownloadAuditRecord struct {
+	gorm.Model
+	models.DownloadAuditRecord
+}
+
+func (d *DownloadAuditRecord) GetClusterID() *strfmt.UUID {
+	return d.ClusterID
+}
+func (d *DownloadAuditRecord) GetInfraEnvID() *strfmt.UUID {
+	return d.InfraEnvID
+}
+func (d *DownloadAuditRecord) GetHostID() *strfmt.UUID {
+	return d.HostID
+}
+
+func (d *DownloadAuditRecord) NotificationType() string {
+	return NotificationTypeDownload
+}
+
+func (d *DownloadAuditRecord) Payload() any {
+	return &d.DownloadAuditRecord
+}
+
+// ManifestLintResult holds the objects that a cluster manifest defines and the issues that were found in its content
+type ManifestLintResult struct {
+	ClusterID strfmt.UUID `gorm:"primaryKey"`
+	// Path of the manifest, prefaced by the folder that contains it
+	Path           string `gorm:"primaryKey"`
+	ManifestSource string
+	// Objects is the JSON formatted list of the objects that the manifest defines
+	Objects string `gorm:"type:text"`
+	// ContentIssues is the JSON formatted list of the issues that were found in the content of the manifest alone.
+	// The issues that are found by comparing the manifest with the other manifests of the cluster aren't stored,
+	// as they change along with the other manifests and with the hosts of the cluster.
+	ContentIssues string `gorm:"type:text"`
+}
+
 type Host struct {
 	models.Host
 	Approved bool `json:"approved"`
@@ -180,7 +218,7 @@ type Host struct {
 	TriggerMonitorTimestamp time.Time
 
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
-	IgnitionEndpointToken string `json:"ignition_endpoint_token" gorm:"type:TEXT"`
+	IgnitionEndpointToken string `json:"ignition_endpoint_token" gorm:"type:TEXT;serializer:encrypted"`
 
 	// Json formatted string of the additional HTTP headers when fetching the ignition.
 	IgnitionEndpointHTTPHeaders string `json:"ignition_endpoint_http_headers,omitempty" gorm:"type:TEXT"`
@@ -208,7 +246,7 @@ type InfraEnv struct {
 	models.InfraEnv
 
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT;serializer:encrypted"`
 
 	// Namespace of the KubeAPI resource
 	KubeKeyNamespace string `json:"kube_key_namespace"`
@@ -231,7 +269,7 @@ type InfraEnv struct {
 	// TODO Add a helper function(s) to load InfraEnv(s) with eager-loading parameter
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:InfraEnvID;references:ID"`
 
-	ImageTokenKey string `json:"image_token_key"`
+	ImageTokenKey string `json:"image_token_key" gorm:"serializer:encrypted"`
 
 	// Json formatted string containing internal overrides for the default ignition config.
 	// This is used for adding ironic ignition config to the assisted ignition config
@@ -322,6 +360,9 @@ func AutoMigrate(db *gorm.DB) error {
 		&Cluster{},
 		&Event{},
 		&InfraEnv{},
+		&DownloadAuditRecord{},
+		&ManifestLintResult{},
+		&models.DiscoveryCustomizationRevision{},
 		&models.ReleaseImage{},
 		&models.ClusterNetwork{},
 		&models.ServiceNetwork{},
@@ -331,6 +372,11 @@ func AutoMigrate(db *gorm.DB) error {
 	)
 }
 
+// EncryptedModels returns the models that have columns encrypted at rest
+func EncryptedModels() []interface{} {
+	return []interface{}{&Cluster{}, &InfraEnv{}, &Host{}, &models.DiscoveryCustomizationRevision{}}
+}
+
 func LoadTableFromDB(db *gorm.DB, tableName string, conditions ...interface{}) *gorm.DB {
 	// Anytime a cluster is loaded from the database, the network tables need to be ordered
 	// by IP family according to the cluster's primary_ip_stack.
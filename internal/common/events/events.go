@@ -1994,6 +1994,184 @@ func (e *ClusterOperatorStatusEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_operator_retried
+//
+type ClusterOperatorRetriedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    OperatorName string
+    StatusInfo string
+    Attempt int64
+    MaxRetries int64
+}
+
+var ClusterOperatorRetriedEventName string = "cluster_operator_retried"
+
+func NewClusterOperatorRetriedEvent(
+    clusterId strfmt.UUID,
+    operatorName string,
+    statusInfo string,
+    attempt int64,
+    maxRetries int64,
+) *ClusterOperatorRetriedEvent {
+    return &ClusterOperatorRetriedEvent{
+        eventName: ClusterOperatorRetriedEventName,
+        ClusterId: clusterId,
+        OperatorName: operatorName,
+        StatusInfo: statusInfo,
+        Attempt: attempt,
+        MaxRetries: maxRetries,
+    }
+}
+
+func SendClusterOperatorRetriedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    statusInfo string,
+    attempt int64,
+    maxRetries int64,) {
+    ev := NewClusterOperatorRetriedEvent(
+        clusterId,
+        operatorName,
+        statusInfo,
+        attempt,
+        maxRetries,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterOperatorRetriedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    statusInfo string,
+    attempt int64,
+    maxRetries int64,
+    eventTime time.Time) {
+    ev := NewClusterOperatorRetriedEvent(
+        clusterId,
+        operatorName,
+        statusInfo,
+        attempt,
+        maxRetries,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterOperatorRetriedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterOperatorRetriedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterOperatorRetriedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterOperatorRetriedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{operator_name}", fmt.Sprint(e.OperatorName),
+        "{status_info}", fmt.Sprint(e.StatusInfo),
+        "{attempt}", fmt.Sprint(e.Attempt),
+        "{max_retries}", fmt.Sprint(e.MaxRetries),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterOperatorRetriedEvent) FormatMessage() string {
+    s := "Operator {operator_name} failed: {status_info}. Reapplying its subscription, attempt {attempt} of {max_retries}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_operator_retries_exhausted
+//
+type ClusterOperatorRetriesExhaustedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    OperatorName string
+    Retries int64
+}
+
+var ClusterOperatorRetriesExhaustedEventName string = "cluster_operator_retries_exhausted"
+
+func NewClusterOperatorRetriesExhaustedEvent(
+    clusterId strfmt.UUID,
+    operatorName string,
+    retries int64,
+) *ClusterOperatorRetriesExhaustedEvent {
+    return &ClusterOperatorRetriesExhaustedEvent{
+        eventName: ClusterOperatorRetriesExhaustedEventName,
+        ClusterId: clusterId,
+        OperatorName: operatorName,
+        Retries: retries,
+    }
+}
+
+func SendClusterOperatorRetriesExhaustedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    retries int64,) {
+    ev := NewClusterOperatorRetriesExhaustedEvent(
+        clusterId,
+        operatorName,
+        retries,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterOperatorRetriesExhaustedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    retries int64,
+    eventTime time.Time) {
+    ev := NewClusterOperatorRetriesExhaustedEvent(
+        clusterId,
+        operatorName,
+        retries,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterOperatorRetriesExhaustedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterOperatorRetriesExhaustedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterOperatorRetriesExhaustedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterOperatorRetriesExhaustedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{operator_name}", fmt.Sprint(e.OperatorName),
+        "{retries}", fmt.Sprint(e.Retries),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterOperatorRetriesExhaustedEvent) FormatMessage() string {
+    s := "Operator {operator_name} is still failing after its subscription was reapplied {retries} times"
+    return e.format(&s)
+}
+
 //
 // Event finalizing_stage_timed_out
 //
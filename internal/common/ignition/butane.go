@@ -0,0 +1,153 @@
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	butane "github.com/coreos/butane/config"
+	butanecommon "github.com/coreos/butane/config/common"
+	"github.com/coreos/vcontext/report"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	ButaneVariantOpenshift = "openshift"
+	ButaneVariantFcos      = "fcos"
+)
+
+type butaneHeader struct {
+	Variant string `yaml:"variant"`
+	Version string `yaml:"version"`
+}
+
+func parseButaneHeader(content []byte) (*butaneHeader, bool) {
+	header := butaneHeader{}
+	if err := yaml.Unmarshal(content, &header); err != nil {
+		return nil, false
+	}
+	return &header, header.Variant != "" && header.Version != ""
+}
+
+// IsButaneConfig returns true if the content is a Butane config, that is a YAML document with top level variant and
+// version fields. Ignition configs and Kubernetes manifests are never detected as Butane configs.
+func IsButaneConfig(content []byte) bool {
+	_, ok := parseButaneHeader(content)
+	return ok
+}
+
+// TranslateButaneToMachineConfig translates a Butane config of the openshift variant into a MachineConfig YAML manifest.
+// The Butane version must not be newer than the OpenShift version of the cluster, so that the generated MachineConfig
+// carries an Ignition spec version that the cluster supports.
+func TranslateButaneToMachineConfig(content []byte, openshiftVersion string) ([]byte, error) {
+	header, ok := parseButaneHeader(content)
+	if !ok {
+		return nil, errors.New("content is not a Butane config, the variant and version fields are required")
+	}
+	if header.Variant != ButaneVariantOpenshift {
+		return nil, errors.Errorf("Butane variant %s is not supported for manifests, only the %s variant can be used",
+			header.Variant, ButaneVariantOpenshift)
+	}
+	if err := validateButaneOpenshiftVersion(header.Version, openshiftVersion); err != nil {
+		return nil, err
+	}
+	return translateButane(content, false)
+}
+
+// TranslateButaneToIgnition translates a Butane config of the openshift or fcos variant into an Ignition config that can
+// be used as an ignition config override. Butane versions of the openshift variant must not be newer than the OpenShift
+// version, and the resulting Ignition config must be of a spec version supported for ignition config overrides.
+func TranslateButaneToIgnition(content []byte, openshiftVersion string) ([]byte, error) {
+	header, ok := parseButaneHeader(content)
+	if !ok {
+		return nil, errors.New("content is not a Butane config, the variant and version fields are required")
+	}
+	switch header.Variant {
+	case ButaneVariantOpenshift:
+		if err := validateButaneOpenshiftVersion(header.Version, openshiftVersion); err != nil {
+			return nil, err
+		}
+	case ButaneVariantFcos:
+	default:
+		return nil, errors.Errorf("Butane variant %s is not supported for ignition config overrides, only the %s and %s variants can be used",
+			header.Variant, ButaneVariantOpenshift, ButaneVariantFcos)
+	}
+
+	ignition, err := translateButane(content, true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = ParseToLatest(ignition); err != nil {
+		return nil, errors.Errorf("Butane %s version %s translates to Ignition spec version %s, which is not supported for ignition config overrides",
+			header.Variant, header.Version, ignitionSpecVersion(ignition))
+	}
+	return ignition, nil
+}
+
+// TranslateIgnitionConfigOverride returns the Ignition config of an ignition config override, and its Butane source if
+// the override is a Butane config. Overrides that are not Butane configs are returned as they are, with an empty source.
+func TranslateIgnitionConfigOverride(override string, openshiftVersion string) (string, string, error) {
+	if override == "" || !IsButaneConfig([]byte(override)) {
+		return override, "", nil
+	}
+	ignition, err := TranslateButaneToIgnition([]byte(override), openshiftVersion)
+	if err != nil {
+		return "", "", err
+	}
+	return string(ignition), override, nil
+}
+
+func validateButaneOpenshiftVersion(butaneVersion, openshiftVersion string) error {
+	if openshiftVersion == "" {
+		return nil
+	}
+	butaneMajorMinor, err := common.GetMajorMinorVersion(butaneVersion)
+	if err != nil {
+		return errors.Errorf("Butane version %s is invalid", butaneVersion)
+	}
+	openshiftMajorMinor, err := common.GetMajorMinorVersion(openshiftVersion)
+	if err != nil {
+		return errors.Errorf("OpenShift version %s is invalid", openshiftVersion)
+	}
+	supported, err := common.VersionGreaterOrEqual(*openshiftMajorMinor, *butaneMajorMinor)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compare Butane version %s with OpenShift version %s", butaneVersion, openshiftVersion)
+	}
+	if !supported {
+		return errors.Errorf("Butane %s version %s is newer than the OpenShift version %s, use version %s.0 or older",
+			ButaneVariantOpenshift, butaneVersion, openshiftVersion, *openshiftMajorMinor)
+	}
+	return nil
+}
+
+func translateButane(content []byte, raw bool) ([]byte, error) {
+	output, translationReport, err := butane.TranslateBytes(content, butanecommon.TranslateBytesOptions{Raw: raw})
+	if err != nil {
+		return nil, butaneTranslationError(err, translationReport)
+	}
+	if translationReport.IsFatal() {
+		return nil, butaneTranslationError(errors.New("the Butane config is invalid"), translationReport)
+	}
+	return output, nil
+}
+
+func butaneTranslationError(err error, translationReport report.Report) error {
+	if len(translationReport.Entries) == 0 {
+		return errors.Errorf("failed to translate Butane config: %s", err.Error())
+	}
+	return errors.Errorf("failed to translate Butane config: %s: %s", err.Error(), strings.TrimSpace(translationReport.String()))
+}
+
+func ignitionSpecVersion(ignition []byte) string {
+	config := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal(ignition, &config); err != nil {
+		return fmt.Sprintf("<%s>", err.Error())
+	}
+	return config.Ignition.Version
+}
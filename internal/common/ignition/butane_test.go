@@ -0,0 +1,117 @@
+package ignition
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const openshiftButane = `variant: openshift
+version: 4.12.0
+metadata:
+  name: 99-worker-custom
+  labels:
+    machineconfiguration.openshift.io/role: worker
+storage:
+  files:
+  - path: /etc/example
+    mode: 0644
+    contents:
+      inline: hello
+`
+
+const fcosButane = `variant: fcos
+version: 1.3.0
+storage:
+  files:
+  - path: /etc/example
+    contents:
+      inline: hello
+`
+
+var _ = Describe("IsButaneConfig", func() {
+	It("detects Butane configs", func() {
+		Expect(IsButaneConfig([]byte(openshiftButane))).To(BeTrue())
+		Expect(IsButaneConfig([]byte(fcosButane))).To(BeTrue())
+	})
+
+	It("doesn't detect Ignition configs and manifests", func() {
+		Expect(IsButaneConfig([]byte(`{"ignition": {"version": "3.2.0"}}`))).To(BeFalse())
+		Expect(IsButaneConfig([]byte("apiVersion: v1\nkind: ConfigMap\n"))).To(BeFalse())
+		Expect(IsButaneConfig([]byte("not: [valid"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("TranslateButaneToMachineConfig", func() {
+	It("translates an openshift Butane config", func() {
+		machineConfig, err := TranslateButaneToMachineConfig([]byte(openshiftButane), "4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(machineConfig)).To(ContainSubstring("kind: MachineConfig"))
+		Expect(string(machineConfig)).To(ContainSubstring("name: 99-worker-custom"))
+		Expect(string(machineConfig)).To(ContainSubstring("version: 3.2.0"))
+		Expect(string(machineConfig)).To(ContainSubstring("source: data:,hello"))
+	})
+
+	It("rejects a Butane version newer than the cluster", func() {
+		_, err := TranslateButaneToMachineConfig([]byte(openshiftButane), "4.11.0")
+		Expect(err).To(MatchError("Butane openshift version 4.12.0 is newer than the OpenShift version 4.11.0, use version 4.11.0 or older"))
+	})
+
+	It("rejects the fcos variant", func() {
+		_, err := TranslateButaneToMachineConfig([]byte(fcosButane), "4.14.3")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("only the openshift variant can be used"))
+	})
+
+	It("reports translation errors with their line", func() {
+		_, err := TranslateButaneToMachineConfig([]byte("variant: openshift\nversion: 4.12.0\nmetadata:\n  name: test\n"), "4.14.3")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("machineconfiguration.openshift.io/role label is required"))
+		Expect(err.Error()).To(ContainSubstring("line 4"))
+	})
+})
+
+var _ = Describe("TranslateButaneToIgnition", func() {
+	It("translates an fcos Butane config", func() {
+		ignition, err := TranslateButaneToIgnition([]byte(fcosButane), "4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		config, err := ParseToLatest(ignition)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Ignition.Version).To(Equal("3.2.0"))
+		Expect(config.Storage.Files).To(HaveLen(1))
+		Expect(config.Storage.Files[0].Path).To(Equal("/etc/example"))
+	})
+
+	It("translates an openshift Butane config", func() {
+		ignition, err := TranslateButaneToIgnition([]byte(openshiftButane), "4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ignitionSpecVersion(ignition)).To(Equal("3.2.0"))
+	})
+
+	It("rejects Butane versions that translate to an unsupported Ignition spec version", func() {
+		_, err := TranslateButaneToIgnition([]byte("variant: fcos\nversion: 1.4.0\n"), "4.14.3")
+		Expect(err).To(MatchError("Butane fcos version 1.4.0 translates to Ignition spec version 3.3.0, which is not supported for ignition config overrides"))
+	})
+
+	It("reports translation errors with their line", func() {
+		_, err := TranslateButaneToIgnition([]byte("variant: fcos\nversion: 1.3.0\nstorage:\n  files:\n  - path: relative\n"), "4.14.3")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("line 5"))
+	})
+})
+
+var _ = Describe("TranslateIgnitionConfigOverride", func() {
+	It("returns Ignition overrides as they are", func() {
+		override := `{"ignition": {"version": "3.2.0"}}`
+		ignition, source, err := TranslateIgnitionConfigOverride(override, "4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ignition).To(Equal(override))
+		Expect(source).To(BeEmpty())
+	})
+
+	It("translates Butane overrides and returns their source", func() {
+		ignition, source, err := TranslateIgnitionConfigOverride(fcosButane, "4.14.3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ignitionSpecVersion([]byte(ignition))).To(Equal("3.2.0"))
+		Expect(source).To(Equal(fcosButane))
+	})
+})
@@ -0,0 +1,90 @@
+package ignition
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// FindOverrideConflicts returns the files, directories, links, systemd units and users of the base ignition config
+// that the ignition config override replaces when both are merged. Conflicts are sorted by type and name.
+func FindOverrideConflicts(base []byte, override []byte) ([]*models.IgnitionOverrideConflict, error) {
+	baseConfig, err := ParseToLatest(base)
+	if err != nil {
+		return nil, err
+	}
+	overrideConfig, err := ParseToLatest(override)
+	if err != nil {
+		return nil, err
+	}
+
+	basePaths := map[string]string{}
+	for _, file := range baseConfig.Storage.Files {
+		basePaths[file.Path] = models.IgnitionOverrideConflictTypeFile
+	}
+	for _, directory := range baseConfig.Storage.Directories {
+		basePaths[directory.Path] = models.IgnitionOverrideConflictTypeDirectory
+	}
+	for _, link := range baseConfig.Storage.Links {
+		basePaths[link.Path] = models.IgnitionOverrideConflictTypeLink
+	}
+	baseUnits := map[string]bool{}
+	for _, unit := range baseConfig.Systemd.Units {
+		baseUnits[unit.Name] = true
+	}
+	baseUsers := map[string]bool{}
+	for _, user := range baseConfig.Passwd.Users {
+		baseUsers[user.Name] = true
+	}
+
+	found := map[models.IgnitionOverrideConflict]bool{}
+	addPath := func(path string) {
+		if conflictType, ok := basePaths[path]; ok {
+			found[models.IgnitionOverrideConflict{Type: conflictType, Name: path}] = true
+		}
+	}
+	for _, file := range overrideConfig.Storage.Files {
+		addPath(file.Path)
+	}
+	for _, directory := range overrideConfig.Storage.Directories {
+		addPath(directory.Path)
+	}
+	for _, link := range overrideConfig.Storage.Links {
+		addPath(link.Path)
+	}
+	for _, unit := range overrideConfig.Systemd.Units {
+		if baseUnits[unit.Name] {
+			found[models.IgnitionOverrideConflict{Type: models.IgnitionOverrideConflictTypeUnit, Name: unit.Name}] = true
+		}
+	}
+	for _, user := range overrideConfig.Passwd.Users {
+		if baseUsers[user.Name] {
+			found[models.IgnitionOverrideConflict{Type: models.IgnitionOverrideConflictTypeUser, Name: user.Name}] = true
+		}
+	}
+
+	conflicts := make([]*models.IgnitionOverrideConflict, 0, len(found))
+	for conflict := range found {
+		conflict := conflict
+		conflicts = append(conflicts, &conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].Type != conflicts[j].Type {
+			return conflicts[i].Type < conflicts[j].Type
+		}
+		return conflicts[i].Name < conflicts[j].Name
+	})
+	return conflicts, nil
+}
+
+// FormatOverrideConflicts returns a human readable list of ignition override conflicts, e.g.
+// "file /etc/hostname, unit agent.service"
+func FormatOverrideConflicts(conflicts []*models.IgnitionOverrideConflict) string {
+	items := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		items = append(items, fmt.Sprintf("%s %s", conflict.Type, conflict.Name))
+	}
+	return strings.Join(items, ", ")
+}
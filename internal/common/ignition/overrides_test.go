@@ -0,0 +1,63 @@
+package ignition
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+const baseIgnition = `{
+  "ignition": {"version": "3.2.0"},
+  "passwd": {"users": [{"name": "core", "sshAuthorizedKeys": ["ssh-rsa key"]}]},
+  "storage": {
+    "files": [{"path": "/etc/hostname", "contents": {"source": "data:,master-0"}}],
+    "directories": [{"path": "/etc/assisted"}],
+    "links": [{"path": "/etc/localtime", "target": "/usr/share/zoneinfo/UTC"}]
+  },
+  "systemd": {"units": [{"name": "agent.service", "enabled": true}]}
+}`
+
+var _ = Describe("FindOverrideConflicts", func() {
+	It("finds no conflicts when the override only adds content", func() {
+		override := `{
+		  "ignition": {"version": "3.2.0"},
+		  "passwd": {"users": [{"name": "admin"}]},
+		  "storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}}]},
+		  "systemd": {"units": [{"name": "example.service", "enabled": true}]}
+		}`
+		conflicts, err := FindOverrideConflicts([]byte(baseIgnition), []byte(override))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflicts).To(BeEmpty())
+	})
+
+	It("finds the files, directories, links, units and users that the override replaces", func() {
+		override := `{
+		  "ignition": {"version": "3.1.0"},
+		  "passwd": {"users": [{"name": "core", "sshAuthorizedKeys": ["ssh-rsa other"]}]},
+		  "storage": {
+		    "files": [
+		      {"path": "/etc/localtime", "contents": {"source": "data:,UTC"}},
+		      {"path": "/etc/hostname", "contents": {"source": "data:,other"}}
+		    ],
+		    "directories": [{"path": "/etc/assisted"}]
+		  },
+		  "systemd": {"units": [{"name": "agent.service", "enabled": false}]}
+		}`
+		conflicts, err := FindOverrideConflicts([]byte(baseIgnition), []byte(override))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflicts).To(Equal([]*models.IgnitionOverrideConflict{
+			{Type: models.IgnitionOverrideConflictTypeDirectory, Name: "/etc/assisted"},
+			{Type: models.IgnitionOverrideConflictTypeFile, Name: "/etc/hostname"},
+			{Type: models.IgnitionOverrideConflictTypeLink, Name: "/etc/localtime"},
+			{Type: models.IgnitionOverrideConflictTypeUnit, Name: "agent.service"},
+			{Type: models.IgnitionOverrideConflictTypeUser, Name: "core"},
+		}))
+		Expect(FormatOverrideConflicts(conflicts)).To(Equal(
+			"directory /etc/assisted, file /etc/hostname, link /etc/localtime, unit agent.service, user core"))
+	})
+
+	It("fails on an invalid override", func() {
+		_, err := FindOverrideConflicts([]byte(baseIgnition), []byte(`{"ignition": {"version": "invalid"}}`))
+		Expect(err).To(HaveOccurred())
+	})
+})
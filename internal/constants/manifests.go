@@ -6,3 +6,5 @@ const ManifestSourceAttribute = "assisted-installer-manifest-source"
 const ManifestSourceSystemGenerated = "system"
 const ManifestSourceUserSupplied = "user"
 const LegacyManifestSourceUserSupplied = "user-supplied"
+const ManifestButaneSourceFolder = "manifest-butane-sources"
+const ManifestChecksumAttribute = "assisted-installer-manifest-checksum"
@@ -104,6 +104,7 @@ type AgentReconciler struct {
 	reclaimer                  *agentReclaimer
 	ImageServiceEnabled        bool
 	EnableMetal3               bool
+	SpokeHealthCheckInterval   time.Duration
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -1096,6 +1097,9 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 		validated(agent, status, h)
 		installed(agent, status, swag.StringValue(h.StatusInfo))
 		bound(agent, status)
+		if healthCheckRequeueAfter := r.checkNodeHealth(ctx, log, agent, status); ret.RequeueAfter == 0 {
+			ret.RequeueAfter = healthCheckRequeueAfter
+		}
 	} else {
 		setConditionsUnknown(agent)
 	}
@@ -1770,7 +1774,8 @@ func setAgentLabel(log logrus.FieldLogger, agent *aiv1beta1.Agent, key string, v
 }
 
 func (r *AgentReconciler) updateHostIgnition(ctx context.Context, log logrus.FieldLogger, host *common.Host, agent *aiv1beta1.Agent) error {
-	if agent.Spec.IgnitionConfigOverrides == host.IgnitionConfigOverrides {
+	if agent.Spec.IgnitionConfigOverrides == host.IgnitionConfigOverrides ||
+		(host.IgnitionConfigOverridesSource != "" && agent.Spec.IgnitionConfigOverrides == host.IgnitionConfigOverridesSource) {
 		log.Debugf("Nothing to update, ignition config override was already set")
 		return nil
 	}
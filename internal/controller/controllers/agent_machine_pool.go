@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AgentMachinePoolLabel is set on the Agents that were bound to a cluster by a compute pool of its
+// AgentClusterInstall, with the pool name as value. Only these Agents are unbound on scale-down.
+const AgentMachinePoolLabel = BaseLabelPrefix + "machine-pool"
+
+// reconcileMachinePools binds and unbinds Agents so that each compute pool of an installed cluster
+// that sets replicas has the desired number of day-2 Agents. Newly bound Agents are installed by
+// installDay2Hosts once they are known and approved, and unbound Agents are reclaimed by the Agent
+// controller when possible.
+func (r *ClusterDeploymentsReconciler) reconcileMachinePools(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall) error {
+	var poolsStatus []hiveext.AgentMachinePoolStatus
+	for _, pool := range clusterInstall.Spec.Compute {
+		if pool.Replicas == nil {
+			continue
+		}
+		poolStatus, err := r.reconcileMachinePool(ctx, log.WithField("machine_pool", pool.Name), clusterDeployment, clusterInstall, pool)
+		if err != nil {
+			return err
+		}
+		poolsStatus = append(poolsStatus, poolStatus)
+	}
+	clusterInstall.Status.MachinePools = poolsStatus
+	return nil
+}
+
+func (r *ClusterDeploymentsReconciler) reconcileMachinePool(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall,
+	pool hiveext.AgentMachinePool) (hiveext.AgentMachinePoolStatus, error) {
+	poolStatus := hiveext.AgentMachinePoolStatus{Name: pool.Name, Replicas: *pool.Replicas}
+
+	poolAgents, err := r.findMachinePoolAgents(ctx, clusterDeployment, pool.Name)
+	if err != nil {
+		return poolStatus, err
+	}
+
+	desired := int(*pool.Replicas)
+	switch {
+	case len(poolAgents) > desired:
+		var installing []*aiv1beta1.Agent
+		poolAgents, installing = splitInstallingAgents(poolAgents)
+		sortAgentsForScaleDown(poolAgents)
+		excess := len(poolAgents) + len(installing) - desired
+		if excess > len(poolAgents) {
+			poolStatus.Message = fmt.Sprintf("The pool is waiting for %d Agents to finish installing before scaling down",
+				excess-len(poolAgents))
+			excess = len(poolAgents)
+		}
+		for _, agent := range poolAgents[:excess] {
+			log.Infof("Unbinding Agent %s/%s to scale down machine pool", agent.Namespace, agent.Name)
+			agent.Spec.ClusterDeploymentName = nil
+			agent.Spec.Role = ""
+			agent.Spec.MachineConfigPool = ""
+			delete(agent.Labels, AgentMachinePoolLabel)
+			if err = r.Update(ctx, agent); err != nil {
+				return poolStatus, err
+			}
+		}
+		poolAgents = append(poolAgents[excess:], installing...)
+	case len(poolAgents) < desired:
+		var candidates []*aiv1beta1.Agent
+		candidates, err = r.findMachinePoolCandidates(ctx, clusterInstall, pool)
+		if err != nil {
+			return poolStatus, err
+		}
+		for _, agent := range candidates {
+			if len(poolAgents) == desired {
+				break
+			}
+			log.Infof("Binding Agent %s/%s to scale up machine pool", agent.Namespace, agent.Name)
+			agent.Spec.ClusterDeploymentName = &aiv1beta1.ClusterReference{
+				Name:      clusterDeployment.Name,
+				Namespace: clusterDeployment.Namespace,
+			}
+			agent.Spec.Role = models.HostRoleWorker
+			if pool.MachineConfigPool != "" {
+				agent.Spec.MachineConfigPool = pool.MachineConfigPool
+			}
+			setAgentLabel(log, agent, AgentMachinePoolLabel, pool.Name)
+			if err = r.Update(ctx, agent); err != nil {
+				return poolStatus, err
+			}
+			poolAgents = append(poolAgents, agent)
+		}
+		if len(poolAgents) < desired {
+			poolStatus.Message = fmt.Sprintf("The pool requires %d Agents but only %d unbound and approved Agents match its agentSelector",
+				desired, len(poolAgents))
+		}
+	}
+
+	poolStatus.BoundAgents = int32(len(poolAgents))
+	return poolStatus, nil
+}
+
+// findMachinePoolAgents returns the Agents that the pool bound to the ClusterDeployment.
+func (r *ClusterDeploymentsReconciler) findMachinePoolAgents(ctx context.Context,
+	clusterDeployment *hivev1.ClusterDeployment, poolName string) ([]*aiv1beta1.Agent, error) {
+	agentList := &aiv1beta1.AgentList{}
+	if err := r.List(ctx, agentList, client.MatchingLabels{AgentMachinePoolLabel: poolName}); err != nil {
+		return nil, err
+	}
+	var agents []*aiv1beta1.Agent
+	for i := range agentList.Items {
+		agent := &agentList.Items[i]
+		if agent.Spec.ClusterDeploymentName == nil ||
+			agent.Spec.ClusterDeploymentName.Name != clusterDeployment.Name ||
+			agent.Spec.ClusterDeploymentName.Namespace != clusterDeployment.Namespace {
+			continue
+		}
+		agents = append(agents, agent)
+	}
+	return agents, nil
+}
+
+// findMachinePoolCandidates returns the unbound and approved Agents, in the namespace of the
+// AgentClusterInstall, matching the pool's agentSelector. A pool without a selector has no candidates.
+func (r *ClusterDeploymentsReconciler) findMachinePoolCandidates(ctx context.Context,
+	clusterInstall *hiveext.AgentClusterInstall, pool hiveext.AgentMachinePool) ([]*aiv1beta1.Agent, error) {
+	if pool.AgentSelector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pool.AgentSelector)
+	if err != nil {
+		return nil, newInputError("invalid agentSelector in machine pool %s: %s", pool.Name, err.Error())
+	}
+	if selector.Empty() {
+		return nil, nil
+	}
+
+	agentList := &aiv1beta1.AgentList{}
+	if err = r.List(ctx, agentList, client.InNamespace(clusterInstall.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	var agents []*aiv1beta1.Agent
+	for i := range agentList.Items {
+		agent := &agentList.Items[i]
+		if agent.Spec.ClusterDeploymentName != nil || !agent.Spec.Approved || !agent.DeletionTimestamp.IsZero() {
+			continue
+		}
+		agents = append(agents, agent)
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].Name < agents[j].Name })
+	return agents, nil
+}
+
+// agentInstallingStates are the states of the Agents whose installation started and didn't finish yet. Unbinding
+// them would interrupt the installation, so they aren't unbound on scale-down.
+var agentInstallingStates = []string{
+	models.HostStatusPreparingForInstallation,
+	models.HostStatusPreparingSuccessful,
+	models.HostStatusInstalling,
+	models.HostStatusInstallingInProgress,
+	models.HostStatusInstallingPendingUserAction,
+}
+
+// splitInstallingAgents separates the pool Agents that are being installed from the others.
+func splitInstallingAgents(agents []*aiv1beta1.Agent) ([]*aiv1beta1.Agent, []*aiv1beta1.Agent) {
+	var others, installing []*aiv1beta1.Agent
+	for _, agent := range agents {
+		if funk.ContainsString(agentInstallingStates, agent.Status.DebugInfo.State) {
+			installing = append(installing, agent)
+		} else {
+			others = append(others, agent)
+		}
+	}
+	return others, installing
+}
+
+// sortAgentsForScaleDown orders the pool Agents that aren't being installed so that the ones to unbind
+// first come first: Agents that weren't installed yet, and then the last ones by name.
+func sortAgentsForScaleDown(agents []*aiv1beta1.Agent) {
+	isInstalled := func(agent *aiv1beta1.Agent) bool {
+		return agent.Status.DebugInfo.State == models.HostStatusInstalled ||
+			agent.Status.DebugInfo.State == models.HostStatusAddedToExistingCluster
+	}
+	sort.Slice(agents, func(i, j int) bool {
+		if isInstalled(agents[i]) != isInstalled(agents[j]) {
+			return !isInstalled(agents[i])
+		}
+		return agents[i].Name > agents[j].Name
+	})
+}
+
+// machinePoolsSelectingAgent returns true if a compute pool of the AgentClusterInstall that sets
+// replicas selects the given unbound Agent.
+func machinePoolsSelectingAgent(clusterInstall *hiveext.AgentClusterInstall, agent *aiv1beta1.Agent) bool {
+	if clusterInstall.Namespace != agent.Namespace {
+		return false
+	}
+	for _, pool := range clusterInstall.Spec.Compute {
+		if pool.Replicas == nil || pool.AgentSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pool.AgentSelector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(agent.Labels)) {
+			return true
+		}
+	}
+	return false
+}
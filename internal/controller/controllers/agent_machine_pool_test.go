@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("reconcileMachinePools", func() {
+	var (
+		c                 client.Client
+		r                 *ClusterDeploymentsReconciler
+		ctx               = context.Background()
+		namespace         = "test-namespace"
+		clusterDeployment *hivev1.ClusterDeployment
+		clusterInstall    *hiveext.AgentClusterInstall
+		poolSelector      = map[string]string{"pool": "day2"}
+	)
+
+	newPoolAgent := func(name string, labels map[string]string, spec aiv1beta1.AgentSpec) *aiv1beta1.Agent {
+		agent := newAgent(name, namespace, spec)
+		agent.Labels = labels
+		return agent
+	}
+
+	boundSpec := func() aiv1beta1.AgentSpec {
+		return aiv1beta1.AgentSpec{
+			Approved:              true,
+			Role:                  models.HostRoleWorker,
+			ClusterDeploymentName: &aiv1beta1.ClusterReference{Name: clusterDeployment.Name, Namespace: namespace},
+		}
+	}
+
+	getAgent := func(name string) *aiv1beta1.Agent {
+		agent := &aiv1beta1.Agent{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, agent)).To(Succeed())
+		return agent
+	}
+
+	setReplicas := func(replicas int32) {
+		clusterInstall.Spec.Compute = []hiveext.AgentMachinePool{{
+			Name:              hiveext.WorkerAgentMachinePool,
+			Replicas:          swag.Int32(replicas),
+			AgentSelector:     &metav1.LabelSelector{MatchLabels: poolSelector},
+			MachineConfigPool: "infra",
+		}}
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		r = &ClusterDeploymentsReconciler{Client: c, Log: logrus.New()}
+		clusterDeployment = newClusterDeployment("test-cluster", namespace, hivev1.ClusterDeploymentSpec{})
+		clusterInstall = newAgentClusterInstall("test-aci", namespace, hiveext.AgentClusterInstallSpec{
+			ClusterDeploymentRef: corev1.LocalObjectReference{Name: clusterDeployment.Name},
+		}, clusterDeployment)
+	})
+
+	It("ignores compute pools without replicas", func() {
+		clusterInstall.Spec.Compute = []hiveext.AgentMachinePool{{Name: hiveext.WorkerAgentMachinePool}}
+		Expect(c.Create(ctx, newPoolAgent("agent-1", poolSelector, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+
+		Expect(r.reconcileMachinePools(ctx, r.Log, clusterDeployment, clusterInstall)).To(Succeed())
+		Expect(clusterInstall.Status.MachinePools).To(BeEmpty())
+		Expect(getAgent("agent-1").Spec.ClusterDeploymentName).To(BeNil())
+	})
+
+	It("binds unbound and approved Agents matching the selector to scale up", func() {
+		setReplicas(2)
+		Expect(c.Create(ctx, newPoolAgent("agent-1", poolSelector, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent-2", poolSelector, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent-3", poolSelector, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent-0", poolSelector, aiv1beta1.AgentSpec{Approved: false}))).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent-other", map[string]string{"pool": "other"}, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+
+		Expect(r.reconcileMachinePools(ctx, r.Log, clusterDeployment, clusterInstall)).To(Succeed())
+
+		for _, name := range []string{"agent-1", "agent-2"} {
+			agent := getAgent(name)
+			Expect(agent.Spec.ClusterDeploymentName).To(Equal(&aiv1beta1.ClusterReference{Name: clusterDeployment.Name, Namespace: namespace}))
+			Expect(agent.Spec.Role).To(Equal(models.HostRoleWorker))
+			Expect(agent.Spec.MachineConfigPool).To(Equal("infra"))
+			Expect(agent.Labels).To(HaveKeyWithValue(AgentMachinePoolLabel, hiveext.WorkerAgentMachinePool))
+		}
+		for _, name := range []string{"agent-0", "agent-3", "agent-other"} {
+			Expect(getAgent(name).Spec.ClusterDeploymentName).To(BeNil())
+		}
+		Expect(clusterInstall.Status.MachinePools).To(Equal([]hiveext.AgentMachinePoolStatus{
+			{Name: hiveext.WorkerAgentMachinePool, Replicas: 2, BoundAgents: 2},
+		}))
+	})
+
+	It("reports when not enough Agents match the selector", func() {
+		setReplicas(3)
+		Expect(c.Create(ctx, newPoolAgent("agent-1", poolSelector, aiv1beta1.AgentSpec{Approved: true}))).To(Succeed())
+
+		Expect(r.reconcileMachinePools(ctx, r.Log, clusterDeployment, clusterInstall)).To(Succeed())
+
+		Expect(clusterInstall.Status.MachinePools).To(HaveLen(1))
+		Expect(clusterInstall.Status.MachinePools[0].BoundAgents).To(BeEquivalentTo(1))
+		Expect(clusterInstall.Status.MachinePools[0].Message).To(ContainSubstring("requires 3 Agents but only 1"))
+	})
+
+	It("unbinds the pool Agents that weren't installed first to scale down", func() {
+		setReplicas(1)
+		poolLabels := map[string]string{"pool": "day2", AgentMachinePoolLabel: hiveext.WorkerAgentMachinePool}
+		installed := newPoolAgent("agent-1", poolLabels, boundSpec())
+		installed.Status.DebugInfo.State = models.HostStatusAddedToExistingCluster
+		Expect(c.Create(ctx, installed)).To(Succeed())
+		Expect(c.Create(ctx, newPoolAgent("agent-2", poolLabels, boundSpec()))).To(Succeed())
+		// Agents bound to the cluster outside of the pool are never unbound
+		Expect(c.Create(ctx, newPoolAgent("agent-day1", poolSelector, boundSpec()))).To(Succeed())
+
+		Expect(r.reconcileMachinePools(ctx, r.Log, clusterDeployment, clusterInstall)).To(Succeed())
+
+		Expect(getAgent("agent-1").Spec.ClusterDeploymentName).ToNot(BeNil())
+		Expect(getAgent("agent-day1").Spec.ClusterDeploymentName).ToNot(BeNil())
+		unbound := getAgent("agent-2")
+		Expect(unbound.Spec.ClusterDeploymentName).To(BeNil())
+		Expect(unbound.Spec.Role).To(BeEmpty())
+		Expect(unbound.Labels).ToNot(HaveKey(AgentMachinePoolLabel))
+		Expect(clusterInstall.Status.MachinePools).To(Equal([]hiveext.AgentMachinePoolStatus{
+			{Name: hiveext.WorkerAgentMachinePool, Replicas: 1, BoundAgents: 1},
+		}))
+	})
+
+	It("never unbinds the pool Agents that are being installed", func() {
+		setReplicas(0)
+		poolLabels := map[string]string{"pool": "day2", AgentMachinePoolLabel: hiveext.WorkerAgentMachinePool}
+		installing := newPoolAgent("agent-1", poolLabels, boundSpec())
+		installing.Status.DebugInfo.State = models.HostStatusInstallingInProgress
+		Expect(c.Create(ctx, installing)).To(Succeed())
+		known := newPoolAgent("agent-2", poolLabels, boundSpec())
+		known.Status.DebugInfo.State = models.HostStatusKnown
+		Expect(c.Create(ctx, known)).To(Succeed())
+
+		Expect(r.reconcileMachinePools(ctx, r.Log, clusterDeployment, clusterInstall)).To(Succeed())
+
+		Expect(getAgent("agent-1").Spec.ClusterDeploymentName).ToNot(BeNil())
+		Expect(getAgent("agent-2").Spec.ClusterDeploymentName).To(BeNil())
+		Expect(clusterInstall.Status.MachinePools).To(HaveLen(1))
+		Expect(clusterInstall.Status.MachinePools[0].BoundAgents).To(BeEquivalentTo(1))
+		Expect(clusterInstall.Status.MachinePools[0].Message).To(ContainSubstring("waiting for 1 Agents to finish installing"))
+	})
+})
+
+var _ = Describe("machinePoolsSelectingAgent", func() {
+	var clusterInstall *hiveext.AgentClusterInstall
+
+	BeforeEach(func() {
+		clusterInstall = &hiveext.AgentClusterInstall{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-aci", Namespace: "test-namespace"},
+			Spec: hiveext.AgentClusterInstallSpec{
+				Compute: []hiveext.AgentMachinePool{{
+					Name:          hiveext.WorkerAgentMachinePool,
+					Replicas:      swag.Int32(1),
+					AgentSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "day2"}},
+				}},
+			},
+		}
+	})
+
+	It("matches an Agent selected by a pool in the same namespace", func() {
+		agent := newAgent("agent", "test-namespace", aiv1beta1.AgentSpec{})
+		agent.Labels = map[string]string{"pool": "day2"}
+		Expect(machinePoolsSelectingAgent(clusterInstall, agent)).To(BeTrue())
+	})
+
+	It("doesn't match an Agent in another namespace", func() {
+		agent := newAgent("agent", "other-namespace", aiv1beta1.AgentSpec{})
+		agent.Labels = map[string]string{"pool": "day2"}
+		Expect(machinePoolsSelectingAgent(clusterInstall, agent)).To(BeFalse())
+	})
+
+	It("doesn't match when the pool doesn't set replicas", func() {
+		clusterInstall.Spec.Compute[0].Replicas = nil
+		agent := newAgent("agent", "test-namespace", aiv1beta1.AgentSpec{})
+		agent.Labels = map[string]string{"pool": "day2"}
+		Expect(machinePoolsSelectingAgent(clusterInstall, agent)).To(BeFalse())
+	})
+})
@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	common_api "github.com/openshift/assisted-service/api/common"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// SpecDryRunAnnotation makes the controller report the changes that syncing the AgentClusterInstall Spec would make
+// to the cluster in status.specPreview, instead of applying them. The annotation value must be "true".
+const SpecDryRunAnnotation = aiv1beta1.Group + "/spec-dry-run"
+
+const redactedValue = "<redacted>"
+
+func isSpecDryRun(clusterInstall *hiveext.AgentClusterInstall) bool {
+	return clusterInstall.GetAnnotations()[SpecDryRunAnnotation] == "true"
+}
+
+// previewClusterUpdate calculates the field changes of the update params against the current cluster, and the
+// validation changes that the backend would report if the update was applied. Nothing is applied.
+func (r *ClusterDeploymentsReconciler) previewClusterUpdate(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	params *models.V2ClusterUpdateParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) *hiveext.SpecPreview {
+	preview := &hiveext.SpecPreview{}
+
+	changes, err := clusterUpdateFieldChanges(cluster, params)
+	if err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+	preview.Changes = changes
+
+	updatedCluster, err := r.Installer.PreviewClusterUpdateInternal(ctx, installer.V2UpdateClusterParams{
+		ClusterUpdateParams: params,
+		ClusterID:           *cluster.ID,
+	}, mirrorRegistryConfiguration)
+	if err != nil {
+		log.WithError(err).Infof("Previewed update of cluster %s would fail", cluster.ID.String())
+		preview.Error = err.Error()
+		return preview
+	}
+
+	preview.ValidationChanges, err = clusterValidationChanges(cluster.ValidationsInfo, updatedCluster.ValidationsInfo)
+	if err != nil {
+		preview.Error = err.Error()
+	}
+	return preview
+}
+
+// clusterUpdateFieldChanges compares each field set in the update params with the same field of the cluster,
+// using their REST API JSON representation. The pull secret is reported without its value.
+func clusterUpdateFieldChanges(cluster *common.Cluster, params *models.V2ClusterUpdateParams) ([]hiveext.SpecPreviewFieldChange, error) {
+	desiredFields, err := toJSONFields(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode cluster update params")
+	}
+	currentFields, err := toJSONFields(&cluster.Cluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode cluster")
+	}
+
+	names := make([]string, 0, len(desiredFields))
+	for name := range desiredFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []hiveext.SpecPreviewFieldChange
+	for _, name := range names {
+		if name == "pull_secret" {
+			changes = append(changes, hiveext.SpecPreviewFieldChange{Field: name, Current: redactedValue, Desired: redactedValue})
+			continue
+		}
+		desired := desiredFields[name]
+		if desired == nil {
+			// Fields that are not set in the update params are not updated
+			continue
+		}
+		current := projectJSONValue(currentFields[name], desired)
+		if isZeroJSONValue(desired) && isZeroJSONValue(current) || equality.Semantic.DeepEqual(current, desired) {
+			continue
+		}
+		change := hiveext.SpecPreviewFieldChange{Field: name}
+		if change.Desired, err = encodeJSONValue(desired); err != nil {
+			return nil, err
+		}
+		if change.Current, err = encodeJSONValue(current); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// clusterValidationChanges returns the validations whose status differs between two cluster validations infos.
+func clusterValidationChanges(currentValidationsInfo, desiredValidationsInfo string) ([]hiveext.SpecPreviewValidationChange, error) {
+	current, err := validationResultsByID(currentValidationsInfo)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := validationResultsByID(desiredValidationsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var changes []hiveext.SpecPreviewValidationChange
+	for _, id := range ids {
+		if current[id].Status == desired[id].Status {
+			continue
+		}
+		changes = append(changes, hiveext.SpecPreviewValidationChange{
+			ID:      id,
+			Current: current[id].Status,
+			Desired: desired[id].Status,
+			Message: desired[id].Message,
+		})
+	}
+	return changes, nil
+}
+
+func validationResultsByID(validationsInfo string) (map[string]common_api.ValidationResult, error) {
+	results := map[string]common_api.ValidationResult{}
+	if validationsInfo == "" {
+		return results, nil
+	}
+	validations := common_api.ValidationsStatus{}
+	if err := json.Unmarshal([]byte(validationsInfo), &validations); err != nil {
+		return nil, errors.Wrap(err, "failed to decode validations info")
+	}
+	for _, categoryResults := range validations {
+		for _, result := range categoryResults {
+			results[result.ID] = result
+		}
+	}
+	return results, nil
+}
+
+func toJSONFields(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// projectJSONValue drops from the current value the object keys that are not part of the desired value, such as
+// the IDs the backend adds to networks, so that only the fields that can be updated are compared.
+func projectJSONValue(current, desired interface{}) interface{} {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		currentValue, ok := current.(map[string]interface{})
+		if !ok {
+			return current
+		}
+		projected := map[string]interface{}{}
+		for key := range desiredValue {
+			if value, found := currentValue[key]; found {
+				projected[key] = projectJSONValue(value, desiredValue[key])
+			}
+		}
+		return projected
+	case []interface{}:
+		currentValue, ok := current.([]interface{})
+		if !ok || len(desiredValue) == 0 {
+			return current
+		}
+		projected := make([]interface{}, len(currentValue))
+		for i := range currentValue {
+			projected[i] = projectJSONValue(currentValue[i], desiredValue[0])
+		}
+		return projected
+	default:
+		return current
+	}
+}
+
+func isZeroJSONValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func encodeJSONValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	common_api "github.com/openshift/assisted-service/api/common"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+)
+
+func validationsInfoJSON(statuses map[string]string) string {
+	results := common_api.ValidationResults{}
+	for id, status := range statuses {
+		results = append(results, common_api.ValidationResult{ID: id, Status: status, Message: id + " is " + status})
+	}
+	data, err := json.Marshal(common_api.ValidationsStatus{"network": results})
+	Expect(err).ToNot(HaveOccurred())
+	return string(data)
+}
+
+var _ = Describe("clusterUpdateFieldChanges", func() {
+	var cluster *common.Cluster
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:            &clusterID,
+			Name:          "old-name",
+			BaseDNSDomain: "example.com",
+			ClusterNetworks: []*models.ClusterNetwork{
+				{ClusterID: clusterID, Cidr: "10.128.0.0/14", HostPrefix: 23},
+			},
+		}}
+	})
+
+	It("reports the fields that differ from the cluster", func() {
+		changes, err := clusterUpdateFieldChanges(cluster, &models.V2ClusterUpdateParams{
+			Name:          swag.String("new-name"),
+			BaseDNSDomain: swag.String("example.com"),
+			HTTPProxy:     swag.String(""),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]hiveext.SpecPreviewFieldChange{
+			{Field: "name", Current: `"old-name"`, Desired: `"new-name"`},
+		}))
+	})
+
+	It("ignores the fields that the backend adds to the current value", func() {
+		changes, err := clusterUpdateFieldChanges(cluster, &models.V2ClusterUpdateParams{
+			ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		changes, err = clusterUpdateFieldChanges(cluster, &models.V2ClusterUpdateParams{
+			ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.132.0.0/14", HostPrefix: 23}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]hiveext.SpecPreviewFieldChange{{
+			Field:   "cluster_networks",
+			Current: `[{"cidr":"10.128.0.0/14","host_prefix":23}]`,
+			Desired: `[{"cidr":"10.132.0.0/14","host_prefix":23}]`,
+		}}))
+	})
+
+	It("redacts the pull secret", func() {
+		changes, err := clusterUpdateFieldChanges(cluster, &models.V2ClusterUpdateParams{PullSecret: swag.String("secret")})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]hiveext.SpecPreviewFieldChange{
+			{Field: "pull_secret", Current: redactedValue, Desired: redactedValue},
+		}))
+	})
+})
+
+var _ = Describe("clusterValidationChanges", func() {
+	It("reports the validations whose status changes", func() {
+		current := validationsInfoJSON(map[string]string{"api-vips-defined": "success", "network-type-valid": "success"})
+		desired := validationsInfoJSON(map[string]string{"api-vips-defined": "failure", "network-type-valid": "success"})
+		changes, err := clusterValidationChanges(current, desired)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]hiveext.SpecPreviewValidationChange{
+			{ID: "api-vips-defined", Current: "success", Desired: "failure", Message: "api-vips-defined is failure"},
+		}))
+	})
+
+	It("handles a cluster without validations", func() {
+		changes, err := clusterValidationChanges("", validationsInfoJSON(map[string]string{"api-vips-defined": "pending"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Current).To(BeEmpty())
+	})
+
+	It("fails on invalid validations info", func() {
+		_, err := clusterValidationChanges("not json", "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("previewClusterUpdate", func() {
+	var (
+		ctx                   = context.Background()
+		mockCtrl              *gomock.Controller
+		mockInstallerInternal *bminventory.MockInstallerInternals
+		r                     *ClusterDeploymentsReconciler
+		cluster               *common.Cluster
+		params                *models.V2ClusterUpdateParams
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
+		r = &ClusterDeploymentsReconciler{Installer: mockInstallerInternal, Log: logrus.New()}
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			SSHPublicKey:    "old-key",
+			ValidationsInfo: validationsInfoJSON(map[string]string{"ntp-server-configured": "success"}),
+		}}
+		params = &models.V2ClusterUpdateParams{SSHPublicKey: swag.String("new-key")}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("reports the field and validation changes of the backend preview", func() {
+		updatedCluster := &common.Cluster{Cluster: cluster.Cluster}
+		updatedCluster.ValidationsInfo = validationsInfoJSON(map[string]string{"ntp-server-configured": "failure"})
+		mockInstallerInternal.EXPECT().PreviewClusterUpdateInternal(gomock.Any(), gomock.Any(), gomock.Any()).Return(updatedCluster, nil)
+
+		preview := r.previewClusterUpdate(ctx, r.Log, cluster, params, nil)
+		Expect(preview.Error).To(BeEmpty())
+		Expect(preview.Changes).To(Equal([]hiveext.SpecPreviewFieldChange{
+			{Field: "ssh_public_key", Current: `"old-key"`, Desired: `"new-key"`},
+		}))
+		Expect(preview.ValidationChanges).To(Equal([]hiveext.SpecPreviewValidationChange{
+			{ID: "ntp-server-configured", Current: "success", Desired: "failure", Message: "ntp-server-configured is failure"},
+		}))
+	})
+
+	It("reports the error the backend would return", func() {
+		mockInstallerInternal.EXPECT().PreviewClusterUpdateInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(400, errors.New("invalid ssh key")))
+
+		preview := r.previewClusterUpdate(ctx, r.Log, cluster, params, nil)
+		Expect(preview.Error).To(ContainSubstring("invalid ssh key"))
+		Expect(preview.Changes).To(HaveLen(1))
+		Expect(preview.ValidationChanges).To(BeEmpty())
+	})
+})
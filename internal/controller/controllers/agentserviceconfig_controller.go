@@ -451,6 +451,7 @@ func (r *AgentServiceConfigReconciler) getWebhookComponents() []component {
 		{"AgentClusterInstallMutatingWebHook", aiv1beta1.ReasonMutatingWebHookFailure, newACIMutatWebHook},
 		{"InfraEnvValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newInfraEnvWebHook},
 		{"AgentValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newAgentWebHook},
+		{"NMStateConfigValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newNMStateConfigWebHook},
 		{"WebHookService", aiv1beta1.ReasonWebHookServiceFailure, newWebHookService},
 		{"WebHookNetworkPolicy", aiv1beta1.ReasonNetworkPolicyFailure, newWebhookNetworkPolicy},
 		{"WebHookServiceDeployment", aiv1beta1.ReasonWebHookDeploymentFailure, newWebHookDeployment},
@@ -2543,10 +2544,13 @@ func getOSImages(log logrus.FieldLogger, spec *aiv1beta1.AgentServiceConfigSpec,
 	osImages := make(models.OsImages, 0)
 	for i := range spec.OSImages {
 		osImage := models.OsImage{
-			OpenshiftVersion: &spec.OSImages[i].OpenshiftVersion,
-			URL:              &spec.OSImages[i].Url,
-			Version:          &spec.OSImages[i].Version,
-			CPUArchitecture:  &spec.OSImages[i].CPUArchitecture,
+			OpenshiftVersion:   &spec.OSImages[i].OpenshiftVersion,
+			URL:                &spec.OSImages[i].Url,
+			Version:            &spec.OSImages[i].Version,
+			CPUArchitecture:    &spec.OSImages[i].CPUArchitecture,
+			KernelSha256:       spec.OSImages[i].KernelSHA256,
+			RootfsSha256:       spec.OSImages[i].RootfsSHA256,
+			KernelSignatureURL: spec.OSImages[i].KernelSignatureURL,
 		}
 		osImages = append(osImages, &osImage)
 	}
@@ -2725,6 +2729,61 @@ func newAgentWebHook(ctx context.Context, log logrus.FieldLogger, asc ASC) (clie
 	return &agent, mutateFn, nil
 }
 
+func newNMStateConfigWebHook(ctx context.Context, log logrus.FieldLogger, asc ASC) (client.Object, controllerutil.MutateFn, error) {
+	fp := admregv1.Fail
+	se := admregv1.SideEffectClassNone
+	path := "/apis/admission.agentinstall.openshift.io/v1/nmstateconfigvalidators"
+	webhooks := []admregv1.ValidatingWebhook{
+		{
+			Name:          "nmstateconfigvalidators.admission.agentinstall.openshift.io",
+			FailurePolicy: &fp,
+			SideEffects:   &se,
+			AdmissionReviewVersions: []string{
+				"v1",
+			},
+			ClientConfig: admregv1.WebhookClientConfig{
+				Service: &admregv1.ServiceReference{
+					Namespace: defaultNamespace,
+					Name:      "kubernetes",
+					Path:      &path,
+				},
+			},
+			Rules: []admregv1.RuleWithOperations{
+				{
+					Operations: []admregv1.OperationType{
+						admregv1.Update,
+						admregv1.Create,
+					},
+					Rule: admregv1.Rule{
+						APIGroups: []string{
+							"agent-install.openshift.io",
+						},
+						APIVersions: []string{
+							"v1beta1",
+						},
+						Resources: []string{
+							"nmstateconfigs",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nmStateConfig := admregv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nmstateconfigvalidators.admission.agentinstall.openshift.io",
+		},
+		Webhooks: webhooks,
+	}
+
+	mutateFn := func() error {
+		nmStateConfig.Webhooks = webhooks
+		return nil
+	}
+	return &nmStateConfig, mutateFn, nil
+}
+
 func newACIMutatWebHook(ctx context.Context, log logrus.FieldLogger, asc ASC) (client.Object, controllerutil.MutateFn, error) {
 	fp := admregv1.Fail
 	se := admregv1.SideEffectClassNone
@@ -2931,6 +2990,17 @@ func newWebHookClusterRole(ctx context.Context, log logrus.FieldLogger, asc ASC)
 				"get",
 			},
 		},
+		{
+			APIGroups: []string{
+				"agent-install.openshift.io",
+			},
+			Resources: []string{
+				"infraenvs",
+			},
+			Verbs: []string{
+				"list",
+			},
+		},
 	}
 	cr := rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -25,6 +25,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -120,6 +121,7 @@ type ClusterDeploymentsReconciler struct {
 	VersionsHandler               versions.Handler
 	SpokeK8sClientFactory         spoke_k8s_client.SpokeK8sClientFactory
 	MirrorRegistriesConfigBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder
+	SpokeHealthCheckInterval      time.Duration
 }
 
 const minimalOpenShiftVersionForDefaultNetworkTypeOVNKubernetes = "4.12.0-0.0"
@@ -297,6 +299,13 @@ func (r *ClusterDeploymentsReconciler) reconcileExistingCluster(ctx context.Cont
 		}
 	}
 
+	// While the spec is previewed the installation doesn't start, so the cluster isn't installed with a stale spec
+	if swag.StringValue(cluster.Kind) == models.ClusterKindCluster && isSpecDryRun(clusterInstall) {
+		log.Infof("AgentClusterInstall %s/%s has the %s annotation, the installation doesn't start",
+			clusterInstall.Namespace, clusterInstall.Name, SpecDryRunAnnotation)
+		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, nil)
+	}
+
 	if swag.StringValue(cluster.Kind) == models.ClusterKindCluster &&
 		!IsHoldInstallationSet(clusterInstall, clusterDeployment) {
 		// Day 1
@@ -541,6 +550,10 @@ func (r *ClusterDeploymentsReconciler) updateWorkerMcpPaused(ctx context.Context
 }
 
 func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster) (ctrl.Result, error) {
+	if err := r.reconcileMachinePools(ctx, log, clusterDeployment, clusterInstall); err != nil {
+		log.WithError(err).Errorf("Failed to scale machine pools for cluster %s", cluster.ID.String())
+		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	}
 	hosts, err := r.Installer.GetKnownApprovedHosts(*cluster.ID)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ready and approved hosts for cluster %s", cluster.ID.String())
@@ -554,7 +567,12 @@ func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log
 		}
 	}
 	err = r.updateWorkerMcpPaused(ctx, log, clusterInstall, clusterDeployment)
-	return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	healthCheckRequeueAfter := r.checkSpokeHealth(ctx, log, clusterDeployment, clusterInstall)
+	result, err := r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
+	if err == nil && !result.Requeue && result.RequeueAfter == 0 {
+		result.RequeueAfter = healthCheckRequeueAfter
+	}
+	return result, err
 }
 
 func (r *ClusterDeploymentsReconciler) createNoIngressKubeConfig(ctx context.Context, log logrus.FieldLogger, cluster *hivev1.ClusterDeployment, c *common.Cluster, clusterInstall *hiveext.AgentClusterInstall) error {
@@ -1198,6 +1216,15 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 		update = true
 	}
 
+	if isSpecDryRun(clusterInstall) {
+		clusterInstall.Status.SpecPreview = &hiveext.SpecPreview{}
+		if update {
+			clusterInstall.Status.SpecPreview = r.previewClusterUpdate(ctx, log, cluster, params, mirrorRegistryConfiguration)
+		}
+		return cluster, nil
+	}
+	clusterInstall.Status.SpecPreview = nil
+
 	if !update {
 		return cluster, nil
 	}
@@ -1992,9 +2019,24 @@ func (r *ClusterDeploymentsReconciler) SetupWithManager(mgr ctrl.Manager) error
 					},
 				},
 			}
-		} else {
+		}
+
+		// An unbound Agent may be selected by the compute pools of installed clusters in its namespace
+		clusterInstalls := &hiveext.AgentClusterInstallList{}
+		if err := r.List(ctx, clusterInstalls, client.InNamespace(agent.Namespace)); err != nil {
+			log.WithError(err).Error("failed to list AgentClusterInstalls for Agent mapper")
 			return []reconcile.Request{}
 		}
+		reply := []reconcile.Request{}
+		for i := range clusterInstalls.Items {
+			if machinePoolsSelectingAgent(&clusterInstalls.Items[i], agent) {
+				reply = append(reply, reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: clusterInstalls.Items[i].Namespace,
+					Name:      clusterInstalls.Items[i].Spec.ClusterDeploymentRef.Name,
+				}})
+			}
+		}
+		return reply
 	}
 
 	agentSpecStatusChangedPredicate := builder.WithPredicates(predicate.Funcs{
@@ -2025,6 +2067,12 @@ func (r *ClusterDeploymentsReconciler) SetupWithManager(mgr ctrl.Manager) error
 				}
 			}
 
+			// Unbound Agents may become candidates for the compute pools of installed clusters
+			if newAgent.Spec.ClusterDeploymentName == nil &&
+				(oldAgent.Spec.Approved != newAgent.Spec.Approved || !reflect.DeepEqual(oldAgent.Labels, newAgent.Labels)) {
+				return true
+			}
+
 			return oldSyncStatus != newSyncStatus
 		},
 	})
@@ -2149,6 +2197,7 @@ func (r *ClusterDeploymentsReconciler) updateStatus(ctx context.Context, log log
 			)
 			clusterValidated(clusterInstall, status, c)
 			clusterCompleted(clusterInstall, clusterDeployment, status, swag.StringValue(c.StatusInfo), c.MonitoredOperators)
+			clusterOperatorsStatus(clusterInstall, status, c.MonitoredOperators)
 			clusterFailed(clusterInstall, status, swag.StringValue(c.StatusInfo))
 			clusterStopped(clusterInstall, status)
 			updateLastInstallationPreparationCondition(clusterInstall, c.LastInstallationPreparation.Status, c.LastInstallationPreparation.Reason)
@@ -2424,6 +2473,75 @@ func clusterCompleted(clusterInstall *hiveext.AgentClusterInstall, clusterDeploy
 	})
 }
 
+// clusterOperatorsStatus reports the OLM operators tracked by the cluster monitor on the ACI status,
+// and flags the ones that failed or are still progressing once the cluster is installed.
+func clusterOperatorsStatus(clusterInstall *hiveext.AgentClusterInstall, status string, opers []*models.MonitoredOperator) {
+	operatorsStatus := make([]hiveext.OperatorStatus, 0, len(opers))
+	var failed, progressing []string
+	for _, op := range opers {
+		if op.OperatorType != models.OperatorTypeOlm {
+			continue
+		}
+		operatorStatus := hiveext.OperatorStatus{
+			Name:    op.Name,
+			Version: op.Version,
+			Status:  string(op.Status),
+			Message: op.StatusInfo,
+		}
+		if !time.Time(op.StatusUpdatedAt).IsZero() {
+			lastUpdated := metav1.NewTime(time.Time(op.StatusUpdatedAt))
+			operatorStatus.LastUpdated = &lastUpdated
+		}
+		operatorsStatus = append(operatorsStatus, operatorStatus)
+
+		switch op.Status {
+		case models.OperatorStatusFailed:
+			failed = append(failed, op.Name)
+		case models.OperatorStatusProgressing, "":
+			progressing = append(progressing, op.Name)
+		}
+	}
+	sort.Slice(operatorsStatus, func(i, j int) bool { return operatorsStatus[i].Name < operatorsStatus[j].Name })
+	sort.Strings(failed)
+	sort.Strings(progressing)
+	clusterInstall.Status.Operators = operatorsStatus
+
+	failedCondition := hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterOperatorsFailedCondition,
+		Status:  corev1.ConditionUnknown,
+		Reason:  hiveext.ClusterOperatorsNotInstalledYetReason,
+		Message: hiveext.ClusterOperatorsNotInstalledYetMsg,
+	}
+	progressingCondition := hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterOperatorsProgressingCondition,
+		Status:  corev1.ConditionUnknown,
+		Reason:  hiveext.ClusterOperatorsNotInstalledYetReason,
+		Message: hiveext.ClusterOperatorsNotInstalledYetMsg,
+	}
+	if status == models.ClusterStatusInstalled || status == models.ClusterStatusAddingHosts {
+		if len(failed) > 0 {
+			failedCondition.Status = corev1.ConditionTrue
+			failedCondition.Reason = hiveext.ClusterOperatorsFailedReason
+			failedCondition.Message = fmt.Sprintf("%s %s", hiveext.ClusterOperatorsFailedMsg, strings.Join(failed, ", "))
+		} else {
+			failedCondition.Status = corev1.ConditionFalse
+			failedCondition.Reason = hiveext.ClusterOperatorsNotFailedReason
+			failedCondition.Message = hiveext.ClusterOperatorsNotFailedMsg
+		}
+		if len(progressing) > 0 {
+			progressingCondition.Status = corev1.ConditionTrue
+			progressingCondition.Reason = hiveext.ClusterOperatorsProgressingReason
+			progressingCondition.Message = fmt.Sprintf("%s %s", hiveext.ClusterOperatorsProgressingMsg, strings.Join(progressing, ", "))
+		} else {
+			progressingCondition.Status = corev1.ConditionFalse
+			progressingCondition.Reason = hiveext.ClusterOperatorsNotProgressingReason
+			progressingCondition.Message = hiveext.ClusterOperatorsNotProgressingMsg
+		}
+	}
+	setClusterCondition(&clusterInstall.Status.Conditions, failedCondition)
+	setClusterCondition(&clusterInstall.Status.Conditions, progressingCondition)
+}
+
 func updateLastInstallationPreparationCondition(clusterInstall *hiveext.AgentClusterInstall, installation_preparation_completion_status string, installation_preparation_completion_status_reason string) {
 	var condStatus corev1.ConditionStatus
 	var reason string
@@ -2587,6 +2705,18 @@ func setClusterConditionsUnknown(clusterInstall *hiveext.AgentClusterInstall) {
 		Reason:  hiveext.ClusterNotAvailableReason,
 		Message: hiveext.ClusterNotAvailableMsg,
 	})
+	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterOperatorsFailedCondition,
+		Status:  corev1.ConditionUnknown,
+		Reason:  hiveext.ClusterNotAvailableReason,
+		Message: hiveext.ClusterNotAvailableMsg,
+	})
+	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+		Type:    hiveext.ClusterOperatorsProgressingCondition,
+		Status:  corev1.ConditionUnknown,
+		Reason:  hiveext.ClusterNotAvailableReason,
+		Message: hiveext.ClusterNotAvailableMsg,
+	})
 }
 
 // SetStatusCondition sets the corresponding condition in conditions to newCondition.
@@ -2166,6 +2166,63 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("spec dry-run holds the installation", func() {
+			backEndCluster.Status = swag.String(models.ClusterStatusReady)
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().GetKnownHostApprovedCounts(gomock.Any()).Return(5, 5, nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil).AnyTimes()
+			mockClusterApi.EXPECT().GetHostCountByRole(*backEndCluster.ID, models.HostRoleMaster, true).Return(swag.Int64(3), nil).AnyTimes()
+			mockClusterApi.EXPECT().GetHostCountByRole(*backEndCluster.ID, models.HostRoleWorker, true).Return(swag.Int64(2), nil).AnyTimes()
+			mockClusterApi.EXPECT().GetHostCountByRole(*backEndCluster.ID, models.HostRoleArbiter, true).Return(swag.Int64(0), nil).AnyTimes()
+
+			mockInstallerInternal.EXPECT().PreviewClusterUpdateInternal(gomock.Any(), gomock.Any(), gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+
+			aci = getTestClusterInstall()
+			aci.SetAnnotations(map[string]string{SpecDryRunAnnotation: "true"})
+			Expect(c.Update(ctx, aci)).To(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(aci.Status.SpecPreview).ToNot(BeNil())
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition).Reason).ToNot(Equal(hiveext.ClusterInstallationInProgressReason))
+		})
+
+		It("spec dry-run keeps creating the credentials of installed clusters", func() {
+			backEndCluster.Status = swag.String(models.ClusterStatusInstalled)
+			backEndCluster.OpenshiftClusterID = strfmt.UUID(uuid.New().String())
+			backEndCluster.Kind = swag.String(models.ClusterKindCluster)
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil).AnyTimes()
+			mockInstallerInternal.EXPECT().PreviewClusterUpdateInternal(gomock.Any(), gomock.Any(), gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+			mockInstallerInternal.EXPECT().TransformClusterToDay2Internal(gomock.Any(), gomock.Any()).Return(backEndCluster, nil).AnyTimes()
+			cred := &models.Credentials{
+				Password: "test",
+				Username: "admin",
+			}
+			kubeconfig := "kubeconfig content"
+			mockInstallerInternal.EXPECT().GetCredentialsInternal(gomock.Any(), gomock.Any()).Return(cred, nil).Times(1)
+			mockInstallerInternal.EXPECT().V2DownloadClusterCredentialsInternal(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader(kubeconfig)), int64(len(kubeconfig)), nil).Times(1)
+
+			aci = getTestClusterInstall()
+			aci.SetAnnotations(map[string]string{SpecDryRunAnnotation: "true"})
+			Expect(c.Update(ctx, aci)).To(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			_, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+
+			aci = getTestClusterInstall()
+			Expect(aci.Status.SpecPreview).ToNot(BeNil())
+			secretKubeConfig := getSecret(cluster.Namespace, aci.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name)
+			Expect(string(secretKubeConfig.Data["kubeconfig"])).To(Equal(kubeconfig))
+		})
+
 		It("hold installation", func() {
 			backEndCluster.Status = swag.String(models.ClusterStatusReady)
 			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(2)
@@ -5767,3 +5824,67 @@ var _ = Describe("indexManifestConfigMapNames", func() {
 		Expect(result).To(BeNil())
 	})
 })
+
+var _ = Describe("clusterOperatorsStatus", func() {
+	var (
+		aci       *hiveext.AgentClusterInstall
+		updatedAt = strfmt.DateTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		operators = []*models.MonitoredOperator{
+			{Name: "odf", OperatorType: models.OperatorTypeOlm, Status: models.OperatorStatusProgressing, Version: "4.15"},
+			{Name: "console", OperatorType: models.OperatorTypeBuiltin, Status: models.OperatorStatusAvailable},
+			{Name: "lvm", OperatorType: models.OperatorTypeOlm, Status: models.OperatorStatusFailed, StatusInfo: "csv failed", StatusUpdatedAt: updatedAt},
+			{Name: "cnv", OperatorType: models.OperatorTypeOlm, Status: models.OperatorStatusAvailable},
+		}
+	)
+
+	BeforeEach(func() {
+		aci = &hiveext.AgentClusterInstall{}
+	})
+
+	It("reports only OLM operators sorted by name", func() {
+		clusterOperatorsStatus(aci, models.ClusterStatusInstalled, operators)
+		Expect(aci.Status.Operators).To(HaveLen(3))
+		Expect(aci.Status.Operators[0].Name).To(Equal("cnv"))
+		Expect(aci.Status.Operators[1].Name).To(Equal("lvm"))
+		Expect(aci.Status.Operators[1].Status).To(Equal(string(models.OperatorStatusFailed)))
+		Expect(aci.Status.Operators[1].Message).To(Equal("csv failed"))
+		Expect(aci.Status.Operators[1].LastUpdated.Time).To(BeTemporally("==", time.Time(updatedAt)))
+		Expect(aci.Status.Operators[2].Name).To(Equal("odf"))
+		Expect(aci.Status.Operators[2].Version).To(Equal("4.15"))
+		Expect(aci.Status.Operators[2].LastUpdated).To(BeNil())
+	})
+
+	It("sets failed and progressing conditions once the cluster is installed", func() {
+		clusterOperatorsStatus(aci, models.ClusterStatusInstalled, operators)
+		failed := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterOperatorsFailedCondition)
+		Expect(failed.Status).To(Equal(corev1.ConditionTrue))
+		Expect(failed.Reason).To(Equal(hiveext.ClusterOperatorsFailedReason))
+		Expect(failed.Message).To(Equal(hiveext.ClusterOperatorsFailedMsg + " lvm"))
+		progressing := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterOperatorsProgressingCondition)
+		Expect(progressing.Status).To(Equal(corev1.ConditionTrue))
+		Expect(progressing.Reason).To(Equal(hiveext.ClusterOperatorsProgressingReason))
+		Expect(progressing.Message).To(Equal(hiveext.ClusterOperatorsProgressingMsg + " odf"))
+	})
+
+	It("sets the conditions to false when all operators are available", func() {
+		clusterOperatorsStatus(aci, models.ClusterStatusAddingHosts, operators[3:])
+		failed := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterOperatorsFailedCondition)
+		Expect(failed.Status).To(Equal(corev1.ConditionFalse))
+		Expect(failed.Reason).To(Equal(hiveext.ClusterOperatorsNotFailedReason))
+		progressing := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterOperatorsProgressingCondition)
+		Expect(progressing.Status).To(Equal(corev1.ConditionFalse))
+		Expect(progressing.Reason).To(Equal(hiveext.ClusterOperatorsNotProgressingReason))
+	})
+
+	It("sets the conditions to unknown before the cluster is installed", func() {
+		clusterOperatorsStatus(aci, models.ClusterStatusFinalizing, operators)
+		Expect(aci.Status.Operators).To(HaveLen(3))
+		for _, conditionType := range []hivev1.ClusterInstallConditionType{
+			hiveext.ClusterOperatorsFailedCondition, hiveext.ClusterOperatorsProgressingCondition,
+		} {
+			condition := FindStatusCondition(aci.Status.Conditions, conditionType)
+			Expect(condition.Status).To(Equal(corev1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(hiveext.ClusterOperatorsNotInstalledYetReason))
+		}
+	})
+})
@@ -111,6 +111,7 @@ func (hr *HypershiftAgentServiceConfigReconciler) getWebhookComponents_spoke() [
 		{"AgentClusterInstallMutatingWebHook", aiv1beta1.ReasonMutatingWebHookFailure, newACIMutatWebHook},
 		{"InfraEnvValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newInfraEnvWebHook},
 		{"AgentValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newAgentWebHook},
+		{"NMStateConfigValidatingWebHook", aiv1beta1.ReasonValidatingWebHookFailure, newNMStateConfigWebHook},
 		{"WebHookHostedService", aiv1beta1.ReasonWebHookServiceFailure, newHeadlessWebHookService},
 		{"WebHookEndpoint", aiv1beta1.ReasonWebHookEndpointFailure, newWebHookEndpoint},
 		{"WebHookAPIService", aiv1beta1.ReasonWebHookAPIServiceFailure, newHypershiftWebHookAPIService},
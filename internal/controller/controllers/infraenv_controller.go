@@ -36,6 +36,7 @@ import (
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/imageservice"
 	"github.com/openshift/assisted-service/internal/infraenv"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -300,19 +301,6 @@ func internalKernelArgs(kargs []aiv1beta1.KernelArgument) models.KernelArguments
 	return ret
 }
 
-func BuildMacInterfaceMap(log logrus.FieldLogger, nmStateConfig aiv1beta1.NMStateConfig) models.MacInterfaceMap {
-	macInterfaceMap := make(models.MacInterfaceMap, 0, len(nmStateConfig.Spec.Interfaces))
-	for _, cfg := range nmStateConfig.Spec.Interfaces {
-		log.Debugf("adding MAC interface map to host static network config - Name: %s, MacAddress: %s ,",
-			cfg.Name, cfg.MacAddress)
-		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{
-			MacAddress:     cfg.MacAddress,
-			LogicalNicName: cfg.Name,
-		})
-	}
-	return macInterfaceMap
-}
-
 func (r *InfraEnvReconciler) processNMStateConfig(ctx context.Context, log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv) ([]*models.HostStaticNetworkConfig, error) {
 	var staticNetworkConfig []*models.HostStaticNetworkConfig
 	var selector labels.Selector
@@ -337,7 +325,7 @@ func (r *InfraEnvReconciler) processNMStateConfig(ctx context.Context, log logru
 
 	for _, nmStateConfig := range nmStateConfigs.Items {
 		staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
-			MacInterfaceMap: BuildMacInterfaceMap(log, nmStateConfig),
+			MacInterfaceMap: network.BuildMacInterfaceMap(log, nmStateConfig),
 			NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
 		})
 	}
@@ -595,38 +583,20 @@ func (r *InfraEnvReconciler) setSignedBootArtifactURLs(infraEnv *aiv1beta1.Infra
 	}
 	infraEnv.Status.BootArtifacts.InitrdURL = signedInitrdURL
 
-	builder := &installer.V2DownloadInfraEnvFilesURL{
-		InfraEnvID: strfmt.UUID(infraEnvID),
-		FileName:   "ipxe-script",
-	}
-	if infraEnv.Spec.IPXEScriptType == aiv1beta1.BootOrderControl {
-		builder.IpxeScriptType = swag.String(bminventory.BootOrderControl)
-	}
-	filesURL, err := builder.Build()
-	if err != nil {
-		return err
-	}
-	scriptURL, err := url.Parse(r.ServiceBaseURL)
+	infraEnv.Status.BootArtifacts.IpxeScriptURL, err = r.bootScriptURL(infraEnv, infraEnvID, "ipxe-script")
 	if err != nil {
 		return err
 	}
-	// ASC may be configured to use http in ipxe artifact URLs so that all ipxe clients could consume those
-	if r.InsecureIPXEURLs {
-		scriptURL.Scheme = "http"
-	}
-	scriptURL.Path = path.Join(scriptURL.Path, filesURL.Path)
-	scriptURL.RawQuery = filesURL.RawQuery
-
-	infraEnv.Status.BootArtifacts.IpxeScriptURL, err = signURL(scriptURL.String(), r.AuthType, infraEnvID, gencrypto.InfraEnvKey)
+	infraEnv.Status.BootArtifacts.GrubConfigURL, err = r.bootScriptURL(infraEnv, infraEnvID, "grub-config")
 	if err != nil {
 		return err
 	}
 
-	builder = &installer.V2DownloadInfraEnvFilesURL{
+	builder := &installer.V2DownloadInfraEnvFilesURL{
 		InfraEnvID: strfmt.UUID(infraEnvID),
 		FileName:   "discovery.ign",
 	}
-	filesURL, err = builder.Build()
+	filesURL, err := builder.Build()
 	if err != nil {
 		return err
 	}
@@ -645,6 +615,33 @@ func (r *InfraEnvReconciler) setSignedBootArtifactURLs(infraEnv *aiv1beta1.Infra
 	return nil
 }
 
+// bootScriptURL returns the signed URL of the iPXE script or the GRUB config that network booted hosts load
+func (r *InfraEnvReconciler) bootScriptURL(infraEnv *aiv1beta1.InfraEnv, infraEnvID, fileName string) (string, error) {
+	builder := &installer.V2DownloadInfraEnvFilesURL{
+		InfraEnvID: strfmt.UUID(infraEnvID),
+		FileName:   fileName,
+	}
+	if infraEnv.Spec.IPXEScriptType == aiv1beta1.BootOrderControl {
+		builder.IpxeScriptType = swag.String(bminventory.BootOrderControl)
+	}
+	filesURL, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+	scriptURL, err := url.Parse(r.ServiceBaseURL)
+	if err != nil {
+		return "", err
+	}
+	// ASC may be configured to use http in ipxe artifact URLs so that all ipxe clients could consume those
+	if r.InsecureIPXEURLs {
+		scriptURL.Scheme = "http"
+	}
+	scriptURL.Path = path.Join(scriptURL.Path, filesURL.Path)
+	scriptURL.RawQuery = filesURL.RawQuery
+
+	return signURL(scriptURL.String(), r.AuthType, infraEnvID, gencrypto.InfraEnvKey)
+}
+
 func (r *InfraEnvReconciler) initrdSchemeChanged(initrdURL string) (bool, error) {
 	u, err := url.Parse(initrdURL)
 	if err != nil {
@@ -716,6 +713,8 @@ func (r *InfraEnvReconciler) setBootArtifactURLs(log logrus.FieldLogger, infraEn
 
 	infraEnv.Status.BootArtifacts.KernelURL = bootArtifactURLs.KernelURL
 	infraEnv.Status.BootArtifacts.RootfsURL = bootArtifactURLs.RootFSURL
+	infraEnv.Status.BootArtifacts.KernelSHA256 = bootArtifactURLs.KernelSHA256
+	infraEnv.Status.BootArtifacts.RootfsSHA256 = bootArtifactURLs.RootFSSHA256
 
 	schemeUpdated, err := r.initrdSchemeChanged(infraEnv.Status.BootArtifacts.InitrdURL)
 	if err != nil {
@@ -319,6 +319,14 @@ var _ = Describe("infraEnv reconcile", func() {
 		Expect(scriptURL.Path).To(ContainSubstring(sId.String()))
 		Expect(scriptURL.Query().Get("file_name")).To(Equal("ipxe-script"))
 		Expect(scriptURL.Query().Get("ipxe_script_type")).To(Equal(bminventory.BootOrderControl))
+
+		grubURL, err := url.Parse(kubeInfraEnv.Status.BootArtifacts.GrubConfigURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(grubURL.Scheme).To(Equal("https"))
+		Expect(grubURL.Host).To(Equal("www.acme.com"))
+		Expect(grubURL.Path).To(ContainSubstring(sId.String()))
+		Expect(grubURL.Query().Get("file_name")).To(Equal("grub-config"))
+		Expect(grubURL.Query().Get("ipxe_script_type")).To(Equal(bminventory.BootOrderControl))
 	})
 
 	It("IPXE with DiscoveryImageAlways script type", func() {
@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	mcfgv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SpokeHealthCheckAnnotation enables the periodic health and drift check of an installed spoke cluster and of the
+// nodes of its Agents. The results are reported in the SpokeHealthy condition of the AgentClusterInstall and in the
+// NodeHealthy condition of the Agents. The annotation value must be "true".
+const SpokeHealthCheckAnnotation = aiv1beta1.Group + "/spoke-health-check"
+
+const defaultSpokeHealthCheckInterval = 10 * time.Minute
+
+func isSpokeHealthCheckEnabled(clusterInstall *hiveext.AgentClusterInstall) bool {
+	return clusterInstall.GetAnnotations()[SpokeHealthCheckAnnotation] == "true"
+}
+
+func spokeHealthCheckRequeueAfter(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return defaultSpokeHealthCheckInterval
+	}
+	return interval
+}
+
+// checkSpokeHealth sets the SpokeHealthy condition of an installed cluster when the spoke health check is enabled,
+// and returns how long to wait before the next check. The spoke is checked at most once per interval, the probe time
+// of the condition records the last check. The condition is removed when the check is disabled.
+func (r *ClusterDeploymentsReconciler) checkSpokeHealth(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall) time.Duration {
+	if !isSpokeHealthCheckEnabled(clusterInstall) {
+		removeClusterCondition(&clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition)
+		return 0
+	}
+
+	interval := spokeHealthCheckRequeueAfter(r.SpokeHealthCheckInterval)
+	if remaining := spokeHealthCheckRemaining(clusterInstall, interval, time.Now()); remaining > 0 {
+		return remaining
+	}
+
+	condition := hivev1.ClusterInstallCondition{Type: hiveext.ClusterSpokeHealthyCondition}
+	spokeClient, err := r.spokeKubeClient(ctx, clusterDeployment)
+	var summary string
+	var problems []string
+	if err == nil {
+		summary, problems, err = spokeClusterHealth(ctx, spokeClient)
+	}
+	switch {
+	case err != nil:
+		log.WithError(err).Warnf("failed to check health of spoke cluster %s/%s", clusterDeployment.Namespace, clusterDeployment.Name)
+		condition.Status = corev1.ConditionUnknown
+		condition.Reason = hiveext.ClusterSpokeUnreachableReason
+		condition.Message = fmt.Sprintf("%s %s", hiveext.ClusterSpokeUnreachableMsg, err.Error())
+	case len(problems) > 0:
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = hiveext.ClusterSpokeUnhealthyReason
+		condition.Message = fmt.Sprintf("%s %s; %s", hiveext.ClusterSpokeUnhealthyMsg, summary, strings.Join(problems, "; "))
+	default:
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = hiveext.ClusterSpokeHealthyReason
+		condition.Message = fmt.Sprintf("%s %s", hiveext.ClusterSpokeHealthyMsg, summary)
+	}
+	setClusterCondition(&clusterInstall.Status.Conditions, condition)
+	// The probe time is only updated by setClusterCondition when the condition changes
+	FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition).LastProbeTime = metav1.Now()
+	return interval
+}
+
+// spokeHealthCheckRemaining returns how long is left until the spoke cluster is due for the next health check, zero
+// when it is due now
+func spokeHealthCheckRemaining(clusterInstall *hiveext.AgentClusterInstall, interval time.Duration, now time.Time) time.Duration {
+	condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition)
+	if condition == nil {
+		return 0
+	}
+	return healthCheckRemaining(condition.LastProbeTime, interval, now)
+}
+
+// spokeClusterHealth returns a summary of the spoke cluster nodes readiness and version, and the problems found:
+// nodes that are not ready and degraded cluster operators.
+func spokeClusterHealth(ctx context.Context, spokeClient client.Client) (string, []string, error) {
+	var problems []string
+
+	nodeList := &corev1.NodeList{}
+	if err := spokeClient.List(ctx, nodeList); err != nil {
+		return "", nil, err
+	}
+	var notReady []string
+	for i := range nodeList.Items {
+		if !isNodeReady(&nodeList.Items[i]) {
+			notReady = append(notReady, nodeList.Items[i].Name)
+		}
+	}
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		problems = append(problems, fmt.Sprintf("nodes not ready: %s", strings.Join(notReady, ", ")))
+	}
+	summary := fmt.Sprintf("%d/%d nodes ready", len(nodeList.Items)-len(notReady), len(nodeList.Items))
+
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := spokeClient.Get(ctx, types.NamespacedName{Name: "version"}, clusterVersion); client.IgnoreNotFound(err) != nil {
+		return "", nil, err
+	}
+	if clusterVersion.Status.Desired.Version != "" {
+		summary = fmt.Sprintf("%s, version %s", summary, clusterVersion.Status.Desired.Version)
+	}
+
+	operatorList := &configv1.ClusterOperatorList{}
+	if err := spokeClient.List(ctx, operatorList); err != nil {
+		return "", nil, err
+	}
+	var degraded []string
+	for _, operator := range operatorList.Items {
+		for _, cond := range operator.Status.Conditions {
+			if cond.Type == configv1.OperatorDegraded && cond.Status == configv1.ConditionTrue {
+				degraded = append(degraded, operator.Name)
+			}
+		}
+	}
+	if len(degraded) > 0 {
+		sort.Strings(degraded)
+		problems = append(problems, fmt.Sprintf("degraded cluster operators: %s", strings.Join(degraded, ", ")))
+	}
+	return summary, problems, nil
+}
+
+func removeClusterCondition(conditions *[]hivev1.ClusterInstallCondition, conditionType hivev1.ClusterInstallConditionType) {
+	filtered := (*conditions)[:0]
+	for _, cond := range *conditions {
+		if cond.Type != conditionType {
+			filtered = append(filtered, cond)
+		}
+	}
+	*conditions = filtered
+}
+
+// checkNodeHealth sets the NodeHealthy condition of an installed Agent when the spoke health check is enabled on its
+// AgentClusterInstall, and returns how long to wait before the next check. The spoke is checked at most once per
+// interval, the heartbeat of the condition records the last check. The condition is removed when the check is disabled.
+func (r *AgentReconciler) checkNodeHealth(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, status string) time.Duration {
+	enabled := false
+	if agent.Spec.ClusterDeploymentName != nil &&
+		(status == models.HostStatusInstalled || status == models.HostStatusAddedToExistingCluster) {
+		clusterInstall, err := r.getAgentClusterInstall(ctx, agent)
+		if err != nil {
+			log.WithError(err).Warnf("failed to get AgentClusterInstall of agent %s/%s", agent.Namespace, agent.Name)
+		}
+		enabled = clusterInstall != nil && isSpokeHealthCheckEnabled(clusterInstall)
+	}
+	if !enabled {
+		conditionsv1.RemoveStatusCondition(&agent.Status.Conditions, aiv1beta1.NodeHealthyCondition)
+		return 0
+	}
+
+	interval := spokeHealthCheckRequeueAfter(r.SpokeHealthCheckInterval)
+	if remaining := nodeHealthCheckRemaining(agent, interval, time.Now()); remaining > 0 {
+		return remaining
+	}
+
+	condition := conditionsv1.Condition{Type: aiv1beta1.NodeHealthyCondition}
+	spokeClient, err := r.spokeKubeClient(ctx, agent.Spec.ClusterDeploymentName)
+	if err == nil {
+		condition, err = nodeHealthCondition(ctx, spokeClient, agent)
+	}
+	if err != nil {
+		log.WithError(err).Warnf("failed to check health of node of agent %s/%s", agent.Namespace, agent.Name)
+		condition = conditionsv1.Condition{
+			Type:    aiv1beta1.NodeHealthyCondition,
+			Status:  corev1.ConditionUnknown,
+			Reason:  aiv1beta1.NodeSpokeUnreachableReason,
+			Message: fmt.Sprintf("%s %s", aiv1beta1.NodeSpokeUnreachableMsg, err.Error()),
+		}
+	}
+	conditionsv1.SetStatusCondition(&agent.Status.Conditions, condition)
+	return interval
+}
+
+// nodeHealthCheckRemaining returns how long is left until the node of the Agent is due for the next health check,
+// zero when it is due now
+func nodeHealthCheckRemaining(agent *aiv1beta1.Agent, interval time.Duration, now time.Time) time.Duration {
+	condition := conditionsv1.FindStatusCondition(agent.Status.Conditions, aiv1beta1.NodeHealthyCondition)
+	if condition == nil {
+		return 0
+	}
+	return healthCheckRemaining(condition.LastHeartbeatTime, interval, now)
+}
+
+// healthCheckRemaining returns how long is left of the interval since the last check, zero when the check is due now
+func healthCheckRemaining(lastCheck metav1.Time, interval time.Duration, now time.Time) time.Duration {
+	if lastCheck.IsZero() {
+		return 0
+	}
+	if remaining := interval - now.Sub(lastCheck.Time); remaining > 0 && remaining <= interval {
+		return remaining
+	}
+	return 0
+}
+
+func (r *AgentReconciler) getAgentClusterInstall(ctx context.Context, agent *aiv1beta1.Agent) (*hiveext.AgentClusterInstall, error) {
+	clusterDeployment, err := getClusterDeploymentFromAgent(ctx, r.Client, agent)
+	if err != nil {
+		return nil, err
+	}
+	if clusterDeployment.Spec.ClusterInstallRef == nil {
+		return nil, nil
+	}
+	clusterInstall := &hiveext.AgentClusterInstall{}
+	key := types.NamespacedName{Namespace: clusterDeployment.Namespace, Name: clusterDeployment.Spec.ClusterInstallRef.Name}
+	if err = r.Client.Get(ctx, key, clusterInstall); err != nil {
+		return nil, err
+	}
+	return clusterInstall, nil
+}
+
+// nodeHealthCondition checks that the spoke node of the Agent is ready, and that the node labels and the machine
+// config pool set in the Agent spec still hold.
+func nodeHealthCondition(ctx context.Context, spokeClient client.Client, agent *aiv1beta1.Agent) (conditionsv1.Condition, error) {
+	condition := conditionsv1.Condition{Type: aiv1beta1.NodeHealthyCondition, Status: corev1.ConditionFalse}
+
+	node := &corev1.Node{}
+	if err := spokeClient.Get(ctx, types.NamespacedName{Name: getAgentHostname(agent)}, node); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return condition, err
+		}
+		condition.Reason = aiv1beta1.NodeNotFoundReason
+		condition.Message = aiv1beta1.NodeNotFoundMsg
+		return condition, nil
+	}
+	if !isNodeReady(node) {
+		condition.Reason = aiv1beta1.NodeNotReadyReason
+		condition.Message = aiv1beta1.NodeNotReadyMsg
+		return condition, nil
+	}
+
+	var drifts []string
+	var missingLabels []string
+	for key, value := range agent.Spec.NodeLabels {
+		if existingValue, ok := node.Labels[key]; !ok || existingValue != value {
+			missingLabels = append(missingLabels, fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+	if len(missingLabels) > 0 {
+		sort.Strings(missingLabels)
+		drifts = append(drifts, fmt.Sprintf("node labels %s are not set", strings.Join(missingLabels, ", ")))
+	}
+
+	if agent.Spec.MachineConfigPool != "" {
+		mcp := &mcfgv1.MachineConfigPool{}
+		err := spokeClient.Get(ctx, types.NamespacedName{Name: agent.Spec.MachineConfigPool}, mcp)
+		switch {
+		case k8serrors.IsNotFound(err):
+			drifts = append(drifts, fmt.Sprintf("machine config pool %s doesn't exist", agent.Spec.MachineConfigPool))
+		case err != nil:
+			return condition, err
+		default:
+			selector, selectorErr := metav1.LabelSelectorAsSelector(mcp.Spec.NodeSelector)
+			if selectorErr != nil || !selector.Matches(labels.Set(node.Labels)) {
+				drifts = append(drifts, fmt.Sprintf("node is not selected by machine config pool %s", agent.Spec.MachineConfigPool))
+			}
+		}
+	}
+
+	if len(drifts) > 0 {
+		condition.Reason = aiv1beta1.NodeDriftedReason
+		condition.Message = fmt.Sprintf("%s %s", aiv1beta1.NodeDriftedMsg, strings.Join(drifts, "; "))
+		return condition, nil
+	}
+	condition.Status = corev1.ConditionTrue
+	condition.Reason = aiv1beta1.NodeHealthyReason
+	condition.Message = aiv1beta1.NodeHealthyMsg
+	return condition, nil
+}
@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	mcfgv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newSpokeNode(name string, ready bool, nodeLabels map[string]string) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func newSpokeClusterOperator(name string, degraded bool) *configv1.ClusterOperator {
+	status := configv1.ConditionFalse
+	if degraded {
+		status = configv1.ConditionTrue
+	}
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{{Type: configv1.OperatorDegraded, Status: status}},
+		},
+	}
+}
+
+var _ = Describe("spokeClusterHealth", func() {
+	var (
+		ctx            = context.Background()
+		clusterVersion *configv1.ClusterVersion
+	)
+
+	BeforeEach(func() {
+		clusterVersion = &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "version"},
+			Status:     configv1.ClusterVersionStatus{Desired: configv1.Release{Version: "4.16.3"}},
+		}
+	})
+
+	It("reports a healthy cluster", func() {
+		spokeClient := fakeclient.NewClientBuilder().WithScheme(spoke_k8s_client.GetKubeClientSchemes()).WithObjects(
+			newSpokeNode("node-1", true, nil), newSpokeNode("node-2", true, nil),
+			clusterVersion, newSpokeClusterOperator("dns", false)).Build()
+
+		summary, problems, err := spokeClusterHealth(ctx, spokeClient)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summary).To(Equal("2/2 nodes ready, version 4.16.3"))
+		Expect(problems).To(BeEmpty())
+	})
+
+	It("reports nodes that are not ready and degraded cluster operators", func() {
+		spokeClient := fakeclient.NewClientBuilder().WithScheme(spoke_k8s_client.GetKubeClientSchemes()).WithObjects(
+			newSpokeNode("node-1", true, nil), newSpokeNode("node-2", false, nil),
+			clusterVersion, newSpokeClusterOperator("ingress", true), newSpokeClusterOperator("dns", true),
+			newSpokeClusterOperator("network", false)).Build()
+
+		summary, problems, err := spokeClusterHealth(ctx, spokeClient)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summary).To(Equal("1/2 nodes ready, version 4.16.3"))
+		Expect(problems).To(Equal([]string{
+			"nodes not ready: node-2",
+			"degraded cluster operators: dns, ingress",
+		}))
+	})
+})
+
+var _ = Describe("nodeHealthCondition", func() {
+	var (
+		ctx   = context.Background()
+		agent *aiv1beta1.Agent
+		mcp   *mcfgv1.MachineConfigPool
+	)
+
+	buildSpokeClient := func(objs ...client.Object) client.Client {
+		return fakeclient.NewClientBuilder().WithScheme(spoke_k8s_client.GetKubeClientSchemes()).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		agent = newAgent("agent", "test-namespace", aiv1beta1.AgentSpec{
+			Hostname:          "node-1",
+			NodeLabels:        map[string]string{"node-role.kubernetes.io/infra": ""},
+			MachineConfigPool: "infra",
+		})
+		mcp = &mcfgv1.MachineConfigPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra"},
+			Spec: mcfgv1.MachineConfigPoolSpec{
+				NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"node-role.kubernetes.io/infra": ""}},
+			},
+		}
+	})
+
+	It("reports a healthy node", func() {
+		node := newSpokeNode("node-1", true, map[string]string{"node-role.kubernetes.io/infra": ""})
+		condition, err := nodeHealthCondition(ctx, buildSpokeClient(node, mcp), agent)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(aiv1beta1.NodeHealthyReason))
+	})
+
+	It("reports a missing node", func() {
+		condition, err := nodeHealthCondition(ctx, buildSpokeClient(mcp), agent)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(aiv1beta1.NodeNotFoundReason))
+	})
+
+	It("reports a node that is not ready", func() {
+		node := newSpokeNode("node-1", false, map[string]string{"node-role.kubernetes.io/infra": ""})
+		condition, err := nodeHealthCondition(ctx, buildSpokeClient(node, mcp), agent)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition.Reason).To(Equal(aiv1beta1.NodeNotReadyReason))
+	})
+
+	It("reports node labels and machine config pool drift", func() {
+		node := newSpokeNode("node-1", true, map[string]string{"node-role.kubernetes.io/worker": ""})
+		condition, err := nodeHealthCondition(ctx, buildSpokeClient(node, mcp), agent)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(aiv1beta1.NodeDriftedReason))
+		Expect(condition.Message).To(Equal(aiv1beta1.NodeDriftedMsg +
+			" node labels node-role.kubernetes.io/infra= are not set; node is not selected by machine config pool infra"))
+	})
+
+	It("reports a machine config pool that doesn't exist", func() {
+		node := newSpokeNode("node-1", true, map[string]string{"node-role.kubernetes.io/infra": ""})
+		condition, err := nodeHealthCondition(ctx, buildSpokeClient(node), agent)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(condition.Reason).To(Equal(aiv1beta1.NodeDriftedReason))
+		Expect(condition.Message).To(ContainSubstring("machine config pool infra doesn't exist"))
+	})
+})
+
+var _ = Describe("nodeHealthCheckRemaining", func() {
+	var (
+		agent *aiv1beta1.Agent
+		now   = time.Now()
+	)
+
+	BeforeEach(func() {
+		agent = &aiv1beta1.Agent{}
+	})
+
+	setLastCheck := func(lastCheck time.Time) {
+		agent.Status.Conditions = []conditionsv1.Condition{{
+			Type:              aiv1beta1.NodeHealthyCondition,
+			Status:            corev1.ConditionTrue,
+			LastHeartbeatTime: metav1.NewTime(lastCheck),
+		}}
+	}
+
+	It("is due when the node wasn't checked yet", func() {
+		Expect(nodeHealthCheckRemaining(agent, 10*time.Minute, now)).To(BeZero())
+	})
+
+	It("waits for the rest of the interval after a check", func() {
+		setLastCheck(now.Add(-4 * time.Minute))
+		Expect(nodeHealthCheckRemaining(agent, 10*time.Minute, now)).To(Equal(6 * time.Minute))
+	})
+
+	It("is due once the interval elapsed", func() {
+		setLastCheck(now.Add(-11 * time.Minute))
+		Expect(nodeHealthCheckRemaining(agent, 10*time.Minute, now)).To(BeZero())
+	})
+
+	It("is due when the last check is in the future", func() {
+		setLastCheck(now.Add(time.Hour))
+		Expect(nodeHealthCheckRemaining(agent, 10*time.Minute, now)).To(BeZero())
+	})
+})
+
+var _ = Describe("checkSpokeHealth", func() {
+	var (
+		ctx               = context.Background()
+		r                 *ClusterDeploymentsReconciler
+		clusterDeployment *hivev1.ClusterDeployment
+		clusterInstall    *hiveext.AgentClusterInstall
+	)
+
+	BeforeEach(func() {
+		c := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		r = &ClusterDeploymentsReconciler{Client: c, APIReader: c, Log: logrus.New()}
+		clusterDeployment = newClusterDeployment("test-cluster", "test-namespace", hivev1.ClusterDeploymentSpec{})
+		clusterInstall = newAgentClusterInstall("test-aci", "test-namespace", hiveext.AgentClusterInstallSpec{}, clusterDeployment)
+	})
+
+	It("removes the condition when the check is disabled", func() {
+		setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+			Type:   hiveext.ClusterSpokeHealthyCondition,
+			Status: corev1.ConditionTrue,
+			Reason: hiveext.ClusterSpokeHealthyReason,
+		})
+		Expect(r.checkSpokeHealth(ctx, r.Log, clusterDeployment, clusterInstall)).To(BeZero())
+		Expect(FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition)).To(BeNil())
+	})
+
+	It("reports an unreachable spoke and requeues", func() {
+		clusterInstall.Annotations = map[string]string{SpokeHealthCheckAnnotation: "true"}
+		Expect(r.checkSpokeHealth(ctx, r.Log, clusterDeployment, clusterInstall)).To(Equal(defaultSpokeHealthCheckInterval))
+		condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeUnreachableReason))
+		Expect(condition.LastProbeTime.IsZero()).To(BeFalse())
+	})
+
+	It("doesn't check the spoke again before the interval elapsed", func() {
+		clusterInstall.Annotations = map[string]string{SpokeHealthCheckAnnotation: "true"}
+		clusterInstall.Status.Conditions = []hivev1.ClusterInstallCondition{{
+			Type:          hiveext.ClusterSpokeHealthyCondition,
+			Status:        corev1.ConditionTrue,
+			Reason:        hiveext.ClusterSpokeHealthyReason,
+			LastProbeTime: metav1.NewTime(time.Now().Add(-4 * time.Minute)),
+		}}
+		requeueAfter := r.checkSpokeHealth(ctx, r.Log, clusterDeployment, clusterInstall)
+		Expect(requeueAfter).To(BeNumerically("~", 6*time.Minute, time.Second))
+		condition := FindStatusCondition(clusterInstall.Status.Conditions, hiveext.ClusterSpokeHealthyCondition)
+		Expect(condition.Reason).To(Equal(hiveext.ClusterSpokeHealthyReason))
+	})
+})
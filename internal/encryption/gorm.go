@@ -0,0 +1,337 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the GORM serializer that encrypts a column, used as
+// `gorm:"serializer:encrypted"`
+const SerializerName = "encrypted"
+
+const (
+	pluginName          = "encryption"
+	plaintextUpdatesKey = "encryption:plaintext_updates"
+)
+
+type keyringContextKey struct{}
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Serializer encrypts string and string pointer fields with the keyring of the
+// Plugin when they are written and decrypts them when they are read. Empty and
+// nil values are stored as they are, and values are stored in plaintext when
+// no keyring is set.
+type Serializer struct{}
+
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var value []byte
+	switch v := dbValue.(type) {
+	case nil:
+	case []byte:
+		value = v
+	case string:
+		value = []byte(v)
+	default:
+		return errors.Errorf("unsupported type %T for encrypted column %s", dbValue, field.DBName)
+	}
+	if IsEncrypted(value) {
+		keyring := keyringFromContext(ctx)
+		if keyring == nil {
+			return errNoKeyring(field.DBName)
+		}
+		var err error
+		if value, err = keyring.Decrypt(value); err != nil {
+			return errors.Wrapf(err, "failed to decrypt column %s", field.DBName)
+		}
+	}
+	target := field.ReflectValueOf(ctx, dst)
+	if target.Kind() == reflect.Ptr {
+		if dbValue == nil {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		plaintext := string(value)
+		target.Set(reflect.ValueOf(&plaintext))
+		return nil
+	}
+	target.SetString(string(value))
+	return nil
+}
+
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	switch value := fieldValue.(type) {
+	case string:
+		return encryptString(keyringFromContext(ctx), value)
+	case *string:
+		if value == nil {
+			return nil, nil
+		}
+		return encryptString(keyringFromContext(ctx), *value)
+	default:
+		return nil, errors.Errorf("unsupported type %T for encrypted column %s", fieldValue, field.DBName)
+	}
+}
+
+func encryptString(keyring *Keyring, value string) (string, error) {
+	if keyring == nil || value == "" || IsEncrypted([]byte(value)) {
+		return value, nil
+	}
+	encrypted, err := keyring.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(encrypted), nil
+}
+
+func isEncryptedField(field *schema.Field) bool {
+	return field != nil && strings.EqualFold(field.TagSettings["SERIALIZER"], SerializerName)
+}
+
+func contextWithKeyring(ctx context.Context, keyring *Keyring) context.Context {
+	return context.WithValue(ctx, keyringContextKey{}, keyring)
+}
+
+func keyringFromContext(ctx context.Context) *Keyring {
+	if ctx == nil {
+		return nil
+	}
+	keyring, _ := ctx.Value(keyringContextKey{}).(*Keyring)
+	return keyring
+}
+
+// Plugin makes the encrypted serializer use its keyring for every statement
+// of the DB it is registered with. A nil keyring disables the encryption of
+// new values. It also makes map based updates (Updates(map[string]interface{}{...})
+// and Update(column, value)) encrypt the columns that use the encrypted
+// serializer, as GORM only applies serializers to struct fields.
+type Plugin struct {
+	keyring *Keyring
+}
+
+func NewPlugin(keyring *Keyring) *Plugin {
+	return &Plugin{keyring: keyring}
+}
+
+func (p *Plugin) Name() string {
+	return pluginName
+}
+
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	registers := map[string]func(string, func(*gorm.DB)) error{
+		"create": callbacks.Create().Before("*").Register,
+		"query":  callbacks.Query().Before("*").Register,
+		"update": callbacks.Update().Before("*").Register,
+		"delete": callbacks.Delete().Before("*").Register,
+		"row":    callbacks.Row().Before("*").Register,
+		"raw":    callbacks.Raw().Before("*").Register,
+	}
+	for name, register := range registers {
+		if err := register(fmt.Sprintf("encryption:%s_keyring", name), p.setKeyring); err != nil {
+			return err
+		}
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("encryption:encrypt_map_updates", encryptMapUpdates); err != nil {
+		return err
+	}
+	return callbacks.Update().After("gorm:update").Register("encryption:restore_map_updates", restoreMapUpdates)
+}
+
+func (p *Plugin) setKeyring(db *gorm.DB) {
+	db.Statement.Context = contextWithKeyring(db.Statement.Context, p.keyring)
+}
+
+// KeyringFromDB returns the keyring of the Plugin registered with the DB, or
+// nil if there is none
+func KeyringFromDB(db *gorm.DB) *Keyring {
+	if plugin, ok := db.Config.Plugins[pluginName].(*Plugin); ok {
+		return plugin.keyring
+	}
+	return nil
+}
+
+func encryptMapUpdates(db *gorm.DB) {
+	stmt := db.Statement
+	updates, ok := stmt.Dest.(map[string]interface{})
+	if db.Error != nil || !ok || stmt.Schema == nil {
+		return
+	}
+	var encrypted map[string]interface{}
+	plaintext := map[*schema.Field]string{}
+	for column, value := range updates {
+		field := stmt.Schema.LookUpField(column)
+		if !isEncryptedField(field) {
+			continue
+		}
+		var str string
+		switch v := value.(type) {
+		case string:
+			str = v
+		case *string:
+			if v == nil {
+				continue
+			}
+			str = *v
+		default:
+			continue
+		}
+		ciphertext, err := encryptString(keyringFromContext(stmt.Context), str)
+		if err != nil {
+			_ = db.AddError(errors.Wrapf(err, "failed to encrypt column %s", field.DBName))
+			return
+		}
+		if encrypted == nil {
+			encrypted = make(map[string]interface{}, len(updates))
+			for k, v := range updates {
+				encrypted[k] = v
+			}
+		}
+		encrypted[column] = ciphertext
+		plaintext[field] = str
+	}
+	if encrypted != nil {
+		stmt.Dest = encrypted
+		stmt.Settings.Store(plaintextUpdatesKey, plaintext)
+	}
+}
+
+// restoreMapUpdates puts the plaintext back in the model, which GORM fills
+// with the values that were written to the database
+func restoreMapUpdates(db *gorm.DB) {
+	stmt := db.Statement
+	value, ok := stmt.Settings.LoadAndDelete(plaintextUpdatesKey)
+	if !ok {
+		return
+	}
+	if !stmt.ReflectValue.IsValid() || stmt.ReflectValue.Kind() != reflect.Struct || !stmt.ReflectValue.CanAddr() {
+		return
+	}
+	for field, plaintext := range value.(map[*schema.Field]string) {
+		_ = field.Set(stmt.Context, stmt.ReflectValue, plaintext)
+	}
+}
+
+// EncryptedColumns returns the table of the model and its columns that use
+// the encrypted serializer
+func EncryptedColumns(db *gorm.DB, model interface{}) (string, []string, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return "", nil, err
+	}
+	var columns []string
+	for _, field := range stmt.Schema.Fields {
+		if isEncryptedField(field) && field.DBName != "" {
+			columns = append(columns, field.DBName)
+		}
+	}
+	return stmt.Schema.Table, columns, nil
+}
+
+// ReencryptColumns encrypts all the values of the encrypted columns of the
+// given models that are either stored in plaintext or encrypted with a key
+// other than the active one. It is safe to run while the service is up since
+// every value is only replaced if it was not modified in the meantime.
+func ReencryptColumns(ctx context.Context, db *gorm.DB, keyring *Keyring, log logrus.FieldLogger, batchSize int, models ...interface{}) error {
+	return forEachModel(db, models, func(table string, columns []string) error {
+		return ReencryptTableColumns(ctx, db, keyring, log, batchSize, table, columns...)
+	})
+}
+
+// DecryptColumns stores all the values of the encrypted columns of the given
+// models in plaintext
+func DecryptColumns(ctx context.Context, db *gorm.DB, keyring *Keyring, log logrus.FieldLogger, batchSize int, models ...interface{}) error {
+	return forEachModel(db, models, func(table string, columns []string) error {
+		return DecryptTableColumns(ctx, db, keyring, log, batchSize, table, columns...)
+	})
+}
+
+// ReencryptTableColumns is ReencryptColumns for the given columns of a table
+func ReencryptTableColumns(ctx context.Context, db *gorm.DB, keyring *Keyring, log logrus.FieldLogger, batchSize int, table string, columns ...string) error {
+	prefix := keyring.ActivePrefix()
+	return rewriteColumns(ctx, db, log, batchSize, table, columns,
+		fmt.Sprintf("left(%%[1]s, %d) <> ?", len(prefix)), []interface{}{prefix},
+		func(value string) (string, error) {
+			plaintext, err := keyring.Decrypt([]byte(value))
+			if err != nil {
+				return "", err
+			}
+			encrypted, err := keyring.Encrypt(plaintext)
+			return string(encrypted), err
+		})
+}
+
+// DecryptTableColumns is DecryptColumns for the given columns of a table
+func DecryptTableColumns(ctx context.Context, db *gorm.DB, keyring *Keyring, log logrus.FieldLogger, batchSize int, table string, columns ...string) error {
+	return rewriteColumns(ctx, db, log, batchSize, table, columns,
+		fmt.Sprintf("left(%%[1]s, %d) = ?", len(valuePrefix)), []interface{}{valuePrefix},
+		func(value string) (string, error) {
+			plaintext, err := keyring.Decrypt([]byte(value))
+			return string(plaintext), err
+		})
+}
+
+func forEachModel(db *gorm.DB, models []interface{}, f func(table string, columns []string) error) error {
+	for _, model := range models {
+		table, columns, err := EncryptedColumns(db, model)
+		if err != nil {
+			return err
+		}
+		if err = f(table, columns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rewriteColumns(ctx context.Context, db *gorm.DB, log logrus.FieldLogger, batchSize int, table string, columns []string,
+	condition string, args []interface{}, rewrite func(string) (string, error)) error {
+	for _, column := range columns {
+		count := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// The values are read and written without the model so that
+			// the serializer does not touch them
+			var values []string
+			err := db.WithContext(ctx).Table(table).
+				Where(fmt.Sprintf("%[1]s IS NOT NULL AND %[1]s <> ''", column)).
+				Where(fmt.Sprintf(condition, column), args...).
+				Distinct(column).Limit(batchSize).Pluck(column, &values).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s.%s", table, column)
+			}
+			if len(values) == 0 {
+				break
+			}
+			for _, value := range values {
+				newValue, err := rewrite(value)
+				if err != nil {
+					return errors.Wrapf(err, "failed to rewrite a value of %s.%s", table, column)
+				}
+				// Only rows that still hold the old value are updated, so
+				// concurrent writes are never overwritten
+				result := db.WithContext(ctx).Table(table).Where(fmt.Sprintf("%s = ?", column), value).
+					UpdateColumn(column, newValue)
+				if result.Error != nil {
+					return errors.Wrapf(result.Error, "failed to update %s.%s", table, column)
+				}
+				count += int(result.RowsAffected)
+			}
+		}
+		if count > 0 {
+			log.Infof("Rewrote %d values of %s.%s", count, table, column)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package encryption
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+type testModel struct {
+	ID             string
+	Secret         string  `gorm:"serializer:encrypted"`
+	OptionalSecret *string `gorm:"serializer:encrypted"`
+}
+
+var _ = Describe("Serializer", func() {
+	var (
+		ctx     context.Context
+		keyring *Keyring
+		field   *schema.Field
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		keyring, err = NewKeyring(map[string][]byte{"key": testKey(1)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		s, err := schema.Parse(&testModel{}, &sync.Map{}, schema.NamingStrategy{})
+		Expect(err).ToNot(HaveOccurred())
+		field = s.LookUpField("secret")
+		Expect(isEncryptedField(field)).To(BeTrue())
+		Expect(isEncryptedField(s.LookUpField("id"))).To(BeFalse())
+	})
+
+	scan := func(dbValue interface{}) (string, error) {
+		model := testModel{}
+		err := Serializer{}.Scan(ctx, field, reflect.ValueOf(&model).Elem(), dbValue)
+		return model.Secret, err
+	}
+
+	It("encrypts values when a keyring is set", func() {
+		ctx = contextWithKeyring(ctx, keyring)
+		value, err := Serializer{}.Value(ctx, field, reflect.Value{}, "secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(HavePrefix("enc:v1:key:"))
+
+		secret, err := scan([]byte(value.(string)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(Equal("secret"))
+	})
+
+	It("does not encrypt empty values", func() {
+		ctx = contextWithKeyring(ctx, keyring)
+		value, err := Serializer{}.Value(ctx, field, reflect.Value{}, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(""))
+	})
+
+	It("stores values in plaintext when no keyring is set", func() {
+		value, err := Serializer{}.Value(ctx, field, reflect.Value{}, "secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("secret"))
+	})
+
+	It("reads plaintext and NULL values", func() {
+		ctx = contextWithKeyring(ctx, keyring)
+		secret, err := scan("plain")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(Equal("plain"))
+
+		secret, err = scan(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(BeEmpty())
+	})
+
+	It("encrypts pointer values and keeps nil ones", func() {
+		ctx = contextWithKeyring(ctx, keyring)
+		optionalField := field.Schema.LookUpField("optional_secret")
+		Expect(isEncryptedField(optionalField)).To(BeTrue())
+		secret := "secret"
+		value, err := Serializer{}.Value(ctx, optionalField, reflect.Value{}, &secret)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(HavePrefix("enc:v1:key:"))
+
+		model := testModel{}
+		Expect(Serializer{}.Scan(ctx, optionalField, reflect.ValueOf(&model).Elem(), value)).To(Succeed())
+		Expect(model.OptionalSecret).To(Equal(&secret))
+
+		value, err = Serializer{}.Value(ctx, optionalField, reflect.Value{}, (*string)(nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(BeNil())
+		Expect(Serializer{}.Scan(ctx, optionalField, reflect.ValueOf(&model).Elem(), nil)).To(Succeed())
+		Expect(model.OptionalSecret).To(BeNil())
+	})
+
+	It("fails to read encrypted values when no keyring is set", func() {
+		encrypted, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = scan(encrypted)
+		Expect(err).To(MatchError(ContainSubstring("no encryption keys are configured")))
+	})
+})
+
+var _ = Describe("Plugin", func() {
+	It("makes the keyring available to the statements of the DB", func() {
+		keyring, err := NewKeyring(map[string][]byte{"key": testKey(1)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		db, err := gorm.Open(nil, &gorm.Config{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(KeyringFromDB(db)).To(BeNil())
+		Expect(db.Use(NewPlugin(keyring))).To(Succeed())
+		Expect(KeyringFromDB(db)).To(Equal(keyring))
+
+		tx := db.Session(&gorm.Session{DryRun: true}).Find(&testModel{})
+		Expect(keyringFromContext(tx.Statement.Context)).To(Equal(keyring))
+	})
+})
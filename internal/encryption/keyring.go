@@ -0,0 +1,232 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// valuePrefix marks values written by this package. The full format is
+	// enc:v1:<key id>:<base64 wrapped data key>:<base64 ciphertext>
+	valuePrefix = "enc:v1:"
+	keySize     = 32
+)
+
+type Config struct {
+	// KeysPath points either to a JSON file mapping key IDs to base64 encoded
+	// 32 byte keys, or to a directory (e.g. a mounted Kubernetes Secret) that
+	// holds one file per key ID containing the base64 encoded key.
+	KeysPath string `envconfig:"ENCRYPTION_KEYS_PATH" default:""`
+	// ActiveKeyID is the key used to encrypt new data. It may be omitted when
+	// only a single key is configured.
+	ActiveKeyID string `envconfig:"ENCRYPTION_ACTIVE_KEY_ID" default:""`
+}
+
+// Keyring holds the key encryption keys and encrypts values using envelope
+// encryption: every value is encrypted with a random data key that is itself
+// encrypted with the active key encryption key.
+type Keyring struct {
+	keys     map[string][]byte
+	activeID string
+}
+
+func NewKeyring(keys map[string][]byte, activeID string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no encryption keys were provided")
+	}
+	for id, key := range keys {
+		if id == "" || strings.ContainsAny(id, ":/") {
+			return nil, errors.Errorf("invalid encryption key ID %q", id)
+		}
+		if len(key) != keySize {
+			return nil, errors.Errorf("encryption key %s must be %d bytes long, got %d", id, keySize, len(key))
+		}
+	}
+	if activeID == "" {
+		if len(keys) > 1 {
+			return nil, errors.New("an active encryption key ID must be set when more than one key is configured")
+		}
+		for id := range keys {
+			activeID = id
+		}
+	}
+	if _, ok := keys[activeID]; !ok {
+		return nil, errors.Errorf("active encryption key %s was not found", activeID)
+	}
+	return &Keyring{keys: keys, activeID: activeID}, nil
+}
+
+// LoadKeyring reads the keys referenced by the configuration. It returns a nil
+// keyring when encryption is not configured.
+func LoadKeyring(cfg Config) (*Keyring, error) {
+	if cfg.KeysPath == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(cfg.KeysPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read encryption keys")
+	}
+	encodedKeys := map[string]string{}
+	if info.IsDir() {
+		entries, err := os.ReadDir(cfg.KeysPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read encryption keys directory")
+		}
+		for _, entry := range entries {
+			// Kubernetes Secret volumes contain hidden ..data style entries
+			if strings.HasPrefix(entry.Name(), ".") || entry.IsDir() {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(cfg.KeysPath, entry.Name()))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read encryption key %s", entry.Name())
+			}
+			encodedKeys[entry.Name()] = string(content)
+		}
+	} else {
+		content, err := os.ReadFile(cfg.KeysPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read encryption keys file")
+		}
+		if err = json.Unmarshal(content, &encodedKeys); err != nil {
+			return nil, errors.Wrap(err, "failed to parse encryption keys file")
+		}
+	}
+
+	keys := make(map[string][]byte, len(encodedKeys))
+	for id, encoded := range encodedKeys {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode encryption key %s", id)
+		}
+		keys[id] = key
+	}
+	return NewKeyring(keys, cfg.ActiveKeyID)
+}
+
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+// KeyIDs returns the IDs of all the configured keys, sorted
+func (k *Keyring) KeyIDs() []string {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ActivePrefix is the prefix shared by all values encrypted with the active key
+func (k *Keyring) ActivePrefix() string {
+	return valuePrefix + k.activeID + ":"
+}
+
+// Encrypt encrypts the value with a new data key wrapped by the active key
+func (k *Keyring) Encrypt(plaintext []byte) ([]byte, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key")
+	}
+	wrappedKey, err := seal(k.keys[k.activeID], dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap data key")
+	}
+	ciphertext, err := seal(dataKey, plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt value")
+	}
+	return []byte(k.ActivePrefix() +
+		base64.StdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.StdEncoding.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt returns the plaintext of a value created by Encrypt. Values that
+// were never encrypted are returned as they are.
+func (k *Keyring) Decrypt(value []byte) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	parts := strings.Split(string(value[len(valuePrefix):]), ":")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed encrypted value")
+	}
+	kek, ok := k.keys[parts[0]]
+	if !ok {
+		return nil, errors.Errorf("encryption key %s is not configured", parts[0])
+	}
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed encrypted data key")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed encrypted value")
+	}
+	dataKey, err := open(kek, wrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key with encryption key %s", parts[0])
+	}
+	plaintext, err := open(dataKey, ciphertext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt value")
+	}
+	return plaintext, nil
+}
+
+// NeedsRotation returns true for values that are not encrypted with the active key
+func (k *Keyring) NeedsRotation(value []byte) bool {
+	return len(value) > 0 && !strings.HasPrefix(string(value), k.ActivePrefix())
+}
+
+// IsEncrypted returns true if the value was created by Keyring.Encrypt
+func IsEncrypted(value []byte) bool {
+	return strings.HasPrefix(string(value), valuePrefix)
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func errNoKeyring(what string) error {
+	return fmt.Errorf("%s is encrypted but no encryption keys are configured", what)
+}
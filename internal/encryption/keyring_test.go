@@ -0,0 +1,148 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, keySize)
+}
+
+var _ = Describe("Keyring", func() {
+	var keyring *Keyring
+
+	BeforeEach(func() {
+		var err error
+		keyring, err = NewKeyring(map[string][]byte{"old": testKey(1), "new": testKey(2)}, "new")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("encrypts and decrypts values with the active key", func() {
+		encrypted, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(encrypted)).To(HavePrefix("enc:v1:new:"))
+		Expect(string(encrypted)).ToNot(ContainSubstring("secret"))
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+		Expect(keyring.NeedsRotation(encrypted)).To(BeFalse())
+
+		decrypted, err := keyring.Decrypt(encrypted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(decrypted)).To(Equal("secret"))
+	})
+
+	It("uses a new data key for every value", func() {
+		first, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		second, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("decrypts values encrypted with a previous key", func() {
+		oldKeyring, err := NewKeyring(map[string][]byte{"old": testKey(1)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := oldKeyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring.NeedsRotation(encrypted)).To(BeTrue())
+
+		decrypted, err := keyring.Decrypt(encrypted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(decrypted)).To(Equal("secret"))
+	})
+
+	It("returns plaintext values as they are", func() {
+		decrypted, err := keyring.Decrypt([]byte("plain"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(decrypted)).To(Equal("plain"))
+		Expect(keyring.NeedsRotation([]byte("plain"))).To(BeTrue())
+		Expect(keyring.NeedsRotation([]byte(""))).To(BeFalse())
+	})
+
+	It("fails to decrypt values encrypted with an unknown key", func() {
+		otherKeyring, err := NewKeyring(map[string][]byte{"other": testKey(3)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := otherKeyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = keyring.Decrypt(encrypted)
+		Expect(err).To(MatchError(ContainSubstring("encryption key other is not configured")))
+	})
+
+	It("fails to decrypt values that were tampered with", func() {
+		encrypted, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		parts := strings.Split(string(encrypted), ":")
+		parts[4] = base64.StdEncoding.EncodeToString([]byte("tampered ciphertext value"))
+
+		_, err = keyring.Decrypt([]byte(strings.Join(parts, ":")))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("validates the keys", func() {
+		_, err := NewKeyring(map[string][]byte{}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewKeyring(map[string][]byte{"short": []byte("short")}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewKeyring(map[string][]byte{"a:b": testKey(1)}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewKeyring(map[string][]byte{"a": testKey(1), "b": testKey(2)}, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewKeyring(map[string][]byte{"a": testKey(1)}, "b")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("LoadKeyring", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "encryption-keys")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("returns no keyring when encryption is not configured", func() {
+		keyring, err := LoadKeyring(Config{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring).To(BeNil())
+	})
+
+	It("loads keys from a JSON file", func() {
+		path := filepath.Join(dir, "keys.json")
+		content := `{"key1": "` + base64.StdEncoding.EncodeToString(testKey(1)) +
+			`", "key2": "` + base64.StdEncoding.EncodeToString(testKey(2)) + `"}`
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+
+		keyring, err := LoadKeyring(Config{KeysPath: path, ActiveKeyID: "key2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring.KeyIDs()).To(Equal([]string{"key1", "key2"}))
+		Expect(keyring.ActiveKeyID()).To(Equal("key2"))
+	})
+
+	It("loads keys from a mounted secret", func() {
+		Expect(os.Mkdir(filepath.Join(dir, "..data"), 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "key1"), []byte(base64.StdEncoding.EncodeToString(testKey(1))+"\n"), 0600)).To(Succeed())
+
+		keyring, err := LoadKeyring(Config{KeysPath: dir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring.KeyIDs()).To(Equal([]string{"key1"}))
+		Expect(keyring.ActiveKeyID()).To(Equal("key1"))
+	})
+
+	It("fails on keys that are not base64 encoded", func() {
+		Expect(os.WriteFile(filepath.Join(dir, "key1"), []byte("not base64!"), 0600)).To(Succeed())
+		_, err := LoadKeyring(Config{KeysPath: dir})
+		Expect(err).To(HaveOccurred())
+	})
+})
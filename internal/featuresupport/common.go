@@ -13,7 +13,7 @@ import (
 
 func GetSupportLevel[T models.FeatureSupportLevelID | models.ArchitectureSupportLevelID](featureId T, filters interface{}) models.SupportLevel {
 	if reflect.TypeOf(featureId).Name() == "FeatureSupportLevelID" {
-		ret, _ := featuresList[models.FeatureSupportLevelID(featureId)].getSupportLevel(filters.(SupportLevelFilters))
+		ret, _ := GetFeatureByID(models.FeatureSupportLevelID(featureId)).getSupportLevel(filters.(SupportLevelFilters))
 		return ret
 	}
 	return cpuFeaturesList[models.ArchitectureSupportLevelID(featureId)].getSupportLevel(filters.(string))
@@ -101,6 +101,8 @@ func getActivatedFeatures(log logrus.FieldLogger, cluster *common.Cluster, infra
 		}
 	}
 
+	featuresListLock.RLock()
+	defer featuresListLock.RUnlock()
 	for _, feature := range featuresList {
 		if feature.getFeatureActiveLevel(cluster, infraEnv, clusterUpdateParams, infraenvUpdateParams) == activeLevelActive {
 			activatedFeatures = append(activatedFeatures, feature)
@@ -112,7 +114,7 @@ func getActivatedFeatures(log logrus.FieldLogger, cluster *common.Cluster, infra
 }
 
 func IsFeatureCompatibleWithArchitecture(feature models.FeatureSupportLevelID, openshiftVersion, cpuArchitecture string) bool {
-	return isFeatureCompatibleWithArchitecture(featuresList[feature], openshiftVersion, cpuArchitecture)
+	return isFeatureCompatibleWithArchitecture(GetFeatureByID(feature), openshiftVersion, cpuArchitecture)
 }
 
 func isFeatureCompatibleWithArchitecture(feature SupportLevelFeature, openshiftVersion, cpuArchitecture string) bool {
@@ -128,36 +130,36 @@ func IsPlatformSupported(platformType models.PlatformType, externalPlatformName
 		CPUArchitecture:  &cpuArchitecture,
 	}
 
-	var featureID models.FeatureSupportLevelID
+	featureID, err := GetPlatformFeatureID(platformType, externalPlatformName)
+	if err != nil {
+		return false, err
+	}
+
+	supportLevel := GetSupportLevel(featureID, filters)
+	if supportLevel == models.SupportLevelUnsupported || supportLevel == models.SupportLevelUnavailable {
+		return false, nil
+	}
 
+	return true, nil
+}
+
+// GetPlatformFeatureID returns the ID of the feature of a platform
+func GetPlatformFeatureID(platformType models.PlatformType, externalPlatformName *string) (models.FeatureSupportLevelID, error) {
 	switch platformType {
 	case models.PlatformTypeBaremetal:
-		featureID = models.FeatureSupportLevelIDBAREMETALPLATFORM
+		return models.FeatureSupportLevelIDBAREMETALPLATFORM, nil
 	case models.PlatformTypeNutanix:
-		featureID = models.FeatureSupportLevelIDNUTANIXINTEGRATION
+		return models.FeatureSupportLevelIDNUTANIXINTEGRATION, nil
 	case models.PlatformTypeVsphere:
-		featureID = models.FeatureSupportLevelIDVSPHEREINTEGRATION
+		return models.FeatureSupportLevelIDVSPHEREINTEGRATION, nil
 	case models.PlatformTypeNone:
-		featureID = models.FeatureSupportLevelIDNONEPLATFORM
+		return models.FeatureSupportLevelIDNONEPLATFORM, nil
 	case models.PlatformTypeExternal:
-		featureID = models.FeatureSupportLevelIDEXTERNALPLATFORM
-
-		if externalPlatformName == nil {
-			break
-		}
-
-		switch *externalPlatformName {
-		case common.ExternalPlatformNameOci:
-			featureID = models.FeatureSupportLevelIDEXTERNALPLATFORMOCI
+		if externalPlatformName != nil && *externalPlatformName == common.ExternalPlatformNameOci {
+			return models.FeatureSupportLevelIDEXTERNALPLATFORMOCI, nil
 		}
+		return models.FeatureSupportLevelIDEXTERNALPLATFORM, nil
 	default:
-		return false, fmt.Errorf("invalid platform type: %s", platformType)
+		return "", fmt.Errorf("invalid platform type: %s", platformType)
 	}
-
-	supportLevel := GetSupportLevel(featureID, filters)
-	if supportLevel == models.SupportLevelUnsupported || supportLevel == models.SupportLevelUnavailable {
-		return false, nil
-	}
-
-	return true, nil
 }
@@ -3,12 +3,16 @@ package featuresupport
 import (
 	"fmt"
 	"slices"
+	"sync"
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 )
 
+// featuresListLock guards featuresList, to which the features of declarative operators are added after init
+var featuresListLock sync.RWMutex
+
 var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 	// Generic features
 	models.FeatureSupportLevelIDSNO:                       (&SnoFeature{}).New(),
@@ -65,6 +69,7 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 	models.FeatureSupportLevelIDMETALLB:                (&MetalLBFeature{}).New(),
 	models.FeatureSupportLevelIDLOKI:                   (&LokiFeature{}).New(),
 	models.FeatureSupportLevelIDOPENSHIFTLOGGING:       (&OpenShiftLoggingFeature{}).New(),
+	models.FeatureSupportLevelIDSRIOV:                  (&SriovFeature{}).New(),
 
 	// Platform features
 	models.FeatureSupportLevelIDNUTANIXINTEGRATION:  (&NutanixIntegrationFeature{}).New(),
@@ -76,9 +81,26 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 }
 
 func GetFeatureByID(featureID models.FeatureSupportLevelID) SupportLevelFeature {
+	featuresListLock.RLock()
+	defer featuresListLock.RUnlock()
 	return featuresList[featureID]
 }
 
+// RegisterOperatorFeature adds the feature of a declarative operator to the support level list. Registering the
+// feature of the same operator again replaces it.
+func RegisterOperatorFeature(feature *OperatorFeature) error {
+	featuresListLock.Lock()
+	defer featuresListLock.Unlock()
+	if existing, ok := featuresList[feature.ID]; ok {
+		registered, isOperatorFeature := existing.(*OperatorFeature)
+		if !isOperatorFeature || registered.OperatorName != feature.OperatorName {
+			return fmt.Errorf("feature support ID %s is already used by %s", feature.ID, existing.GetName())
+		}
+	}
+	featuresList[feature.ID] = feature
+	return nil
+}
+
 func getFeatureSupportList(features map[models.FeatureSupportLevelID]SupportLevelFeature, filters SupportLevelFilters) []models.Feature {
 	ret := make([]models.Feature, 0, len(features))
 
@@ -164,6 +186,8 @@ func GetFeatureSupportList(openshiftVersion string, cpuArchitecture *string, pla
 	if cpuArchitecture == nil {
 		filters.CPUArchitecture = swag.String(common.DefaultCPUArchitecture)
 	}
+	featuresListLock.RLock()
+	defer featuresListLock.RUnlock()
 	featuresSupportList := overrideInvalidRequest(featuresList, *filters.CPUArchitecture, openshiftVersion)
 	if featuresSupportList == nil {
 		featuresSupportList = getFeatureSupportList(featuresList, filters)
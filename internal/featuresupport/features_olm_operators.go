@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/oadp"
 	"github.com/openshift/assisted-service/internal/operators/openshiftlogging"
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -1239,3 +1240,131 @@ func (f *MetalLBFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *model
 	}
 	return activeLevelNotActive
 }
+
+// SriovFeature describes the support for the SR-IOV Network Operator.
+type SriovFeature struct{}
+
+func (f *SriovFeature) New() SupportLevelFeature {
+	return &SriovFeature{}
+}
+
+func (f *SriovFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDSRIOV
+}
+
+func (f *SriovFeature) GetName() string {
+	return sriov.FullName
+}
+
+func (f *SriovFeature) getSupportLevel(filters SupportLevelFilters) (models.SupportLevel, models.IncompatibilityReason) {
+	if !isFeatureCompatibleWithArchitecture(f, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
+	}
+
+	if isNotSupported, err := common.BaseVersionLessThan(sriov.SriovMinOpenshiftVersion, filters.OpenshiftVersion); isNotSupported || err != nil {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonOpenshiftVersion
+	}
+
+	return models.SupportLevelSupported, ""
+}
+
+func (f *SriovFeature) getIncompatibleArchitectures(_ *string) []models.ArchitectureSupportLevelID {
+	// The SR-IOV Network Operator is only available for x86_64 and arm64
+	return []models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDPPC64LEARCHITECTURE,
+		models.ArchitectureSupportLevelIDS390XARCHITECTURE,
+	}
+}
+
+func (f *SriovFeature) getIncompatibleFeatures(string) []models.FeatureSupportLevelID {
+	return []models.FeatureSupportLevelID{}
+}
+
+func (f *SriovFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isOperatorActivated(sriov.Name, cluster, clusterUpdateParams) {
+		return activeLevelActive
+	}
+	return activeLevelNotActive
+}
+
+// OperatorFeature describes the support for an OLM operator that is defined declaratively, instead of by an operator
+// plugin of the service. It is added to the support level list by RegisterOperatorFeature.
+type OperatorFeature struct {
+	ID           models.FeatureSupportLevelID
+	Name         string
+	OperatorName string
+	SupportLevel models.SupportLevel
+	// Architectures lists the CPU architectures that the operator supports, all of them when empty
+	Architectures []string
+	// MinOpenshiftVersion is the first OpenShift version that the operator supports, if any
+	MinOpenshiftVersion string
+	// MaxOpenshiftVersion is the last OpenShift minor version that the operator supports, if any
+	MaxOpenshiftVersion string
+}
+
+func (f *OperatorFeature) New() SupportLevelFeature {
+	return f
+}
+
+func (f *OperatorFeature) getId() models.FeatureSupportLevelID {
+	return f.ID
+}
+
+func (f *OperatorFeature) GetName() string {
+	return f.Name
+}
+
+// SupportsOpenshiftVersion checks whether the version is in the OpenShift version range of the operator
+func (f *OperatorFeature) SupportsOpenshiftVersion(openshiftVersion string) bool {
+	if f.MinOpenshiftVersion != "" {
+		if isNotSupported, err := common.BaseVersionLessThan(f.MinOpenshiftVersion, openshiftVersion); isNotSupported || err != nil {
+			return false
+		}
+	}
+	if f.MaxOpenshiftVersion != "" {
+		majorMinorVersion, err := common.GetMajorMinorVersion(openshiftVersion)
+		if err != nil {
+			return false
+		}
+		if isSupported, err := common.BaseVersionGreaterOrEqual(*majorMinorVersion, f.MaxOpenshiftVersion); !isSupported || err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *OperatorFeature) getSupportLevel(filters SupportLevelFilters) (models.SupportLevel, models.IncompatibilityReason) {
+	if !isFeatureCompatibleWithArchitecture(f, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonCPUArchitecture
+	}
+
+	if !f.SupportsOpenshiftVersion(filters.OpenshiftVersion) {
+		return models.SupportLevelUnavailable, models.IncompatibilityReasonOpenshiftVersion
+	}
+
+	return f.SupportLevel, ""
+}
+
+func (f *OperatorFeature) getIncompatibleArchitectures(_ *string) []models.ArchitectureSupportLevelID {
+	if len(f.Architectures) == 0 {
+		return nil
+	}
+	var incompatibleArchitectures []models.ArchitectureSupportLevelID
+	for cpuArchitecture, architectureID := range cpuArchitectureFeatureIdMap {
+		if !slices.Contains(f.Architectures, cpuArchitecture) {
+			incompatibleArchitectures = append(incompatibleArchitectures, architectureID)
+		}
+	}
+	return incompatibleArchitectures
+}
+
+func (f *OperatorFeature) getIncompatibleFeatures(string) []models.FeatureSupportLevelID {
+	return nil
+}
+
+func (f *OperatorFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isOperatorActivated(f.OperatorName, cluster, clusterUpdateParams) {
+		return activeLevelActive
+	}
+	return activeLevelNotActive
+}
@@ -84,6 +84,8 @@ const (
 	AreMetalLBRequirementsSatisfied                = validationID(models.HostValidationIDMetallbRequirementsSatisfied)
 	AreLokiRequirementsSatisfied                   = validationID(models.HostValidationIDLokiRequirementsSatisfied)
 	AreOpenShiftLoggingRequirementsSatisfied       = validationID(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied)
+	AreSriovRequirementsSatisfied                  = validationID(models.HostValidationIDSriovRequirementsSatisfied)
+	AreDeclarativeOperatorsRequirementsSatisfied   = validationID(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied)
 )
 
 func (v validationID) category() (string, error) {
@@ -156,7 +158,9 @@ func (v validationID) category() (string, error) {
 		AreOADPRequirementsSatisfied,
 		AreMetalLBRequirementsSatisfied,
 		AreLokiRequirementsSatisfied,
-		AreOpenShiftLoggingRequirementsSatisfied:
+		AreOpenShiftLoggingRequirementsSatisfied,
+		AreSriovRequirementsSatisfied,
+		AreDeclarativeOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
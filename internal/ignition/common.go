@@ -3,7 +3,12 @@ package ignition
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"regexp"
 
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
 	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
@@ -89,3 +94,83 @@ func AddStateRootCleanupToIgnition(log logrus.FieldLogger, ignition []byte, host
 	}
 	return ignition, nil
 }
+
+// setHostFilesInPointerIgnition adds the files that are specific to a host to its pointer ignition: the hostname, and
+// the node IP hint of single node clusters
+func setHostFilesInPointerIgnition(config *config_latest_types.Config, cluster *common.Cluster, host *models.Host) error {
+	hostname, err := hostutil.GetCurrentHostName(host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
+	}
+
+	ignitioncommon.SetFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420, true)
+	if common.IsSingleNodeCluster(cluster) {
+		if len(cluster.MachineNetworks) == 0 {
+			return errors.Errorf("Failed to set node ip hint, cluster %s has no machine network", cluster.ID)
+		}
+		ip, _, err := net.ParseCIDR(string(cluster.MachineNetworks[0].Cidr))
+		if err != nil {
+			return errors.Wrapf(err, "Failed to parse machine cidr for node ip hint content")
+		}
+		ignitioncommon.SetFileInIgnition(config, nodeIpHintFile, fmt.Sprintf("data:,KUBELET_NODEIP_HINT=%s", ip), false, 420, true)
+	}
+	return nil
+}
+
+// FormatHostPointerIgnition builds the pointer ignition of a host of a new cluster from the pointer ignition of its
+// role, the same way it is built when the installation starts, and applies the given ignition config overrides
+func FormatHostPointerIgnition(log logrus.FieldLogger, roleIgnition []byte, cluster *common.Cluster, host *models.Host, ignitionConfigOverrides string) ([]byte, error) {
+	config, err := ignitioncommon.ParseToLatest(roleIgnition)
+	if err != nil {
+		return nil, err
+	}
+	if err = setHostFilesInPointerIgnition(config, cluster, host); err != nil {
+		return nil, err
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	if ignitionConfigOverrides != "" {
+		merged, mergeErr := ignitioncommon.MergeIgnitionConfig(configBytes, []byte(ignitionConfigOverrides))
+		if mergeErr != nil {
+			return nil, errors.Wrapf(mergeErr, "failed to apply ignition config overrides for host %s", host.ID)
+		}
+		configBytes = []byte(merged)
+	}
+	return AddStateRootCleanupToIgnition(log, configBytes, host)
+}
+
+// discoveryCredentialFiles are the files of the discovery ignition that hold credentials
+var discoveryCredentialFiles = map[string]bool{
+	"/root/.docker/config.json":                          true,
+	"/etc/pki/ca-trust/source/anchors/rh-it-root-ca.crt": true,
+}
+
+var pullSecretTokenRegexp = regexp.MustCompile(`(?m)^(Environment=PULL_SECRET_TOKEN=).*$`)
+
+// RedactDiscoveryIgnition replaces the credentials of a discovery ignition with a placeholder: the SSH keys of its
+// users, the pull secret, the Red Hat root CA and the pull secret token of the agent. Unlike the ignition formatted
+// safe for logs, the redacted ignition is still valid.
+func RedactDiscoveryIgnition(discoveryIgnition []byte) ([]byte, error) {
+	config, err := ignitioncommon.ParseToLatest(discoveryIgnition)
+	if err != nil {
+		return nil, err
+	}
+	for i := range config.Passwd.Users {
+		for j := range config.Passwd.Users[i].SSHAuthorizedKeys {
+			config.Passwd.Users[i].SSHAuthorizedKeys[j] = "*****"
+		}
+	}
+	for i := range config.Storage.Files {
+		if discoveryCredentialFiles[config.Storage.Files[i].Path] {
+			config.Storage.Files[i].Contents.Source = swag.String("data:,*****")
+		}
+	}
+	for i := range config.Systemd.Units {
+		if contents := config.Systemd.Units[i].Contents; contents != nil {
+			config.Systemd.Units[i].Contents = swag.String(pullSecretTokenRegexp.ReplaceAllString(*contents, "${1}*****"))
+		}
+	}
+	return json.Marshal(config)
+}
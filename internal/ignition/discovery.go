@@ -14,6 +14,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/go-openapi/swag"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/common"
 	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
@@ -421,6 +422,24 @@ func (ib *ignitionBuilder) FormatDiscoveryIgnitionFile(ctx context.Context, infr
 		ib.log.Infof("Applying internal ignition override %s for infra env %s", infraEnv.InternalIgnitionConfigOverride, infraEnv.ID)
 	}
 
+	if swag.StringValue(infraEnv.DiscoveryCustomization) != "" {
+		var customization *models.DiscoveryCustomization
+		customization, err = ParseDiscoveryCustomization(swag.StringValue(infraEnv.DiscoveryCustomization))
+		if err != nil {
+			return "", err
+		}
+		var rendered string
+		rendered, err = RenderDiscoveryCustomization(customization, infraEnv.DiscoveryCustomizationRevision)
+		if err != nil {
+			return "", err
+		}
+		res, err = ignitioncommon.MergeIgnitionConfig([]byte(res), []byte(rendered))
+		if err != nil {
+			return "", err
+		}
+		ib.log.Infof("Applying discovery customization revision %d for infra env %s", infraEnv.DiscoveryCustomizationRevision, infraEnv.ID)
+	}
+
 	if infraEnv.IgnitionConfigOverride != "" {
 		res, err = ignitioncommon.MergeIgnitionConfig([]byte(res), []byte(infraEnv.IgnitionConfigOverride))
 		if err != nil {
@@ -0,0 +1,181 @@
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	config_latest_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/vincent-petithory/dataurl"
+)
+
+// Files that the discovery customization is rendered to, in addition to the files of the user
+const (
+	DiscoveryCustomizationRevisionPath = "/etc/assisted/discovery-customization-revision"
+	discoveryKernelModulesPath         = "/etc/modules-load.d/assisted-discovery-customization.conf"
+	discoverySysctlsPath               = "/etc/sysctl.d/99-assisted-discovery-customization.conf"
+	defaultDiscoveryFileMode           = 0644
+)
+
+// ParseDiscoveryCustomization parses the JSON formatted discovery customization of an infra-env
+func ParseDiscoveryCustomization(customization string) (*models.DiscoveryCustomization, error) {
+	var result models.DiscoveryCustomization
+	if err := json.Unmarshal([]byte(customization), &result); err != nil {
+		return nil, errors.Wrap(err, "failed to parse discovery customization")
+	}
+	return &result, nil
+}
+
+// IsDiscoveryCustomizationEmpty returns true if the discovery customization doesn't customize anything
+func IsDiscoveryCustomizationEmpty(customization *models.DiscoveryCustomization) bool {
+	return customization == nil || (len(customization.Files) == 0 && len(customization.SystemdUnits) == 0 &&
+		len(customization.KernelModules) == 0 && len(customization.Sysctls) == 0)
+}
+
+// ValidateDiscoveryCustomization validates the discovery customization against its schema, and verifies that
+// it doesn't define the same file, unit or drop-in twice
+func ValidateDiscoveryCustomization(customization *models.DiscoveryCustomization) error {
+	if customization == nil {
+		return nil
+	}
+	if err := customization.Validate(strfmt.Default); err != nil {
+		return errors.Wrap(err, "invalid discovery customization")
+	}
+
+	paths := map[string]bool{
+		DiscoveryCustomizationRevisionPath: true,
+		discoveryKernelModulesPath:         true,
+		discoverySysctlsPath:               true,
+	}
+	for _, file := range customization.Files {
+		filePath := swag.StringValue(file.Path)
+		if path.Clean(filePath) != filePath {
+			return errors.Errorf("discovery customization file path %s is not a clean absolute path", filePath)
+		}
+		if paths[filePath] {
+			return errors.Errorf("discovery customization file %s is defined more than once or is reserved by the service", filePath)
+		}
+		paths[filePath] = true
+	}
+
+	units := map[string]bool{}
+	for _, unit := range customization.SystemdUnits {
+		name := swag.StringValue(unit.Name)
+		if units[name] {
+			return errors.Errorf("discovery customization unit %s is defined more than once", name)
+		}
+		units[name] = true
+		if unit.Contents == "" && len(unit.Dropins) == 0 && unit.Enabled == nil {
+			return errors.Errorf("discovery customization unit %s must set contents, drop-ins or enabled", name)
+		}
+		dropins := map[string]bool{}
+		for _, dropin := range unit.Dropins {
+			dropinName := swag.StringValue(dropin.Name)
+			if dropins[dropinName] {
+				return errors.Errorf("drop-in %s of discovery customization unit %s is defined more than once", dropinName, name)
+			}
+			dropins[dropinName] = true
+		}
+	}
+
+	sysctls := map[string]bool{}
+	for _, sysctl := range customization.Sysctls {
+		key := swag.StringValue(sysctl.Key)
+		if sysctls[key] {
+			return errors.Errorf("discovery customization sysctl %s is defined more than once", key)
+		}
+		sysctls[key] = true
+	}
+	return nil
+}
+
+// RenderDiscoveryCustomization renders the discovery customization to an ignition config that can be merged
+// with the discovery ignition. The revision is written to the discovery environment so that a discovery image
+// can be traced back to the customization it was generated with.
+func RenderDiscoveryCustomization(customization *models.DiscoveryCustomization, revision int64) (string, error) {
+	// The discovery ignition template is version 3.1.0, so only features of that version are used
+	config := config_latest_types.Config{Ignition: config_latest_types.Ignition{Version: "3.1.0"}}
+
+	for _, file := range customization.Files {
+		mode := defaultDiscoveryFileMode
+		if file.Mode != nil {
+			mode = int(*file.Mode)
+		}
+		ignitioncommon.SetFileInIgnition(&config, swag.StringValue(file.Path),
+			dataurl.EncodeBytes([]byte(swag.StringValue(file.Contents))), false, mode, true)
+	}
+
+	if len(customization.KernelModules) > 0 {
+		ignitioncommon.SetFileInIgnition(&config, discoveryKernelModulesPath,
+			dataurl.EncodeBytes([]byte(strings.Join(customization.KernelModules, "\n")+"\n")), false, defaultDiscoveryFileMode, true)
+	}
+
+	if len(customization.Sysctls) > 0 {
+		var sysctls strings.Builder
+		for _, sysctl := range customization.Sysctls {
+			fmt.Fprintf(&sysctls, "%s = %s\n", swag.StringValue(sysctl.Key), swag.StringValue(sysctl.Value))
+		}
+		ignitioncommon.SetFileInIgnition(&config, discoverySysctlsPath,
+			dataurl.EncodeBytes([]byte(sysctls.String())), false, defaultDiscoveryFileMode, true)
+	}
+
+	for _, unit := range customization.SystemdUnits {
+		ignitionUnit := config_latest_types.Unit{
+			Name:    swag.StringValue(unit.Name),
+			Enabled: unit.Enabled,
+		}
+		if unit.Contents != "" {
+			ignitionUnit.Contents = swag.String(unit.Contents)
+		}
+		for _, dropin := range unit.Dropins {
+			ignitionUnit.Dropins = append(ignitionUnit.Dropins, config_latest_types.Dropin{
+				Name:     swag.StringValue(dropin.Name),
+				Contents: dropin.Contents,
+			})
+		}
+		config.Systemd.Units = append(config.Systemd.Units, ignitionUnit)
+	}
+
+	ignitioncommon.SetFileInIgnition(&config, DiscoveryCustomizationRevisionPath,
+		dataurl.EncodeBytes([]byte(fmt.Sprintf("%d\n", revision))), false, defaultDiscoveryFileMode, true)
+
+	res, err := json.Marshal(config)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render discovery customization")
+	}
+	return string(res), nil
+}
+
+// FindDiscoveryCustomizationConflicts returns the files and systemd units of the base discovery ignition that the
+// discovery customization replaces. Units of the base ignition may be extended with drop-ins, but not replaced.
+func FindDiscoveryCustomizationConflicts(base []byte, customization *models.DiscoveryCustomization) ([]*models.IgnitionOverrideConflict, error) {
+	rendered, err := RenderDiscoveryCustomization(customization, 0)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := ignitioncommon.FindOverrideConflicts(base, []byte(rendered))
+	if err != nil {
+		return nil, err
+	}
+
+	replacedUnits := map[string]bool{}
+	for _, unit := range customization.SystemdUnits {
+		if unit.Contents != "" {
+			replacedUnits[swag.StringValue(unit.Name)] = true
+		}
+	}
+	result := make([]*models.IgnitionOverrideConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		if conflict.Type == models.IgnitionOverrideConflictTypeUnit && !replacedUnits[conflict.Name] {
+			continue
+		}
+		result = append(result, conflict)
+	}
+	return result, nil
+}
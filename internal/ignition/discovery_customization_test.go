@@ -0,0 +1,68 @@
+package ignition
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Discovery customization", func() {
+	const base = `{"ignition": {"version": "3.1.0"},
+		"storage": {"files": [{"path": "/etc/motd", "contents": {"source": "data:,motd"}}]},
+		"systemd": {"units": [{"name": "agent.service", "enabled": true, "contents": "[Service]\nExecStart=/usr/bin/agent\n"}]}}`
+
+	file := func(path string) *models.DiscoveryCustomizationFile {
+		return &models.DiscoveryCustomizationFile{Path: swag.String(path), Contents: swag.String("contents")}
+	}
+
+	It("accepts a valid customization", func() {
+		Expect(ValidateDiscoveryCustomization(&models.DiscoveryCustomization{
+			Files:         []*models.DiscoveryCustomizationFile{file("/etc/a"), file("/etc/b")},
+			SystemdUnits:  []*models.DiscoveryCustomizationSystemdUnit{{Name: swag.String("a.service"), Contents: "[Service]\n"}},
+			KernelModules: []string{"br_netfilter"},
+			Sysctls:       []*models.DiscoveryCustomizationSysctl{{Key: swag.String("net.ipv4.ip_forward"), Value: swag.String("1")}},
+		})).To(Succeed())
+	})
+
+	It("rejects invalid customizations", func() {
+		for _, customization := range []*models.DiscoveryCustomization{
+			{Files: []*models.DiscoveryCustomizationFile{file("etc/relative")}},
+			{Files: []*models.DiscoveryCustomizationFile{file("/etc/../root/file")}},
+			{Files: []*models.DiscoveryCustomizationFile{file("/etc/a"), file("/etc/a")}},
+			{Files: []*models.DiscoveryCustomizationFile{file(DiscoveryCustomizationRevisionPath)}},
+			{KernelModules: []string{"br_netfilter; reboot"}},
+			{SystemdUnits: []*models.DiscoveryCustomizationSystemdUnit{{Name: swag.String("a.service")}}},
+			{SystemdUnits: []*models.DiscoveryCustomizationSystemdUnit{{Name: swag.String("not-a-unit"), Contents: "[Unit]\n"}}},
+			{Sysctls: []*models.DiscoveryCustomizationSysctl{
+				{Key: swag.String("vm.swappiness"), Value: swag.String("10")},
+				{Key: swag.String("vm.swappiness"), Value: swag.String("20")},
+			}},
+		} {
+			Expect(ValidateDiscoveryCustomization(customization)).NotTo(Succeed())
+		}
+	})
+
+	It("allows adding drop-ins to the units of the service", func() {
+		conflicts, err := FindDiscoveryCustomizationConflicts([]byte(base), &models.DiscoveryCustomization{
+			SystemdUnits: []*models.DiscoveryCustomizationSystemdUnit{{
+				Name:    swag.String("agent.service"),
+				Dropins: []*models.DiscoveryCustomizationSystemdDropin{{Name: swag.String("10-debug.conf"), Contents: swag.String("[Service]\n")}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(BeEmpty())
+	})
+
+	It("reports the files and units of the service that the customization replaces", func() {
+		conflicts, err := FindDiscoveryCustomizationConflicts([]byte(base), &models.DiscoveryCustomization{
+			Files:        []*models.DiscoveryCustomizationFile{file("/etc/motd"), file("/etc/other")},
+			SystemdUnits: []*models.DiscoveryCustomizationSystemdUnit{{Name: swag.String("agent.service"), Contents: "[Service]\n"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(Equal([]*models.IgnitionOverrideConflict{
+			{Type: models.IgnitionOverrideConflictTypeFile, Name: "/etc/motd"},
+			{Type: models.IgnitionOverrideConflictTypeUnit, Name: "agent.service"},
+		}))
+	})
+})
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/assisted-service/internal/common"
+	ignitioncommon "github.com/openshift/assisted-service/internal/common/ignition"
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -203,6 +204,26 @@ var _ = Describe("IgnitionBuilder", func() {
 		Expect(text).Should(ContainSubstring("data:,*****"))
 	})
 
+	It("redacted_ignition_is_valid", func() {
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error")).Times(1)
+		infraEnv.SSHAuthorizedKey = "ssh-rsa AAAAB3NzaC1yc2E secret-key"
+		infraEnv.IgnitionConfigOverride = `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:,hello"}}]}}`
+		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, ignitionConfig, false, auth.TypeRHSSO, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		redacted, err := RedactDiscoveryIgnition([]byte(text))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(redacted)).ShouldNot(ContainSubstring("secret-key"))
+		Expect(string(redacted)).ShouldNot(ContainSubstring("cloud.openshift.com"))
+		Expect(string(redacted)).ShouldNot(MatchRegexp(`PULL_SECRET_TOKEN=[^*]`))
+		config, err := ignitioncommon.ParseToLatest(redacted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Passwd.Users).To(HaveLen(1))
+		Expect(config.Passwd.Users[0].SSHAuthorizedKeys).To(ConsistOf(types_32.SSHAuthorizedKey("*****")))
+		Expect(string(redacted)).Should(ContainSubstring("/tmp/example"))
+	})
+
 	It("enabled_cert_verification", func() {
 		ignitionConfig.SkipCertVerification = false
 		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
@@ -426,6 +447,47 @@ var _ = Describe("IgnitionBuilder", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("applies the discovery customization", func() {
+		infraEnv.DiscoveryCustomization = swag.String(`{"files": [{"path": "/etc/pki/ca-trust/source/anchors/custom.crt", "contents": "certificate"}],
+			"systemd_units": [{"name": "agent.service", "dropins": [{"name": "10-debug.conf", "contents": "[Service]\nEnvironment=DEBUG=1\n"}]}],
+			"kernel_modules": ["br_netfilter"], "sysctls": [{"key": "net.ipv4.ip_forward", "value": "1"}]}`)
+		infraEnv.DiscoveryCustomizationRevision = 3
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(1)
+		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error")).Times(1)
+		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, ignitionConfig, false, auth.TypeRHSSO, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		config, report, err := config_31.Parse([]byte(text))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.IsFatal()).To(BeFalse())
+		Expect(config.Ignition.Version).To(Equal("3.1.0"))
+
+		files := map[string]string{}
+		for _, file := range config.Storage.Files {
+			if file.Contents.Source != nil {
+				var data *dataurl.DataURL
+				data, err = dataurl.DecodeString(*file.Contents.Source)
+				Expect(err).NotTo(HaveOccurred())
+				files[file.Path] = string(data.Data)
+			}
+		}
+		Expect(files).To(HaveKeyWithValue("/etc/pki/ca-trust/source/anchors/custom.crt", "certificate"))
+		Expect(files).To(HaveKeyWithValue(discoveryKernelModulesPath, "br_netfilter\n"))
+		Expect(files).To(HaveKeyWithValue(discoverySysctlsPath, "net.ipv4.ip_forward = 1\n"))
+		Expect(files).To(HaveKeyWithValue(DiscoveryCustomizationRevisionPath, "3\n"))
+
+		var agentUnit *types_31.Unit
+		for i := range config.Systemd.Units {
+			if config.Systemd.Units[i].Name == "agent.service" {
+				agentUnit = &config.Systemd.Units[i]
+			}
+		}
+		Expect(agentUnit).NotTo(BeNil())
+		Expect(agentUnit.Contents).NotTo(BeNil())
+		Expect(agentUnit.Dropins).To(HaveLen(1))
+		Expect(agentUnit.Dropins[0].Name).To(Equal("10-debug.conf"))
+	})
+
 	It("applies day2 overrides successfuly", func() {
 		hostID := strfmt.UUID(uuid.New().String())
 		cluster.Hosts = []*models.Host{{
@@ -1350,6 +1412,50 @@ var _ = Describe("FormatSecondDayWorkerIgnitionFile", func() {
 	})
 })
 
+var _ = Describe("FormatHostPointerIgnition", func() {
+	var (
+		cluster *common.Cluster
+		host    *models.Host
+	)
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "192.168.126.0/24"}},
+		}}
+		host = &models.Host{ID: &hostID, ClusterID: &clusterID, Inventory: hostInventory, Role: models.HostRoleMaster}
+	})
+
+	It("sets the hostname and applies the overrides", func() {
+		override := `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/etc/example", "contents": {"source": "data:,hello"}}]}}`
+		res, err := FormatHostPointerIgnition(common.GetTestLog(), []byte(`{"ignition": {"version": "3.2.0"}}`), cluster, host, override)
+		Expect(err).ToNot(HaveOccurred())
+		config, err := ignitioncommon.ParseToLatest(res)
+		Expect(err).ToNot(HaveOccurred())
+		paths := []string{}
+		for _, file := range config.Storage.Files {
+			paths = append(paths, file.Path)
+		}
+		Expect(paths).To(ContainElements("/etc/hostname", "/etc/example"))
+		Expect(paths).ToNot(ContainElement(nodeIpHintFile))
+	})
+
+	It("sets the node ip hint of single node clusters", func() {
+		cluster.ControlPlaneCount = 1
+		res, err := FormatHostPointerIgnition(common.GetTestLog(), []byte(`{"ignition": {"version": "3.2.0"}}`), cluster, host, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(res)).To(ContainSubstring(nodeIpHintFile))
+		Expect(string(res)).To(ContainSubstring("KUBELET_NODEIP_HINT=192.168.126.0"))
+	})
+
+	It("fails on an invalid role ignition", func() {
+		_, err := FormatHostPointerIgnition(common.GetTestLog(), []byte("not ignition"), cluster, host, "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 var _ = Describe("OKD overrides", func() {
 	var (
 		ctrl                               *gomock.Controller
@@ -7,6 +7,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 )
@@ -55,7 +56,9 @@ func (g *dummyGenerator) Generate(_ context.Context, installConfig []byte, _ boo
 			return err
 		}
 	}
-	return nil
+	return writeGenerationRecord(g.workDir, g.cluster, &models.IgnitionGenerationRecord{
+		InstallConfigHash: ContentSHA256(installConfig),
+	})
 }
 
 // UploadToS3 uploads the generated files to the configured S3-compatible storage
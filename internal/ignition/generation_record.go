@@ -0,0 +1,141 @@
+package ignition
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// GenerationRecordFileName is the name of the file that records the content hashes of the generated ignitions
+// and the inputs they were generated with. It is uploaded next to the ignitions.
+const GenerationRecordFileName = "ignition-generation-record.json"
+
+// ContentSHA256 returns the hex encoded SHA-256 hash of the content
+func ContentSHA256(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, f); err != nil {
+		return "", errors.Wrapf(err, "failed to read %s", path)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// manifestsSHA256 returns a hash of the manifests that the installer generates the ignitions from. The path of
+// every manifest is hashed along with its content, so renaming or moving a manifest changes the hash.
+func manifestsSHA256(workDir string) (string, error) {
+	hash := sha256.New()
+	for _, folder := range []string{models.ManifestFolderManifests, models.ManifestFolderOpenshift} {
+		root := filepath.Join(workDir, folder)
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) && path == root {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if entry.IsDir() {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			relPath, err := filepath.Rel(workDir, path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(hash, "%s %d\n", relPath, len(content))
+			_, err = hash.Write(content)
+			return err
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to hash the manifests in %s", root)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// writeGenerationRecord hashes the ignitions generated in the work directory and writes them, along with the
+// generation inputs of the record, to the generation record file
+func writeGenerationRecord(workDir string, cluster *common.Cluster, record *models.IgnitionGenerationRecord) error {
+	record.ClusterID = *cluster.ID
+	record.GeneratedAt = strfmt.DateTime(time.Now())
+	record.Ignitions = []*models.IgnitionContentHash{}
+
+	roleFiles := []struct {
+		fileName string
+		role     models.HostRole
+	}{
+		{"bootstrap.ign", models.HostRoleBootstrap},
+		{masterIgn, models.HostRoleMaster},
+		{arbiterIgn, models.HostRoleArbiter},
+		{workerIgn, models.HostRoleWorker},
+	}
+	for _, roleFile := range roleFiles {
+		if roleFile.fileName == arbiterIgn && !common.IsClusterTopologyHighlyAvailableArbiter(cluster) {
+			continue
+		}
+		hash, err := fileSHA256(filepath.Join(workDir, roleFile.fileName))
+		if err != nil {
+			return err
+		}
+		record.Ignitions = append(record.Ignitions, &models.IgnitionContentHash{
+			FileName: roleFile.fileName,
+			Role:     roleFile.role,
+			Sha256:   hash,
+		})
+	}
+
+	masters, arbiters, workers := sortHosts(cluster.Hosts)
+	for _, hosts := range [][]*models.Host{masters, arbiters, workers} {
+		for _, host := range hosts {
+			fileName := hostutil.IgnitionFileName(host)
+			hash, err := fileSHA256(filepath.Join(workDir, fileName))
+			if err != nil {
+				return err
+			}
+			record.Ignitions = append(record.Ignitions, &models.IgnitionContentHash{
+				FileName: fileName,
+				Role:     common.GetEffectiveRole(host),
+				HostID:   *host.ID,
+				Sha256:   hash,
+			})
+		}
+	}
+
+	content, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the ignition generation record")
+	}
+	return os.WriteFile(filepath.Join(workDir, GenerationRecordFileName), content, 0600)
+}
+
+// ParseGenerationRecord parses an ignition generation record file
+func ParseGenerationRecord(content []byte) (*models.IgnitionGenerationRecord, error) {
+	var record models.IgnitionGenerationRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the ignition generation record")
+	}
+	return &record, nil
+}
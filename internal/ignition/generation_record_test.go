@@ -0,0 +1,121 @@
+package ignition
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Ignition generation record", func() {
+	var (
+		workDir string
+		cluster *common.Cluster
+	)
+
+	writeFile := func(name, content string) {
+		path := filepath.Join(workDir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "generation-record-test-")
+		Expect(err).NotTo(HaveOccurred())
+		cluster = testCluster()
+		masterID := strfmt.UUID(uuid.New().String())
+		workerID := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = []*models.Host{
+			{ID: &masterID, Role: models.HostRoleMaster, Bootstrap: true},
+			{ID: &workerID, Role: models.HostRoleWorker},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("records the content hash of every generated ignition", func() {
+		writeFile("bootstrap.ign", "bootstrap")
+		writeFile(masterIgn, "master")
+		writeFile(workerIgn, "worker")
+		for _, host := range cluster.Hosts {
+			writeFile(hostutil.IgnitionFileName(host), "host "+host.ID.String())
+		}
+
+		Expect(writeGenerationRecord(workDir, cluster, &models.IgnitionGenerationRecord{
+			ReleaseImage:      "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64",
+			InstallConfigHash: ContentSHA256([]byte("install-config")),
+		})).To(Succeed())
+
+		content, err := os.ReadFile(filepath.Join(workDir, GenerationRecordFileName))
+		Expect(err).NotTo(HaveOccurred())
+		record, err := ParseGenerationRecord(content)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.ClusterID).To(Equal(*cluster.ID))
+		Expect(record.ReleaseImage).To(Equal("quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64"))
+		Expect(record.InstallConfigHash).To(Equal(ContentSHA256([]byte("install-config"))))
+
+		Expect(record.Ignitions).To(HaveLen(5))
+		Expect(*record.Ignitions[0]).To(Equal(models.IgnitionContentHash{
+			FileName: "bootstrap.ign", Role: models.HostRoleBootstrap, Sha256: ContentSHA256([]byte("bootstrap")),
+		}))
+		Expect(*record.Ignitions[1]).To(Equal(models.IgnitionContentHash{
+			FileName: masterIgn, Role: models.HostRoleMaster, Sha256: ContentSHA256([]byte("master")),
+		}))
+		Expect(*record.Ignitions[2]).To(Equal(models.IgnitionContentHash{
+			FileName: workerIgn, Role: models.HostRoleWorker, Sha256: ContentSHA256([]byte("worker")),
+		}))
+		for i, host := range cluster.Hosts {
+			Expect(*record.Ignitions[3+i]).To(Equal(models.IgnitionContentHash{
+				FileName: hostutil.IgnitionFileName(host),
+				Role:     host.Role,
+				HostID:   *host.ID,
+				Sha256:   ContentSHA256([]byte("host " + host.ID.String())),
+			}))
+		}
+	})
+
+	It("fails when an ignition wasn't generated", func() {
+		writeFile("bootstrap.ign", "bootstrap")
+		writeFile(masterIgn, "master")
+		writeFile(workerIgn, "worker")
+
+		Expect(writeGenerationRecord(workDir, cluster, &models.IgnitionGenerationRecord{})).NotTo(Succeed())
+	})
+
+	It("hashes the paths and contents of the manifests", func() {
+		writeFile("manifests/cluster-config.yaml", "config")
+		writeFile("openshift/99_openshift-machineconfig_master.yaml", "machineconfig")
+		revision, err := manifestsSHA256(workDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		again, err := manifestsSHA256(workDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(revision))
+
+		writeFile("openshift/99_openshift-machineconfig_master.yaml", "modified")
+		modified, err := manifestsSHA256(workDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).NotTo(Equal(revision))
+
+		Expect(os.Rename(filepath.Join(workDir, "openshift/99_openshift-machineconfig_master.yaml"),
+			filepath.Join(workDir, "openshift/99_renamed.yaml"))).To(Succeed())
+		renamed, err := manifestsSHA256(workDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renamed).NotTo(Equal(modified))
+	})
+
+	It("hashes missing manifest folders as empty", func() {
+		revision, err := manifestsSHA256(workDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision).To(Equal(ContentSHA256(nil)))
+	})
+})
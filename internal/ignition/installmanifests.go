@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
@@ -56,6 +57,18 @@ const (
 	nodeIpHintFile = "/etc/default/nodeip-configuration"
 )
 
+// RoleIgnitionFileName returns the name of the pointer ignition file that the installer generates for a host role
+func RoleIgnitionFileName(role models.HostRole) string {
+	switch role {
+	case models.HostRoleMaster, models.HostRoleBootstrap:
+		return masterIgn
+	case models.HostRoleArbiter:
+		return arbiterIgn
+	default:
+		return workerIgn
+	}
+}
+
 const highlyAvailableInfrastructureTopologyPatch = `---
 - op: replace
   path: /status/infrastructureTopology
@@ -81,6 +94,11 @@ type Generator interface {
 	UploadToS3(ctx context.Context) error
 }
 
+// ManifestsRenderer can render the manifests that the installer uses to generate the ignition files
+type ManifestsRenderer interface {
+	RenderManifests(ctx context.Context, installConfig []byte, forceInsecurePolicyJson bool, generatedManifests map[string][]byte) (*RenderedManifests, error)
+}
+
 type installerGenerator struct {
 	log                           logrus.FieldLogger
 	workDir                       string
@@ -109,12 +127,28 @@ var fileNames = [...]string{
 	"kubeconfig-noingress",
 	"kubeadmin-password",
 	"install-config.yaml",
+	GenerationRecordFileName,
 }
 
 // NewGenerator returns a generator that can generate ignition files
 func NewGenerator(workDir string, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
 	serviceCACert string, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, providerRegistry registry.ProviderRegistry,
 	installerReleaseImageOverride, clusterTLSCertOverrideDir string, manifestApi manifestsapi.ManifestsAPI, eventsHandler eventsapi.Handler, installerCache *installercache.Installers) Generator {
+	return newInstallerGenerator(workDir, cluster, releaseImage, releaseImageMirror, serviceCACert, installInvoker, s3Client, log,
+		providerRegistry, installerReleaseImageOverride, clusterTLSCertOverrideDir, manifestApi, installerCache)
+}
+
+// NewManifestsRenderer returns a renderer of the installer manifests of a cluster
+func NewManifestsRenderer(workDir string, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
+	serviceCACert string, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, providerRegistry registry.ProviderRegistry,
+	installerReleaseImageOverride, clusterTLSCertOverrideDir string, manifestApi manifestsapi.ManifestsAPI, installerCache *installercache.Installers) ManifestsRenderer {
+	return newInstallerGenerator(workDir, cluster, releaseImage, releaseImageMirror, serviceCACert, installInvoker, s3Client, log,
+		providerRegistry, installerReleaseImageOverride, clusterTLSCertOverrideDir, manifestApi, installerCache)
+}
+
+func newInstallerGenerator(workDir string, cluster *common.Cluster, releaseImage string, releaseImageMirror string,
+	serviceCACert string, installInvoker string, s3Client s3wrapper.API, log logrus.FieldLogger, providerRegistry registry.ProviderRegistry,
+	installerReleaseImageOverride, clusterTLSCertOverrideDir string, manifestApi manifestsapi.ManifestsAPI, installerCache *installercache.Installers) *installerGenerator {
 	return &installerGenerator{
 		cluster:                       cluster,
 		log:                           log,
@@ -170,23 +204,9 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 		}
 	}()
 
-	// In case we don't want to override image for extracting installer use release one
-	if g.installerReleaseImageOverride == "" {
-		g.installerReleaseImageOverride = g.releaseImage
-	}
-
-	mirrorRegistriesBuilder := mirrorregistries.New(forceInsecurePolicyJson)
-	ocRelease := oc.NewRelease(
-		&executer.CommonExecuter{},
-		oc.Config{MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay},
-		mirrorRegistriesBuilder,
-		system.NewLocalSystemInfo(),
-	)
-
-	release, err := g.installerCache.Get(ctx, g.installerReleaseImageOverride, g.releaseImageMirror,
-		g.cluster.PullSecret, ocRelease, g.cluster.OpenshiftVersion, *g.cluster.ID)
+	release, err := g.getInstallerRelease(ctx, forceInsecurePolicyJson)
 	if err != nil {
-		return errors.Wrap(err, "failed to get installer path")
+		return err
 	}
 
 	//cleanup resources at the end
@@ -200,29 +220,13 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	installerPath := release.Path
 	installConfigPath := filepath.Join(g.workDir, "install-config.yaml")
 
-	g.enableMetal3Provisioning, err = common.VersionGreaterOrEqual(g.cluster.Cluster.OpenshiftVersion, "4.7")
+	installerDigest, err := fileSHA256(installerPath)
 	if err != nil {
 		return err
 	}
 
-	g.encodedDhcpFileContents, err = network.GetEncodedDhcpParamFileContents(g.cluster)
+	envVars, err := g.installerEnvVars(log)
 	if err != nil {
-		wrapped := errors.Wrapf(err, "Could not create DHCP encoded file")
-		log.WithError(wrapped).Errorf("GenerateInstallConfig")
-		return wrapped
-	}
-
-	g.allocateNodeIpsIfNeeded(log)
-
-	envVars := append(os.Environ(),
-		"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE="+g.releaseImage,
-		"OPENSHIFT_INSTALL_INVOKER="+g.installInvoker,
-	)
-	if g.clusterTLSCertOverrideDir != "" {
-		envVars = append(envVars, "OPENSHIFT_INSTALL_LOAD_CLUSTER_CERTS=true")
-	}
-
-	if envVars, err = g.addBootstrapKubeletIpIfRequired(log, envVars); err != nil {
 		return err
 	}
 
@@ -243,54 +247,19 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 		return err
 	}
 
-	err = g.providerRegistry.PreCreateManifestsHook(g.cluster, &envVars, g.workDir)
-
-	if err != nil {
-		log.WithError(err).Errorf("failed to run pre manifests creation hook '%s'", common.PlatformTypeValue(g.cluster.Platform.Type))
-		return err
-	}
-
-	err = g.importClusterTLSCerts(ctx)
-	if err != nil {
-		log.WithError(err).Error("Failed to import cluster TLS certs")
-		return err
-	}
-
-	err = g.runCreateCommand(ctx, installerPath, "manifests", envVars)
-	if err != nil {
-		return err
-	}
-	err = g.providerRegistry.PostCreateManifestsHook(g.cluster, &envVars, g.workDir)
-	if err != nil {
-		log.WithError(err).Errorf("failed to run post manifests creation hook '%s'", common.PlatformTypeValue(g.cluster.Platform.Type))
-		return err
-	}
-
-	// download manifests files to working directory
-	for _, manifest := range manifestFiles {
-		log.Infof("adding manifest %s to working dir for cluster %s", manifest.Path, g.cluster.ID)
-		err = g.downloadManifest(ctx, manifest.Path)
-		if err != nil {
-			log.WithError(err).Errorf("Failed to download manifest %s to working dir for cluster %s", manifest.Path, g.cluster.ID)
-			return err
-		}
-	}
-
-	err = g.expandUserMultiDocYamls(ctx)
+	err = g.createManifests(ctx, installerPath, &envVars)
 	if err != nil {
-		log.WithError(err).Errorf("failed expand multi-document yaml for cluster '%s'", g.cluster.ID)
 		return err
 	}
 
-	err = g.applyManifestPatches(ctx)
+	err = g.addClusterManifests(ctx, manifestFiles)
 	if err != nil {
-		log.WithError(err).Errorf("failed to apply manifests' patches for cluster '%s'", g.cluster.ID)
 		return err
 	}
 
-	err = g.applyInfrastructureCRPatch(ctx)
+	// the installer consumes the manifests, so they are hashed before the ignitions are created
+	manifestsRevision, err := manifestsSHA256(g.workDir)
 	if err != nil {
-		log.WithError(err).Errorf("failed to patch the infrastructure CR manifest '%s'", common.PlatformTypeValue(g.cluster.Platform.Type))
 		return err
 	}
 
@@ -346,9 +315,241 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	if err != nil {
 		return err
 	}
+
+	err = writeGenerationRecord(g.workDir, g.cluster, &models.IgnitionGenerationRecord{
+		ReleaseImage:      g.releaseImage,
+		InstallerDigest:   installerDigest,
+		ManifestsRevision: manifestsRevision,
+		InstallConfigHash: ContentSHA256(installConfig),
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to write the ignition generation record of cluster %s", g.cluster.ID)
+		return err
+	}
 	return nil
 }
 
+// RenderManifests runs the installer manifests creation in the work directory, and applies the cluster manifests the
+// same way Generate does, without generating the ignition files. The generated manifests are added to the openshift
+// folder before the cluster manifests, as the operator manifests are when the installation starts. Failures of the
+// installer and of the user manifests are returned as bad request API errors, the others are internal errors.
+func (g *installerGenerator) RenderManifests(ctx context.Context, installConfig []byte, forceInsecurePolicyJson bool,
+	generatedManifests map[string][]byte) (*RenderedManifests, error) {
+	log := logutil.FromContext(ctx, g.log)
+
+	release, err := g.getInstallerRelease(ctx, forceInsecurePolicyJson)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := release.Cleanup(ctx); e != nil {
+			log.WithError(e).Warnf("Failed to clean up installer release %s", release.Path)
+		}
+	}()
+
+	envVars, err := g.installerEnvVars(log)
+	if err != nil {
+		return nil, err
+	}
+
+	installConfigPath := filepath.Join(g.workDir, "install-config.yaml")
+	if err = os.WriteFile(installConfigPath, installConfig, 0600); err != nil {
+		return nil, errors.Wrapf(err, "failed to write file %s", installConfigPath)
+	}
+
+	manifestFiles, err := manifests.GetClusterManifests(ctx, g.cluster.ID, g.s3Client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get manifests of cluster %s", g.cluster.ID)
+	}
+
+	if err = g.createManifests(ctx, release.Path, &envVars); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		return nil, err
+	}
+
+	rendered := &RenderedManifests{}
+	if rendered.Defaults, err = g.readManifests(); err != nil {
+		return nil, err
+	}
+
+	for name, content := range generatedManifests {
+		manifestPath := filepath.Join(g.workDir, models.ManifestFolderOpenshift, name)
+		if err = os.WriteFile(manifestPath, content, 0600); err != nil {
+			return nil, errors.Wrapf(err, "failed to write generated manifest %s", manifestPath)
+		}
+	}
+
+	if err = g.downloadClusterManifests(ctx, manifestFiles); err != nil {
+		return nil, err
+	}
+	if err = g.applyUserManifests(ctx); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = g.applyInfrastructureCRPatch(ctx); err != nil {
+		return nil, err
+	}
+
+	if rendered.Rendered, err = g.readManifests(); err != nil {
+		return nil, err
+	}
+	return rendered, nil
+}
+
+func (g *installerGenerator) getInstallerRelease(ctx context.Context, forceInsecurePolicyJson bool) (*installercache.Release, error) {
+	// In case we don't want to override image for extracting installer use release one
+	if g.installerReleaseImageOverride == "" {
+		g.installerReleaseImageOverride = g.releaseImage
+	}
+
+	mirrorRegistriesBuilder := mirrorregistries.New(forceInsecurePolicyJson)
+	ocRelease := oc.NewRelease(
+		&executer.CommonExecuter{},
+		oc.Config{MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay},
+		mirrorRegistriesBuilder,
+		system.NewLocalSystemInfo(),
+	)
+
+	release, err := g.installerCache.Get(ctx, g.installerReleaseImageOverride, g.releaseImageMirror,
+		g.cluster.PullSecret, ocRelease, g.cluster.OpenshiftVersion, *g.cluster.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get installer path")
+	}
+	return release, nil
+}
+
+func (g *installerGenerator) installerEnvVars(log logrus.FieldLogger) ([]string, error) {
+	var err error
+	g.enableMetal3Provisioning, err = common.VersionGreaterOrEqual(g.cluster.Cluster.OpenshiftVersion, "4.7")
+	if err != nil {
+		return nil, err
+	}
+
+	g.encodedDhcpFileContents, err = network.GetEncodedDhcpParamFileContents(g.cluster)
+	if err != nil {
+		wrapped := errors.Wrapf(err, "Could not create DHCP encoded file")
+		log.WithError(wrapped).Errorf("GenerateInstallConfig")
+		return nil, wrapped
+	}
+
+	g.allocateNodeIpsIfNeeded(log)
+
+	envVars := append(os.Environ(),
+		"OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE="+g.releaseImage,
+		"OPENSHIFT_INSTALL_INVOKER="+g.installInvoker,
+	)
+	if g.clusterTLSCertOverrideDir != "" {
+		envVars = append(envVars, "OPENSHIFT_INSTALL_LOAD_CLUSTER_CERTS=true")
+	}
+
+	return g.addBootstrapKubeletIpIfRequired(log, envVars)
+}
+
+// createManifests runs openshift-install create manifests in the work directory, along with the platform hooks
+func (g *installerGenerator) createManifests(ctx context.Context, installerPath string, envVars *[]string) error {
+	log := logutil.FromContext(ctx, g.log)
+
+	err := g.providerRegistry.PreCreateManifestsHook(g.cluster, envVars, g.workDir)
+	if err != nil {
+		log.WithError(err).Errorf("failed to run pre manifests creation hook '%s'", common.PlatformTypeValue(g.cluster.Platform.Type))
+		return err
+	}
+
+	err = g.importClusterTLSCerts(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to import cluster TLS certs")
+		return err
+	}
+
+	err = g.runCreateCommand(ctx, installerPath, "manifests", *envVars)
+	if err != nil {
+		return err
+	}
+	err = g.providerRegistry.PostCreateManifestsHook(g.cluster, envVars, g.workDir)
+	if err != nil {
+		log.WithError(err).Errorf("failed to run post manifests creation hook '%s'", common.PlatformTypeValue(g.cluster.Platform.Type))
+		return err
+	}
+	return nil
+}
+
+// addClusterManifests downloads the cluster manifests to the work directory, expands the user multi-document
+// manifests and applies the manifest patches and the infrastructure CR patch
+func (g *installerGenerator) addClusterManifests(ctx context.Context, manifestFiles []s3wrapper.ObjectInfo) error {
+	if err := g.downloadClusterManifests(ctx, manifestFiles); err != nil {
+		return err
+	}
+	if err := g.applyUserManifests(ctx); err != nil {
+		return err
+	}
+	return g.applyInfrastructureCRPatch(ctx)
+}
+
+// downloadClusterManifests downloads the cluster manifests to the work directory and lints them
+func (g *installerGenerator) downloadClusterManifests(ctx context.Context, manifestFiles []s3wrapper.ObjectInfo) error {
+	log := logutil.FromContext(ctx, g.log)
+
+	for _, manifest := range manifestFiles {
+		log.Infof("adding manifest %s to working dir for cluster %s", manifest.Path, g.cluster.ID)
+		err := g.downloadManifest(ctx, manifest.Path)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to download manifest %s to working dir for cluster %s", manifest.Path, g.cluster.ID)
+			return err
+		}
+	}
+
+	g.lintClusterManifests(ctx, manifestFiles)
+	return nil
+}
+
+// applyUserManifests expands the user multi-document manifests and applies the manifest patches, which fails when
+// the user manifests are invalid
+func (g *installerGenerator) applyUserManifests(ctx context.Context) error {
+	log := logutil.FromContext(ctx, g.log)
+
+	err := g.expandUserMultiDocYamls(ctx)
+	if err != nil {
+		log.WithError(err).Errorf("failed expand multi-document yaml for cluster '%s'", g.cluster.ID)
+		return err
+	}
+
+	err = g.applyManifestPatches(ctx)
+	if err != nil {
+		log.WithError(err).Errorf("failed to apply manifests' patches for cluster '%s'", g.cluster.ID)
+		return err
+	}
+	return nil
+}
+
+// readManifests returns the content of the files in the manifests and openshift folders of the work directory,
+// keyed by their path relative to the work directory
+func (g *installerGenerator) readManifests() (map[string][]byte, error) {
+	result := map[string][]byte{}
+	for _, folder := range []string{models.ManifestFolderManifests, models.ManifestFolderOpenshift} {
+		entries, err := os.ReadDir(filepath.Join(g.workDir, folder))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to read folder %s", folder)
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() {
+				continue
+			}
+			name := filepath.Join(folder, entry.Name())
+			content, err := os.ReadFile(filepath.Join(g.workDir, name))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read manifest %s", name)
+			}
+			result[name] = content
+		}
+	}
+	return result, nil
+}
+
 func (g *installerGenerator) addBootstrapKubeletIpIfRequired(log logrus.FieldLogger, envVars []string) ([]string, error) {
 	// setting bootstrap kubelet node ip
 	log.Debugf("Adding bootstrap ip to env vars")
@@ -572,6 +773,38 @@ func (g *installerGenerator) bootstrapInPlaceIgnitionsCreate(ctx context.Context
 	return nil
 }
 
+// lintClusterManifests lints the downloaded cluster manifests against the OpenShift version of the cluster and against
+// each other, including the manifests that were generated for the operators, and logs the issues. The lint errors are
+// reported by the cluster validations, they don't fail the generation.
+func (g *installerGenerator) lintClusterManifests(ctx context.Context, manifestFiles []s3wrapper.ObjectInfo) {
+	log := logutil.FromContext(ctx, g.log)
+	prefix := manifests.GetManifestObjectName(*g.cluster.ID, "")
+	manifestContents := make([]manifests.ManifestContent, 0, len(manifestFiles))
+	for _, manifest := range manifestFiles {
+		path := strings.TrimPrefix(manifest.Path, prefix)
+		content, err := os.ReadFile(filepath.Join(g.workDir, path))
+		if err != nil {
+			// Empty manifests aren't downloaded
+			if !os.IsNotExist(err) {
+				log.WithError(err).Warnf("Failed to read manifest %s of cluster %s in order to lint it", path, g.cluster.ID)
+			}
+			continue
+		}
+		manifestContents = append(manifestContents, manifests.ManifestContent{
+			Path:    path,
+			Source:  manifest.Metadata[constants.ManifestSourceAttribute],
+			Content: content,
+		})
+	}
+
+	issues := manifests.LintClusterManifests(g.cluster, manifestContents)
+	for path, pathIssues := range issues {
+		for _, issue := range pathIssues {
+			log.Warnf("Manifest %s of cluster %s has a lint %s: %s", path, g.cluster.ID, issue.Severity, issue.Message)
+		}
+	}
+}
+
 // expandUserMultiDocYamls finds if user uploaded multi document yaml files and
 // split them into several files
 func (g *installerGenerator) expandUserMultiDocYamls(ctx context.Context) error {
@@ -1163,20 +1396,10 @@ func (g *installerGenerator) writeSingleHostFile(host *models.Host, baseFile str
 		return err
 	}
 
-	hostname, err := hostutil.GetCurrentHostName(host)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get hostname for host %s", host.ID)
+	if err = setHostFilesInPointerIgnition(config, g.cluster, host); err != nil {
+		return err
 	}
-
-	ignitioncommon.SetFileInIgnition(config, "/etc/hostname", fmt.Sprintf("data:,%s", hostname), false, 420, true)
-	if common.IsSingleNodeCluster(g.cluster) {
-		machineCidr := g.cluster.MachineNetworks[0]
-		ip, _, errP := net.ParseCIDR(string(machineCidr.Cidr))
-		if errP != nil {
-			return errors.Wrapf(errP, "Failed to parse machine cidr for node ip hint content")
-		}
-		ignitioncommon.SetFileInIgnition(config, nodeIpHintFile, fmt.Sprintf("data:,KUBELET_NODEIP_HINT=%s", ip), false, 420, true)
-	} else if g.nodeIpAllocations != nil && (common.IsMultiNodeNonePlatformCluster(g.cluster) || network.IsLoadBalancerUserManaged(g.cluster)) {
+	if !common.IsSingleNodeCluster(g.cluster) && g.nodeIpAllocations != nil && (common.IsMultiNodeNonePlatformCluster(g.cluster) || network.IsLoadBalancerUserManaged(g.cluster)) {
 		allocation, ok := g.nodeIpAllocations[lo.FromPtr(host.ID)]
 		if ok {
 			ignitioncommon.SetFileInIgnition(config, nodeIpHintFile, fmt.Sprintf("data:,KUBELET_NODEIP_HINT=%s", allocation.HintIp), false, 420, true)
@@ -2391,3 +2391,102 @@ var _ = Describe("Import Cluster TLS Certs for ephemeral installer", func() {
 		}
 	})
 })
+
+var _ = Describe("Rendered manifests", func() {
+	var (
+		generator *installerGenerator
+		workDir   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "rendered-manifests-test-")
+		Expect(err).NotTo(HaveOccurred())
+		generator = &installerGenerator{
+			log:     logrus.New(),
+			workDir: workDir,
+			cluster: testCluster(),
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("reads the manifests of both folders", func() {
+		Expect(os.Mkdir(filepath.Join(workDir, "manifests"), 0755)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(workDir, "openshift"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(workDir, "manifests", "cvo-overrides.yaml"), []byte("a"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(workDir, "openshift", "99-custom.yaml"), []byte("b"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(workDir, "install-config.yaml"), []byte("c"), 0600)).To(Succeed())
+
+		manifests, err := generator.readManifests()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifests).To(Equal(map[string][]byte{
+			"manifests/cvo-overrides.yaml": []byte("a"),
+			"openshift/99-custom.yaml":     []byte("b"),
+		}))
+	})
+
+	Context("List", func() {
+		rendered := &RenderedManifests{
+			Defaults: map[string][]byte{
+				"manifests/cluster-scheduler-02-config.yml": []byte("spec:\n  mastersSchedulable: false\n"),
+				"manifests/cvo-overrides.yaml":              []byte("spec: {}\n"),
+			},
+			Rendered: map[string][]byte{
+				"manifests/cluster-scheduler-02-config.yml": []byte("spec:\n  mastersSchedulable: true\n"),
+				"manifests/cvo-overrides.yaml":              []byte("spec: {}\n"),
+				"openshift/99-custom.yaml":                  []byte("kind: ConfigMap\n"),
+			},
+		}
+
+		It("returns all the manifests with their content", func() {
+			manifests, err := rendered.List(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(Equal(models.ListRenderedManifests{
+				{Folder: "manifests", FileName: "cluster-scheduler-02-config.yml", Change: models.RenderedManifestChangeModified, Content: "spec:\n  mastersSchedulable: true\n"},
+				{Folder: "manifests", FileName: "cvo-overrides.yaml", Change: models.RenderedManifestChangeUnchanged, Content: "spec: {}\n"},
+				{Folder: "openshift", FileName: "99-custom.yaml", Change: models.RenderedManifestChangeAdded, Content: "kind: ConfigMap\n"},
+			}))
+		})
+
+		It("returns the changed manifests with their diff", func() {
+			manifests, err := rendered.List(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(HaveLen(2))
+			Expect(manifests[0].FileName).To(Equal("cluster-scheduler-02-config.yml"))
+			Expect(manifests[0].Content).To(BeEmpty())
+			Expect(manifests[0].Diff).To(Equal("--- a/manifests/cluster-scheduler-02-config.yml\n" +
+				"+++ b/manifests/cluster-scheduler-02-config.yml\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				" spec:\n" +
+				"-  mastersSchedulable: false\n" +
+				"+  mastersSchedulable: true\n"))
+			Expect(manifests[1].FileName).To(Equal("99-custom.yaml"))
+			Expect(manifests[1].Change).To(Equal(models.RenderedManifestChangeAdded))
+			Expect(manifests[1].Diff).To(ContainSubstring("+kind: ConfigMap\n"))
+		})
+	})
+
+	It("redacts the values of the Secret manifests", func() {
+		pullSecret := []byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: pull-secret\n" +
+			"data:\n  .dockerconfigjson: eyJhdXRocyI6e319\n")
+		rendered := &RenderedManifests{
+			Defaults: map[string][]byte{
+				"openshift/99_kubeadmin-password-secret.yaml": []byte("kind: Secret\nstringData:\n  kubeadmin: hash\n"),
+			},
+			Rendered: map[string][]byte{
+				"openshift/99_kubeadmin-password-secret.yaml":        []byte("kind: Secret\nstringData:\n  kubeadmin: hash\n"),
+				"manifests/openshift-config-secret-pull-secret.yaml": pullSecret,
+				"openshift/99-custom.yaml":                           []byte("kind: ConfigMap\ndata:\n  key: value\n"),
+			},
+		}
+		Expect(rendered.RedactSecrets()).To(Succeed())
+		Expect(string(rendered.Defaults["openshift/99_kubeadmin-password-secret.yaml"])).To(Equal("kind: Secret\nstringData:\n  kubeadmin: '*****'\n"))
+		Expect(string(rendered.Rendered["openshift/99_kubeadmin-password-secret.yaml"])).NotTo(ContainSubstring("hash"))
+		Expect(string(rendered.Rendered["manifests/openshift-config-secret-pull-secret.yaml"])).To(Equal("apiVersion: v1\n" +
+			"data:\n  .dockerconfigjson: '*****'\nkind: Secret\nmetadata:\n  name: pull-secret\n"))
+		Expect(string(rendered.Rendered["openshift/99-custom.yaml"])).To(Equal("kind: ConfigMap\ndata:\n  key: value\n"))
+	})
+})
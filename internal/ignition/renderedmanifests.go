@@ -0,0 +1,119 @@
+package ignition
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+const redactedSecretValue = "*****"
+
+// RenderedManifests holds manifest contents keyed by their path relative to the installer directory, for example
+// openshift/99-openshift-machineconfig-master-kargs.yaml
+type RenderedManifests struct {
+	// Defaults are the manifests created by the installer
+	Defaults map[string][]byte
+	// Rendered are the manifests after the cluster manifests and patches were applied
+	Rendered map[string][]byte
+}
+
+// List returns the rendered manifests sorted by folder and file name. When withDiff is set, only the manifests that
+// differ from the installer defaults are returned, with a unified diff against the default manifest instead of
+// their content.
+func (r *RenderedManifests) List(withDiff bool) (models.ListRenderedManifests, error) {
+	paths := make([]string, 0, len(r.Rendered))
+	for path := range r.Rendered {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	result := models.ListRenderedManifests{}
+	for _, path := range paths {
+		content := r.Rendered[path]
+		defaultContent, isDefault := r.Defaults[path]
+		manifest := &models.RenderedManifest{
+			Folder:   filepath.Dir(path),
+			FileName: filepath.Base(path),
+		}
+		switch {
+		case !isDefault:
+			manifest.Change = models.RenderedManifestChangeAdded
+		case string(defaultContent) != string(content):
+			manifest.Change = models.RenderedManifestChangeModified
+		default:
+			manifest.Change = models.RenderedManifestChangeUnchanged
+		}
+
+		if !withDiff {
+			manifest.Content = string(content)
+			result = append(result, manifest)
+			continue
+		}
+		if manifest.Change == models.RenderedManifestChangeUnchanged {
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(defaultContent),
+			B:        splitLines(content),
+			FromFile: "a/" + path,
+			ToFile:   "b/" + path,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to diff manifest %s", path)
+		}
+		manifest.Diff = diff
+		result = append(result, manifest)
+	}
+	return result, nil
+}
+
+// RedactSecrets replaces the values of the Secret manifests, among them the pull secret and the kubeadmin password
+// hash, with a placeholder. Both the default and the rendered manifests are redacted, so a Secret whose values were
+// changed is listed as unchanged.
+func (r *RenderedManifests) RedactSecrets() error {
+	for _, manifests := range []map[string][]byte{r.Defaults, r.Rendered} {
+		for path, content := range manifests {
+			redacted, err := redactSecretManifest(content)
+			if err != nil {
+				return errors.Wrapf(err, "failed to redact manifest %s", path)
+			}
+			manifests[path] = redacted
+		}
+	}
+	return nil
+}
+
+func redactSecretManifest(content []byte) ([]byte, error) {
+	manifest := map[string]interface{}{}
+	// Manifests that aren't Secrets are returned as they are, and so are manifests that can't be parsed
+	if yaml.Unmarshal(content, &manifest) != nil || manifest["kind"] != "Secret" {
+		return content, nil
+	}
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := manifest[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range values {
+			values[key] = redactedSecretValue
+		}
+	}
+	return yaml.Marshal(manifest)
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
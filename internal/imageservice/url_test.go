@@ -3,6 +3,7 @@ package imageservice
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -123,6 +124,25 @@ var _ = Describe("URL building", func() {
 		checkURL(bootArtifacts.KernelURL, scheme, host, "/v3/boot-artifacts/kernel", version, arch)
 		checkURL(bootArtifacts.RootFSURL, scheme, host, "/v3/boot-artifacts/rootfs", version, arch)
 		checkURL(bootArtifacts.InitrdURL, scheme, host, fmt.Sprintf("/v3/images/%s/pxe-initrd", id), version, arch)
+		Expect(bootArtifacts.SHA256Sums()).To(BeEmpty())
+	})
+
+	It("includes the boot artifact digests and signatures of the OS image", func() {
+		kernelDigest := strings.Repeat("a", 64)
+		rootfsDigest := strings.Repeat("b", 64)
+		osImage := models.OsImage{
+			CPUArchitecture:    &arch,
+			OpenshiftVersion:   &version,
+			KernelSha256:       kernelDigest,
+			RootfsSha256:       rootfsDigest,
+			KernelSignatureURL: "https://mirror.example.com/kernel.sig",
+		}
+		bootArtifacts, err := GetBootArtifactURLs(baseURL, id, &osImage, false)
+		Expect(err).To(BeNil())
+		Expect(bootArtifacts.KernelSHA256).To(Equal(kernelDigest))
+		Expect(bootArtifacts.RootFSSHA256).To(Equal(rootfsDigest))
+		Expect(bootArtifacts.KernelSignatureURL).To(Equal("https://mirror.example.com/kernel.sig"))
+		Expect(bootArtifacts.SHA256Sums()).To(Equal(kernelDigest + "  kernel\n" + rootfsDigest + "  rootfs\n"))
 	})
 })
 
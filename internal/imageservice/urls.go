@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"strings"
 
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
@@ -13,6 +14,25 @@ type BootArtifactURLs struct {
 	KernelURL string
 	RootFSURL string
 	InitrdURL string
+	// The expected digests of the artifacts that are identical for every infra-env, and the detached signature of
+	// the kernel, when the OS image provides them. The initrd embeds the discovery ignition of the infra-env, so it
+	// has none.
+	KernelSHA256       string
+	RootFSSHA256       string
+	KernelSignatureURL string
+}
+
+// SHA256Sums returns the expected digests of the boot artifacts in the sha256sum format, or an empty string when
+// the OS image doesn't provide any
+func (u *BootArtifactURLs) SHA256Sums() string {
+	var sums strings.Builder
+	if u.KernelSHA256 != "" {
+		fmt.Fprintf(&sums, "%s  kernel\n", u.KernelSHA256)
+	}
+	if u.RootFSSHA256 != "" {
+		fmt.Fprintf(&sums, "%s  rootfs\n", u.RootFSSHA256)
+	}
+	return sums.String()
 }
 
 const BootArtifactsPath = "/boot-artifacts"
@@ -92,9 +112,12 @@ func GetBootArtifactURLs(baseURL, imageID string, osImage *models.OsImage, insec
 		return nil, fmt.Errorf("failed generating initrd url: %w", err)
 	}
 	return &BootArtifactURLs{
-		KernelURL: kernelUrl,
-		RootFSURL: rootfsUrl,
-		InitrdURL: initrdUrl,
+		KernelURL:          kernelUrl,
+		RootFSURL:          rootfsUrl,
+		InitrdURL:          initrdUrl,
+		KernelSHA256:       osImage.KernelSha256,
+		RootFSSHA256:       osImage.RootfsSha256,
+		KernelSignatureURL: osImage.KernelSignatureURL,
 	}, nil
 }
 
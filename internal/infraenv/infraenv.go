@@ -92,5 +92,9 @@ func (m Manager) DeregisterInfraEnv(ctx context.Context, infraEnvId strfmt.UUID)
 		log.WithError(err).Errorf("failed to deregister infraEnv %s", infraEnvId)
 		return err
 	}
+	if err = m.db.Where("infra_env_id = ?", infraEnvId.String()).Delete(&models.DiscoveryCustomizationRevision{}).Error; err != nil {
+		log.WithError(err).Errorf("failed to delete discovery customization revisions of infraEnv %s", infraEnvId)
+		return err
+	}
 	return nil
 }
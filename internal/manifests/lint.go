@@ -0,0 +1,400 @@
+package manifests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	machineConfigGroup     = "machineconfiguration.openshift.io"
+	machineConfigRoleLabel = "machineconfiguration.openshift.io/role"
+)
+
+// apiVersionSupport describes the OpenShift releases that serve a version of an API group. An empty release
+// means that the version is served by every release that the service can install, or by no release yet.
+type apiVersionSupport struct {
+	introducedIn string
+	removedIn    string
+}
+
+// knownAPIGroups holds the versions of the API groups that are commonly used in cluster manifests, along with
+// the OpenShift releases that introduced and removed them
+var knownAPIGroups = map[string]map[string]apiVersionSupport{
+	"":                                    {"v1": {}},
+	"apps":                                {"v1": {}},
+	"batch":                               {"v1": {}, "v1beta1": {removedIn: "4.12"}},
+	"autoscaling":                         {"v1": {}, "v2": {introducedIn: "4.10"}, "v2beta1": {removedIn: "4.12"}, "v2beta2": {removedIn: "4.13"}},
+	"policy":                              {"v1": {introducedIn: "4.8"}, "v1beta1": {removedIn: "4.12"}},
+	"rbac.authorization.k8s.io":           {"v1": {}, "v1beta1": {removedIn: "4.9"}},
+	"networking.k8s.io":                   {"v1": {}, "v1beta1": {removedIn: "4.9"}},
+	"apiextensions.k8s.io":                {"v1": {}, "v1beta1": {removedIn: "4.9"}},
+	"admissionregistration.k8s.io":        {"v1": {}, "v1beta1": {removedIn: "4.9"}},
+	"storage.k8s.io":                      {"v1": {}, "v1beta1": {removedIn: "4.14"}},
+	"discovery.k8s.io":                    {"v1": {introducedIn: "4.8"}, "v1beta1": {removedIn: "4.12"}},
+	"events.k8s.io":                       {"v1": {}, "v1beta1": {removedIn: "4.12"}},
+	"flowcontrol.apiserver.k8s.io":        {"v1": {introducedIn: "4.16"}, "v1beta3": {introducedIn: "4.13", removedIn: "4.19"}, "v1beta2": {removedIn: "4.16"}, "v1beta1": {removedIn: "4.13"}},
+	"scheduling.k8s.io":                   {"v1": {}},
+	"coordination.k8s.io":                 {"v1": {}},
+	"certificates.k8s.io":                 {"v1": {}},
+	"node.k8s.io":                         {"v1": {}},
+	machineConfigGroup:                    {"v1": {}},
+	"config.openshift.io":                 {"v1": {}},
+	"operator.openshift.io":               {"v1": {}, "v1alpha1": {}},
+	"operators.coreos.com":                {"v1": {}, "v1alpha1": {}, "v2": {}},
+	"security.openshift.io":               {"v1": {}},
+	"route.openshift.io":                  {"v1": {}},
+	"image.openshift.io":                  {"v1": {}},
+	"monitoring.coreos.com":               {"v1": {}, "v1alpha1": {}, "v1beta1": {}},
+	"machine.openshift.io":                {"v1": {}, "v1beta1": {}},
+	"metal3.io":                           {"v1alpha1": {}},
+	"imageregistry.operator.openshift.io": {"v1": {}},
+}
+
+// ignitionVersions holds the Ignition spec versions that the Machine Config Operator accepts in MachineConfigs,
+// along with the OpenShift release that started accepting them
+var ignitionVersions = map[string]string{
+	"2.2.0": "",
+	"3.0.0": "",
+	"3.1.0": "",
+	"3.2.0": "",
+	"3.3.0": "4.14",
+	"3.4.0": "4.14",
+}
+
+// manifestObject is an object that a cluster manifest defines
+type manifestObject struct {
+	APIVersion string `json:"api_version"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Role is the role label of MachineConfigs
+	Role string `json:"role,omitempty"`
+}
+
+func (o *manifestObject) group() string {
+	if i := strings.Index(o.APIVersion, "/"); i >= 0 {
+		return o.APIVersion[:i]
+	}
+	return ""
+}
+
+func (o *manifestObject) key() string {
+	return strings.Join([]string{o.group(), o.Kind, o.Namespace, o.Name}, "/")
+}
+
+func (o *manifestObject) String() string {
+	if o.Namespace != "" {
+		return fmt.Sprintf("%s %s/%s", o.Kind, o.Namespace, o.Name)
+	}
+	return fmt.Sprintf("%s %s", o.Kind, o.Name)
+}
+
+// ManifestContent is the content of a cluster manifest, along with its path and source
+type ManifestContent struct {
+	// Path of the manifest, prefaced by the folder that contains it
+	Path    string
+	Source  string
+	Content []byte
+}
+
+// manifestLint holds the objects of a manifest, along with the issues that were found in its content alone
+type manifestLint struct {
+	path          string
+	source        string
+	objects       []manifestObject
+	contentIssues []*models.ManifestLintIssue
+}
+
+// LintClusterManifests lints the manifests of a cluster against its OpenShift version and against each other, and
+// returns the issues that were found in the user manifests by path. Manifests without issues are not returned.
+func LintClusterManifests(cluster *common.Cluster, manifestContents []ManifestContent) map[string][]*models.ManifestLintIssue {
+	lints := make([]*manifestLint, 0, len(manifestContents))
+	for _, manifest := range manifestContents {
+		objects, issues := lintManifestContent(cluster.OpenshiftVersion, manifest.Path, manifest.Content)
+		lints = append(lints, &manifestLint{
+			path:          manifest.Path,
+			source:        manifest.Source,
+			objects:       objects,
+			contentIssues: issues,
+		})
+	}
+	return lintManifests(cluster, lints)
+}
+
+// LintErrors returns a description of every error issue, prefaced by the path of the manifest it was found in
+func LintErrors(issuesByPath map[string][]*models.ManifestLintIssue) []string {
+	var result []string
+	for path, issues := range issuesByPath {
+		for _, issue := range issues {
+			if issue.Severity == models.ManifestLintIssueSeverityError {
+				result = append(result, fmt.Sprintf("%s: %s", path, issue.Message))
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// lintManifestContent returns the objects that a manifest defines, along with the issues that can be found by
+// looking at the content of the manifest alone. Manifest patches aren't linted.
+func lintManifestContent(openshiftVersion string, path string, content []byte) ([]manifestObject, []*models.ManifestLintIssue) {
+	objects := []manifestObject{}
+	issues := []*models.ManifestLintIssue{}
+	if strings.HasPrefix(filepath.Ext(path), ".patch") {
+		return objects, issues
+	}
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(content), 4096)
+	for {
+		var doc map[string]interface{}
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			issues = append(issues, lintError(models.ManifestLintIssueRuleInvalidObject, "failed to parse the manifest: %s", err))
+			break
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		u := unstructured.Unstructured{Object: doc}
+		object := manifestObject{
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Namespace:  u.GetNamespace(),
+			Name:       u.GetName(),
+		}
+		if object.APIVersion == "" || object.Kind == "" || object.Name == "" {
+			issues = append(issues, lintError(models.ManifestLintIssueRuleInvalidObject,
+				"every object must set apiVersion, kind and metadata.name, but found apiVersion %q, kind %q and name %q",
+				object.APIVersion, object.Kind, object.Name))
+			continue
+		}
+		if issue := lintAPIVersion(openshiftVersion, &object); issue != nil {
+			issues = append(issues, issue)
+		}
+		if object.group() == machineConfigGroup && object.Kind == "MachineConfig" {
+			object.Role = u.GetLabels()[machineConfigRoleLabel]
+			if issue := lintIgnitionVersion(openshiftVersion, &object, doc); issue != nil {
+				issues = append(issues, issue)
+			}
+		}
+		objects = append(objects, object)
+	}
+	return objects, issues
+}
+
+func lintAPIVersion(openshiftVersion string, object *manifestObject) *models.ManifestLintIssue {
+	group := object.group()
+	version := object.APIVersion[strings.LastIndex(object.APIVersion, "/")+1:]
+	versions, ok := knownAPIGroups[group]
+	if !ok {
+		return lintWarning(models.ManifestLintIssueRuleAPIVersion,
+			"%s uses API group %s, which isn't known to the service and can't be checked", object, group)
+	}
+	support, ok := versions[version]
+	if !ok {
+		return lintWarning(models.ManifestLintIssueRuleAPIVersion, "%s uses %s, which isn't a known version of API group %q and can't be checked",
+			object, object.APIVersion, group)
+	}
+	if openshiftVersion == "" {
+		return nil
+	}
+	if support.introducedIn != "" {
+		if introduced, err := common.BaseVersionGreaterOrEqual(support.introducedIn, openshiftVersion); err == nil && !introduced {
+			return lintError(models.ManifestLintIssueRuleAPIVersion, "%s uses %s, which is only served by OpenShift %s and later",
+				object, object.APIVersion, support.introducedIn)
+		}
+	}
+	if support.removedIn != "" {
+		if removed, err := common.BaseVersionGreaterOrEqual(support.removedIn, openshiftVersion); err == nil && removed {
+			return lintError(models.ManifestLintIssueRuleAPIVersion, "%s uses %s, which was removed in OpenShift %s",
+				object, object.APIVersion, support.removedIn)
+		}
+	}
+	return nil
+}
+
+func lintIgnitionVersion(openshiftVersion string, object *manifestObject, doc map[string]interface{}) *models.ManifestLintIssue {
+	config, found, err := unstructured.NestedMap(doc, "spec", "config")
+	if err != nil {
+		return lintError(models.ManifestLintIssueRuleIgnitionVersion, "%s has an invalid Ignition config: %s", object, err)
+	}
+	if !found || len(config) == 0 {
+		return nil
+	}
+	version, _, err := unstructured.NestedString(config, "ignition", "version")
+	if err != nil || version == "" {
+		return lintError(models.ManifestLintIssueRuleIgnitionVersion, "%s has an Ignition config without a spec version", object)
+	}
+	minimalOpenshiftVersion, ok := ignitionVersions[version]
+	if !ok {
+		return lintError(models.ManifestLintIssueRuleIgnitionVersion,
+			"%s uses Ignition spec version %s, which isn't supported by the Machine Config Operator", object, version)
+	}
+	if minimalOpenshiftVersion != "" && openshiftVersion != "" {
+		if supported, err := common.BaseVersionGreaterOrEqual(minimalOpenshiftVersion, openshiftVersion); err == nil && !supported {
+			return lintError(models.ManifestLintIssueRuleIgnitionVersion,
+				"%s uses Ignition spec version %s, which is only supported by OpenShift %s and later",
+				object, version, minimalOpenshiftVersion)
+		}
+	}
+	return nil
+}
+
+// lintManifests adds the issues that are found by comparing the manifests with each other to the issues of their
+// content, and returns the issues of the user manifests by path
+func lintManifests(cluster *common.Cluster, lints []*manifestLint) map[string][]*models.ManifestLintIssue {
+	pools := map[string]bool{
+		string(models.HostRoleMaster): true,
+		string(models.HostRoleWorker): true,
+	}
+	if common.IsClusterTopologyHighlyAvailableArbiter(cluster) {
+		pools[string(models.HostRoleArbiter)] = true
+	}
+	definedIn := map[string][]*manifestLint{}
+	for _, lint := range lints {
+		for i := range lint.objects {
+			object := &lint.objects[i]
+			if object.group() == machineConfigGroup && object.Kind == "MachineConfigPool" {
+				pools[object.Name] = true
+			}
+			definedIn[object.key()] = append(definedIn[object.key()], lint)
+		}
+	}
+
+	result := map[string][]*models.ManifestLintIssue{}
+	for _, lint := range lints {
+		if lint.source != constants.ManifestSourceUserSupplied {
+			continue
+		}
+		issues := append([]*models.ManifestLintIssue{}, lint.contentIssues...)
+		reported := map[string]bool{}
+		for i := range lint.objects {
+			object := &lint.objects[i]
+			if object.group() == machineConfigGroup && object.Kind == "MachineConfig" {
+				if object.Role == "" {
+					issues = append(issues, lintWarning(models.ManifestLintIssueRuleMachineConfigPool,
+						"%s doesn't set the %s label, so it won't be applied by any machine config pool", object, machineConfigRoleLabel))
+				} else if !pools[object.Role] {
+					issues = append(issues, lintWarning(models.ManifestLintIssueRuleMachineConfigPool,
+						"%s has role %s, which doesn't match any existing machine config pool or one that is defined in the cluster manifests",
+						object, object.Role))
+				}
+			}
+			if reported[object.key()] {
+				continue
+			}
+			reported[object.key()] = true
+			if issue := lintDuplicate(lint, object, definedIn[object.key()]); issue != nil {
+				issues = append(issues, issue)
+			}
+		}
+		if len(issues) > 0 {
+			result[lint.path] = issues
+		}
+	}
+	return result
+}
+
+func lintDuplicate(lint *manifestLint, object *manifestObject, definedIn []*manifestLint) *models.ManifestLintIssue {
+	var userPaths, generatedPaths []string
+	occurrences := 0
+	for _, other := range definedIn {
+		switch {
+		case other == lint:
+			occurrences++
+		case other.source == constants.ManifestSourceUserSupplied:
+			userPaths = append(userPaths, other.path)
+		default:
+			generatedPaths = append(generatedPaths, other.path)
+		}
+	}
+	switch {
+	case len(generatedPaths) > 0:
+		return lintWarning(models.ManifestLintIssueRuleDuplicateObject,
+			"%s is also defined in the generated manifest %s, and may override it", object,
+			strings.Join(funk.UniqString(generatedPaths), ", "))
+	case len(userPaths) > 0:
+		return lintError(models.ManifestLintIssueRuleDuplicateObject, "%s is also defined in the user manifest %s",
+			object, strings.Join(funk.UniqString(userPaths), ", "))
+	case occurrences > 1:
+		return lintError(models.ManifestLintIssueRuleDuplicateObject, "%s is defined more than once in the manifest", object)
+	}
+	return nil
+}
+
+func lintError(rule string, format string, args ...interface{}) *models.ManifestLintIssue {
+	return &models.ManifestLintIssue{Severity: models.ManifestLintIssueSeverityError, Rule: rule, Message: fmt.Sprintf(format, args...)}
+}
+
+func lintWarning(rule string, format string, args ...interface{}) *models.ManifestLintIssue {
+	return &models.ManifestLintIssue{Severity: models.ManifestLintIssueSeverityWarning, Rule: rule, Message: fmt.Sprintf(format, args...)}
+}
+
+// marshalLint stores the objects and content issues of a manifest in its lint result
+func marshalLint(lint *manifestLint, result *common.ManifestLintResult) error {
+	objects, err := json.Marshal(lint.objects)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the objects of manifest %s", lint.path)
+	}
+	issues, err := json.Marshal(lint.contentIssues)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the issues of manifest %s", lint.path)
+	}
+	result.Path = lint.path
+	result.ManifestSource = lint.source
+	result.Objects = string(objects)
+	result.ContentIssues = string(issues)
+	return nil
+}
+
+// unmarshalLint returns the objects and content issues of a manifest that are stored in its lint result
+func unmarshalLint(result *common.ManifestLintResult) (*manifestLint, error) {
+	lint := &manifestLint{path: result.Path, source: result.ManifestSource}
+	if result.Objects != "" {
+		if err := json.Unmarshal([]byte(result.Objects), &lint.objects); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the objects of manifest %s", result.Path)
+		}
+	}
+	if result.ContentIssues != "" {
+		if err := json.Unmarshal([]byte(result.ContentIssues), &lint.contentIssues); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the issues of manifest %s", result.Path)
+		}
+	}
+	return lint, nil
+}
+
+// LintStoredClusterManifests lints the manifests of a cluster against each other, using the objects and content
+// issues that were stored when the manifests were uploaded, and returns the issues of the user manifests by path
+func LintStoredClusterManifests(db *gorm.DB, cluster *common.Cluster) (map[string][]*models.ManifestLintIssue, error) {
+	var results []*common.ManifestLintResult
+	if err := db.Where("cluster_id = ?", cluster.ID.String()).Order("path").Find(&results).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get the manifest lint results of cluster %s", cluster.ID)
+	}
+	lints := make([]*manifestLint, 0, len(results))
+	for _, result := range results {
+		lint, err := unmarshalLint(result)
+		if err != nil {
+			return nil, err
+		}
+		lints = append(lints, lint)
+	}
+	return lintManifests(cluster, lints), nil
+}
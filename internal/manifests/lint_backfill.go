@@ -0,0 +1,36 @@
+package manifests
+
+import (
+	"context"
+
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+)
+
+// LintBackfill lints the manifests that were uploaded before manifests were linted. It runs periodically on the
+// leader, until all of them were linted once.
+type LintBackfill struct {
+	manifests     *Manifests
+	leaderElector leader.Leader
+	done          bool
+}
+
+// NewLintBackfill creates a new backfill of the manifest lint results
+func NewLintBackfill(manifests *Manifests, leaderElector leader.Leader) *LintBackfill {
+	return &LintBackfill{manifests: manifests, leaderElector: leaderElector}
+}
+
+func (b *LintBackfill) Run() {
+	if b.done || !b.leaderElector.IsLeader() {
+		return
+	}
+	requestID := requestid.NewID()
+	ctx := requestid.ToContext(context.Background(), requestID)
+	log := requestid.RequestIDLogger(b.manifests.log, requestID)
+	if err := b.manifests.lintUnlintedManifests(ctx); err != nil {
+		log.WithError(err).Warn("Failed to lint the manifests that were uploaded before manifests were linted")
+		return
+	}
+	log.Info("Linted the manifests that were uploaded before manifests were linted")
+	b.done = true
+}
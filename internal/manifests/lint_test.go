@@ -0,0 +1,177 @@
+package manifests_test
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("LintClusterManifests", func() {
+	var cluster *common.Cluster
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, OpenshiftVersion: "4.14.0"}}
+	})
+
+	userManifest := func(path, content string) manifests.ManifestContent {
+		return manifests.ManifestContent{Path: path, Source: constants.ManifestSourceUserSupplied, Content: []byte(content)}
+	}
+
+	machineConfig := func(name, role, ignitionVersion string) string {
+		content := "apiVersion: machineconfiguration.openshift.io/v1\nkind: MachineConfig\nmetadata:\n  name: " + name + "\n"
+		if role != "" {
+			content += "  labels:\n    machineconfiguration.openshift.io/role: " + role + "\n"
+		}
+		if ignitionVersion != "" {
+			content += "spec:\n  config:\n    ignition:\n      version: " + ignitionVersion + "\n"
+		}
+		return content
+	}
+
+	lintOne := func(content string) []*models.ManifestLintIssue {
+		return manifests.LintClusterManifests(cluster, []manifests.ManifestContent{userManifest("openshift/manifest.yaml", content)})["openshift/manifest.yaml"]
+	}
+
+	It("reports no issues for valid manifests", func() {
+		issues := manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("openshift/mc.yaml", machineConfig("99-worker-kargs", "worker", "3.2.0")),
+			userManifest("manifests/cm.json", `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "namespace": "default"}}`),
+			userManifest("openshift/cluster-scheduler-02-config.yml.patch_masters", "- op: replace\n  path: /spec/mastersSchedulable\n  value: true\n"),
+		})
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("reports objects without a kind or a name", func() {
+		issues := lintOne("apiVersion: v1\nkind: ConfigMap\nmetadata: {}\n")
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Rule).To(Equal(models.ManifestLintIssueRuleInvalidObject))
+		Expect(issues[0].Severity).To(Equal(models.ManifestLintIssueSeverityError))
+	})
+
+	It("lints every document of multi-document manifests", func() {
+		issues := lintOne("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: first\n---\napiVersion: batch/v1beta1\nkind: CronJob\nmetadata:\n  name: second\n")
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Message).To(ContainSubstring("CronJob second"))
+	})
+
+	DescribeTable("API versions",
+		func(openshiftVersion, apiVersion string, expected *models.ManifestLintIssue) {
+			cluster.OpenshiftVersion = openshiftVersion
+			issues := lintOne("apiVersion: " + apiVersion + "\nkind: Example\nmetadata:\n  name: example\n")
+			if expected == nil {
+				Expect(issues).To(BeEmpty())
+				return
+			}
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Rule).To(Equal(expected.Rule))
+			Expect(issues[0].Severity).To(Equal(expected.Severity))
+		},
+		Entry("core group", "4.14.0", "v1", nil),
+		Entry("served version", "4.11.0", "batch/v1beta1", nil),
+		Entry("removed version", "4.12.0", "batch/v1beta1",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityError}),
+		Entry("removed version in a release candidate", "4.12.0-rc.1", "batch/v1beta1",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityError}),
+		Entry("version that isn't introduced yet", "4.15.0", "flowcontrol.apiserver.k8s.io/v1",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityError}),
+		Entry("unknown version of a known group", "4.14.0", "apps/v2",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityWarning}),
+		Entry("unknown alpha version of a known group", "4.14.0", "machineconfiguration.openshift.io/v1alpha1",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityWarning}),
+		Entry("unknown group", "4.14.0", "example.com/v1",
+			&models.ManifestLintIssue{Rule: models.ManifestLintIssueRuleAPIVersion, Severity: models.ManifestLintIssueSeverityWarning}),
+	)
+
+	DescribeTable("Ignition spec versions of MachineConfigs",
+		func(openshiftVersion, ignitionVersion string, valid bool) {
+			cluster.OpenshiftVersion = openshiftVersion
+			issues := lintOne(machineConfig("99-worker-custom", "worker", ignitionVersion))
+			if valid {
+				Expect(issues).To(BeEmpty())
+				return
+			}
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Rule).To(Equal(models.ManifestLintIssueRuleIgnitionVersion))
+		},
+		Entry("spec 2", "4.12.0", "2.2.0", true),
+		Entry("spec 3.2", "4.12.0", "3.2.0", true),
+		Entry("spec 3.4 on a release that supports it", "4.14.0", "3.4.0", true),
+		Entry("spec 3.4 on a release that doesn't support it", "4.13.0", "3.4.0", false),
+		Entry("unknown spec", "4.14.0", "3.9.0", false),
+	)
+
+	It("warns about MachineConfigs without a role", func() {
+		issues := lintOne(machineConfig("99-custom", "", ""))
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Rule).To(Equal(models.ManifestLintIssueRuleMachineConfigPool))
+		Expect(issues[0].Severity).To(Equal(models.ManifestLintIssueSeverityWarning))
+	})
+
+	It("accepts the roles of machine config pools that are defined in the manifests", func() {
+		pool := "apiVersion: machineconfiguration.openshift.io/v1\nkind: MachineConfigPool\nmetadata:\n  name: infra\n"
+		issues := manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("openshift/mc.yaml", machineConfig("99-infra-custom", "infra", "")),
+		})
+		Expect(issues["openshift/mc.yaml"]).To(HaveLen(1))
+		Expect(issues["openshift/mc.yaml"][0].Rule).To(Equal(models.ManifestLintIssueRuleMachineConfigPool))
+		Expect(issues["openshift/mc.yaml"][0].Severity).To(Equal(models.ManifestLintIssueSeverityWarning))
+
+		issues = manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("openshift/mc.yaml", machineConfig("99-infra-custom", "infra", "")),
+			userManifest("openshift/pool.yaml", pool),
+		})
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("accepts the arbiter role only for clusters with arbiter hosts", func() {
+		content := machineConfig("99-arbiter-custom", "arbiter", "")
+		Expect(lintOne(content)).To(HaveLen(1))
+
+		hostID := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = []*models.Host{{ID: &hostID, Role: models.HostRoleArbiter}}
+		Expect(lintOne(content)).To(BeEmpty())
+	})
+
+	It("reports objects that are defined by more than one manifest", func() {
+		configMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: default\n"
+		issues := manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("manifests/first.yaml", configMap),
+			userManifest("manifests/second.yaml", configMap),
+			{Path: "openshift/operator.yaml", Source: constants.ManifestSourceSystemGenerated, Content: []byte(machineConfig("99-worker-operator", "worker", ""))},
+			userManifest("openshift/third.yaml", machineConfig("99-worker-operator", "worker", "")),
+		})
+		Expect(issues).To(HaveLen(3))
+		Expect(issues["manifests/first.yaml"][0].Rule).To(Equal(models.ManifestLintIssueRuleDuplicateObject))
+		Expect(issues["manifests/first.yaml"][0].Message).To(ContainSubstring("user manifest manifests/second.yaml"))
+		Expect(issues["manifests/second.yaml"][0].Message).To(ContainSubstring("user manifest manifests/first.yaml"))
+		Expect(issues["manifests/first.yaml"][0].Severity).To(Equal(models.ManifestLintIssueSeverityError))
+		Expect(issues["openshift/third.yaml"][0].Message).To(ContainSubstring("generated manifest openshift/operator.yaml"))
+		Expect(issues["openshift/third.yaml"][0].Severity).To(Equal(models.ManifestLintIssueSeverityWarning))
+		Expect(issues).NotTo(HaveKey("openshift/operator.yaml"))
+	})
+
+	It("doesn't report objects with the same name in different namespaces", func() {
+		issues := manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("manifests/first.yaml", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: first\n"),
+			userManifest("manifests/second.yaml", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: second\n"),
+		})
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("lists the errors by manifest", func() {
+		issues := manifests.LintClusterManifests(cluster, []manifests.ManifestContent{
+			userManifest("manifests/unknown.yaml", "apiVersion: example.com/v1\nkind: Example\nmetadata:\n  name: example\n"),
+			userManifest("manifests/invalid.yaml", "apiVersion: v1\nkind: ConfigMap\n"),
+		})
+		lintErrors := manifests.LintErrors(issues)
+		Expect(lintErrors).To(HaveLen(1))
+		Expect(lintErrors[0]).To(HavePrefix("manifests/invalid.yaml: "))
+	})
+})
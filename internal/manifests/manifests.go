@@ -3,6 +3,7 @@ package manifests
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/go-openapi/swag"
 	yamlpatch "github.com/krishicks/yaml-patch"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/common/ignition"
 	"github.com/openshift/assisted-service/internal/constants"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -64,11 +66,12 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 	// In OCM, this is validated at the authorization layer. In other
 	// authorization scheme, it does not and therefore should be checked
 	// at the application level.
-	if _, err := common.GetClusterFromDB(m.db, params.ClusterID, false); err != nil {
+	cluster, err := common.GetClusterFromDB(m.db, params.ClusterID, false)
+	if err != nil {
 		return nil, common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
 	}
 
-	err := m.validateManifestFileNames(ctx, params.ClusterID, []string{fileName})
+	err = m.validateManifestFileNames(ctx, params.ClusterID, []string{fileName})
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +82,12 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 		return nil, err
 	}
 
+	var butaneSource []byte
+	manifestContent, butaneSource, err = m.translateButaneManifest(ctx, cluster, manifestContent, path)
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.validateUserSuppliedManifest(ctx, params.ClusterID, manifestContent, path)
 	if err != nil {
 		return nil, err
@@ -99,6 +108,16 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 		return nil, err
 	}
 
+	if butaneSource != nil {
+		if err = m.uploadButaneSource(ctx, butaneSource, manifestContent, params.ClusterID, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = m.storeManifestLint(ctx, cluster, path, manifestSource, manifestContent); err != nil {
+		return nil, err
+	}
+
 	if isCustomManifest {
 		if usageErr := m.setUsage(true, params.ClusterID); usageErr != nil {
 			log.Errorf("Failed to set feature usage '%s': %v", usage.CustomManifest, usageErr)
@@ -107,6 +126,13 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 
 	log.Infof("Done creating manifest %s for cluster %s", path, params.ClusterID.String())
 	manifest := models.Manifest{FileName: fileName, Folder: folder, ManifestSource: manifestSource}
+	if isCustomManifest {
+		issues, lintErr := m.getManifestLintIssues(ctx, cluster)
+		if lintErr != nil {
+			return nil, lintErr
+		}
+		manifest.LintIssues = issues[path]
+	}
 	return &manifest, nil
 }
 
@@ -159,18 +185,42 @@ func (m *Manifests) ListClusterManifestsInternal(ctx context.Context, params ope
 	// In OCM, this is validated at the authorization layer. In other
 	// authorization scheme, it does not and therefore should be checked
 	// at the application level.
-	if _, err := common.GetClusterFromDB(m.db, params.ClusterID, false); err != nil {
+	cluster, err := common.GetClusterFromDB(m.db, params.ClusterID, false)
+	if err != nil {
 		return nil, common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
 	}
 
-	objectName := filepath.Join(params.ClusterID.String(), constants.ManifestFolder)
+	allManifests, err := m.listManifests(ctx, params.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	manifests := models.ListManifests{}
+	for _, manifest := range allManifests {
+		if manifest.ManifestSource == constants.ManifestSourceUserSupplied || swag.BoolValue(params.IncludeSystemGenerated) {
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	issues, err := m.getManifestLintIssues(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range manifests {
+		manifest.LintIssues = issues[filepath.Join(manifest.Folder, manifest.FileName)]
+	}
+	return manifests, nil
+}
+
+// listManifests returns all the manifests of a cluster, along with their source
+func (m *Manifests) listManifests(ctx context.Context, clusterID strfmt.UUID) (models.ListManifests, error) {
+	objectName := filepath.Join(clusterID.String(), constants.ManifestFolder)
 	files, err := m.objectHandler.ListObjectsByPrefixWithMetadata(ctx, objectName)
 	if err != nil {
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
 	// legacyUserManifestPaths is temporarily included until no more legacy manifest paths are supported
-	legacyUserManifestPaths, err := m.FindUserManifestPathsByLegacyMetadata(ctx, strfmt.UUID(params.ClusterID.String()))
+	legacyUserManifestPaths, err := m.FindUserManifestPathsByLegacyMetadata(ctx, clusterID)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to identify user manifest paths based on filesystem")
 	}
@@ -181,7 +231,6 @@ func (m *Manifests) ListClusterManifestsInternal(ctx context.Context, params ope
 		if err != nil {
 			return nil, err
 		}
-
 		manifestSource := constants.ManifestSourceSystemGenerated
 		manifestSourceAttributeValue, ok := file.Metadata[constants.ManifestSourceAttribute]
 		if ok {
@@ -189,9 +238,11 @@ func (m *Manifests) ListClusterManifestsInternal(ctx context.Context, params ope
 		} else if swag.ContainsStrings(legacyUserManifestPaths, file.Path) {
 			manifestSource = constants.ManifestSourceUserSupplied
 		}
-		if manifestSource == constants.ManifestSourceUserSupplied || swag.BoolValue(params.IncludeSystemGenerated) {
-			manifests = append(manifests, &models.Manifest{FileName: filename, Folder: folder, ManifestSource: manifestSource})
-		}
+		manifests = append(manifests, &models.Manifest{
+			FileName:       filename,
+			Folder:         folder,
+			ManifestSource: manifestSource,
+		})
 	}
 	return manifests, nil
 }
@@ -222,6 +273,10 @@ func (m *Manifests) DeleteClusterManifestInternal(ctx context.Context, params op
 		return err
 	}
 
+	if err = m.deleteManifestLint(ctx, params.ClusterID, path); err != nil {
+		return err
+	}
+
 	// Unset feature usage if this was the last manifest. Don't fail on error
 	// because we successfully deleted the manifest as requested.
 	remaining, listErr := m.ListClusterManifestsInternal(ctx, operations.V2ListClusterManifestsParams{ClusterID: params.ClusterID})
@@ -272,11 +327,16 @@ func (m *Manifests) UpdateClusterManifestInternal(ctx context.Context, params op
 	}
 
 	var content []byte
+	var butaneSource []byte
 	if params.UpdateManifestParams.UpdatedContent != nil {
 		content, err = m.decodeUserSuppliedManifest(ctx, params.ClusterID, params.UpdateManifestParams.UpdatedContent, srcPath)
 		if err != nil {
 			return nil, err
 		}
+		content, butaneSource, err = m.translateButaneManifest(ctx, cluster, content, srcPath)
+		if err != nil {
+			return nil, err
+		}
 		err = m.validateUserSuppliedManifest(ctx, params.ClusterID, content, srcFileName)
 		if err != nil {
 			return nil, err
@@ -293,13 +353,30 @@ func (m *Manifests) UpdateClusterManifestInternal(ctx context.Context, params op
 		return nil, err
 	}
 
+	if butaneSource != nil {
+		if err = m.uploadButaneSource(ctx, butaneSource, content, params.ClusterID, destPath); err != nil {
+			return nil, err
+		}
+	}
+
 	if srcPath != destPath {
 		err = m.deleteManifest(ctx, params.ClusterID, srcPath)
 		if err != nil {
 			return nil, err
 		}
+		if err = m.deleteManifestLint(ctx, params.ClusterID, srcPath); err != nil {
+			return nil, err
+		}
 	}
-	manifest := models.Manifest{FileName: destFileName, Folder: destFolder, ManifestSource: constants.ManifestSourceUserSupplied}
+
+	if err = m.storeManifestLint(ctx, cluster, destPath, constants.ManifestSourceUserSupplied, content); err != nil {
+		return nil, err
+	}
+	issues, err := m.getManifestLintIssues(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	manifest := models.Manifest{FileName: destFileName, Folder: destFolder, ManifestSource: constants.ManifestSourceUserSupplied, LintIssues: issues[destPath]}
 	return &manifest, nil
 }
 
@@ -309,7 +386,7 @@ func (m *Manifests) V2DownloadClusterManifest(ctx context.Context, params operat
 		defaultFolder := models.CreateManifestParamsFolderManifests
 		params.Folder = &defaultFolder
 	}
-	_, fileName, path := m.getManifestPathsFromParameters(ctx, params.Folder, &params.FileName)
+	folder, fileName, path := m.getManifestPathsFromParameters(ctx, params.Folder, &params.FileName)
 
 	// Verify that the manifests are created for a valid cluster
 	// to align kube-api and ocm behavior.
@@ -337,6 +414,13 @@ func (m *Manifests) V2DownloadClusterManifest(ctx context.Context, params operat
 		return common.GenerateErrorResponderWithDefault(errors.New(msg), http.StatusNotFound)
 	}
 
+	if swag.BoolValue(params.ButaneSource) {
+		objectName, err = m.getButaneSourceObjectName(ctx, params.ClusterID, folder, fileName)
+		if err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
 	respBody, contentLength, err := m.objectHandler.Download(ctx, objectName)
 	if err != nil {
 		log.WithError(err).Errorf("failed to download file %s from cluster: %s", fileName, params.ClusterID.String())
@@ -370,6 +454,11 @@ func GetManifestObjectName(clusterID strfmt.UUID, fileName string) string {
 	return filepath.Join(string(clusterID), constants.ManifestFolder, fileName)
 }
 
+// GetManifestButaneSourceObjectName returns the object name of the Butane config that a manifest was translated from
+func GetManifestButaneSourceObjectName(clusterID strfmt.UUID, fileName string) string {
+	return filepath.Join(string(clusterID), constants.ManifestButaneSourceFolder, fileName)
+}
+
 // GetClusterManifests returns a list of cluster manifests
 func GetClusterManifests(ctx context.Context, clusterID *strfmt.UUID, s3Client s3wrapper.API) ([]s3wrapper.ObjectInfo, error) {
 	manifestFiles := []s3wrapper.ObjectInfo{}
@@ -561,6 +650,63 @@ func (m *Manifests) decodeUserSuppliedManifest(ctx context.Context, clusterID st
 	return manifestContent, nil
 }
 
+// translateButaneManifest translates YAML manifests that are Butane configs into MachineConfigs, and returns the
+// MachineConfig along with the Butane source. Other manifests are returned as they are, without a source.
+func (m *Manifests) translateButaneManifest(ctx context.Context, cluster *common.Cluster, manifestContent []byte, fileName string) ([]byte, []byte, error) {
+	extension := filepath.Ext(fileName)
+	if (extension != ".yaml" && extension != ".yml") || !ignition.IsButaneConfig(manifestContent) {
+		return manifestContent, nil, nil
+	}
+	machineConfig, err := ignition.TranslateButaneToMachineConfig(manifestContent, cluster.OpenshiftVersion)
+	if err != nil {
+		return nil, nil, m.prepareAndLogError(ctx, http.StatusBadRequest, errors.Wrapf(err, "Manifest content of file %s for cluster ID %s is an invalid Butane config", fileName, cluster.ID))
+	}
+	return machineConfig, manifestContent, nil
+}
+
+// uploadButaneSource stores the Butane config that a manifest was translated from, along with the checksum of the
+// manifest so that the source is only served for as long as the manifest is not replaced.
+func (m *Manifests) uploadButaneSource(ctx context.Context, butaneSource []byte, manifestContent []byte, clusterID strfmt.UUID, path string) error {
+	objectName := GetManifestButaneSourceObjectName(clusterID, path)
+	metadata := map[string]string{constants.ManifestChecksumAttribute: manifestChecksum(manifestContent)}
+	if err := m.objectHandler.UploadWithMetadata(ctx, butaneSource, objectName, metadata); err != nil {
+		return m.prepareAndLogError(ctx, http.StatusInternalServerError, errors.Wrapf(err, "Failed to upload Butane source object %s for cluster %s", objectName, clusterID))
+	}
+	return nil
+}
+
+// getButaneSourceObjectName returns the object name of the Butane config that a manifest was translated from. Sources
+// of manifests that were replaced, or that were renamed since, are not found.
+func (m *Manifests) getButaneSourceObjectName(ctx context.Context, clusterID strfmt.UUID, folder string, fileName string) (string, error) {
+	path := filepath.Join(folder, fileName)
+	objectName := GetManifestButaneSourceObjectName(clusterID, path)
+	sources, err := m.objectHandler.ListObjectsByPrefixWithMetadata(ctx, objectName)
+	if err != nil {
+		return "", m.prepareAndLogError(ctx, http.StatusInternalServerError, errors.Wrapf(err, "Failed to find Butane source of manifest %s for cluster %s", path, clusterID))
+	}
+	var checksum string
+	for _, source := range sources {
+		if source.Path == objectName {
+			checksum = source.Metadata[constants.ManifestChecksumAttribute]
+		}
+	}
+	if checksum != "" {
+		var content []byte
+		content, err = m.fetchManifestContent(ctx, clusterID, folder, fileName)
+		if err != nil {
+			return "", err
+		}
+		if checksum == manifestChecksum(content) {
+			return objectName, nil
+		}
+	}
+	return "", m.prepareAndLogError(ctx, http.StatusNotFound, errors.Errorf("Cluster manifest %s of cluster %s was not created from a Butane config", path, clusterID))
+}
+
+func manifestChecksum(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
 func (m *Manifests) getManifestPathsFromParameters(ctx context.Context, folder *string, fileName *string) (string, string, string) {
 	if folder == nil {
 		defaultFolder := models.CreateManifestParamsFolderManifests
@@ -598,5 +744,101 @@ func (m *Manifests) deleteManifest(ctx context.Context, clusterID strfmt.UUID, p
 			http.StatusInternalServerError,
 			errors.Wrapf(err, "Failed to delete object %s from storage for cluster %s", objectName, clusterID))
 	}
+	// The Butane source of the manifest, if it was translated from one, goes along with it
+	sourceObjectName := GetManifestButaneSourceObjectName(clusterID, path)
+	if _, err = m.objectHandler.DeleteObject(ctx, sourceObjectName); err != nil {
+		return m.prepareAndLogError(
+			ctx,
+			http.StatusInternalServerError,
+			errors.Wrapf(err, "Failed to delete Butane source object %s from storage for cluster %s", sourceObjectName, clusterID))
+	}
 	return nil
 }
+
+// storeManifestLint stores the objects that a manifest defines, along with the issues that were found in its content,
+// so that the manifest can be linted against the other manifests of the cluster without downloading them
+func (m *Manifests) storeManifestLint(ctx context.Context, cluster *common.Cluster, path string, manifestSource string, content []byte) error {
+	objects, issues := lintManifestContent(cluster.OpenshiftVersion, path, content)
+	result := &common.ManifestLintResult{ClusterID: *cluster.ID}
+	if err := marshalLint(&manifestLint{path: path, source: manifestSource, objects: objects, contentIssues: issues}, result); err != nil {
+		return m.prepareAndLogError(ctx, http.StatusInternalServerError, err)
+	}
+	if err := m.db.Save(result).Error; err != nil {
+		return m.prepareAndLogError(ctx, http.StatusInternalServerError, errors.Wrapf(err, "Failed to store lint result of manifest %s for cluster %s", path, cluster.ID))
+	}
+	return nil
+}
+
+func (m *Manifests) deleteManifestLint(ctx context.Context, clusterID strfmt.UUID, path string) error {
+	if err := m.db.Where("cluster_id = ? and path = ?", clusterID.String(), path).Delete(&common.ManifestLintResult{}).Error; err != nil {
+		return m.prepareAndLogError(ctx, http.StatusInternalServerError, errors.Wrapf(err, "Failed to delete lint result of manifest %s for cluster %s", path, clusterID))
+	}
+	return nil
+}
+
+// lintUnlintedManifests stores the lint results of the manifests of the clusters that aren't installed yet, which
+// were uploaded before manifests were linted, so that listing the manifests doesn't have to. Clusters whose manifests
+// fail to be listed or downloaded are skipped, and the error is returned so that they are linted again on the next
+// attempt.
+func (m *Manifests) lintUnlintedManifests(ctx context.Context) error {
+	log := logutil.FromContext(ctx, m.log)
+	var clusterIDs []strfmt.UUID
+	if err := m.db.Model(&common.Cluster{}).
+		Where("status IN (?)", []string{models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput}).
+		Pluck("id", &clusterIDs).Error; err != nil {
+		return errors.Wrap(err, "failed to list the clusters that aren't installed")
+	}
+	var result error
+	for _, clusterID := range clusterIDs {
+		if err := m.lintUnlintedClusterManifests(ctx, clusterID); err != nil {
+			log.WithError(err).Warnf("Failed to lint the manifests of cluster %s", clusterID)
+			result = err
+		}
+	}
+	return result
+}
+
+func (m *Manifests) lintUnlintedClusterManifests(ctx context.Context, clusterID strfmt.UUID) error {
+	cluster, err := common.GetClusterFromDB(m.db, clusterID, common.SkipEagerLoading)
+	if err != nil {
+		return err
+	}
+	var linted []string
+	if err = m.db.Model(&common.ManifestLintResult{}).Where("cluster_id = ?", clusterID.String()).Pluck("path", &linted).Error; err != nil {
+		return errors.Wrapf(err, "failed to get the manifest lint results of cluster %s", clusterID)
+	}
+	manifests, err := m.listManifests(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	for _, manifest := range manifests {
+		path := filepath.Join(manifest.Folder, manifest.FileName)
+		if funk.ContainsString(linted, path) {
+			continue
+		}
+		content, err := m.fetchManifestContent(ctx, clusterID, manifest.Folder, manifest.FileName)
+		if err != nil {
+			return err
+		}
+		if err = m.storeManifestLint(ctx, cluster, path, manifest.ManifestSource, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getManifestLintIssues returns the issues of the user manifests of a cluster by path
+func (m *Manifests) getManifestLintIssues(ctx context.Context, cluster *common.Cluster) (map[string][]*models.ManifestLintIssue, error) {
+	// The machine config pools of the cluster depend on the roles of its hosts, so only these are loaded
+	var hosts []*models.Host
+	if err := m.db.Select("id", "infra_env_id", "role", "suggested_role").Where("cluster_id = ?", cluster.ID.String()).Find(&hosts).Error; err != nil {
+		return nil, m.prepareAndLogError(ctx, http.StatusInternalServerError, errors.Wrapf(err, "Failed to get the hosts of cluster %s", cluster.ID))
+	}
+	clusterWithHosts := *cluster
+	clusterWithHosts.Hosts = hosts
+	issues, err := LintStoredClusterManifests(m.db, &clusterWithHosts)
+	if err != nil {
+		return nil, m.prepareAndLogError(ctx, http.StatusInternalServerError, err)
+	}
+	return issues, nil
+}
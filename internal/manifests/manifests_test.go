@@ -2,6 +2,7 @@ package manifests_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
@@ -23,6 +25,7 @@ import (
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"go.uber.org/mock/gomock"
@@ -57,6 +60,19 @@ spec:
   kernelArguments:
   - 'loglevel=7'`
 
+const contentAsButane = `variant: openshift
+version: 4.12.0
+metadata:
+  name: 99-worker-custom
+  labels:
+    machineconfiguration.openshift.io/role: worker
+storage:
+  files:
+  - path: /etc/example
+    contents:
+      inline: hello
+`
+
 const contentAsJSON = `{
   "apiVersion": "machineconfiguration.openshift.io/v1",
   "kind": "MachineConfig",
@@ -136,6 +152,10 @@ var _ = Describe("ClusterManifestTests", func() {
 		return fmt.Sprintf("%s/manifests/%s/%s", *clusterID, folderName, fileName)
 	}
 
+	getButaneSourceObjectName := func(clusterID *strfmt.UUID, folderName, fileName string) string {
+		return manifests.GetManifestButaneSourceObjectName(*clusterID, filepath.Join(folderName, fileName))
+	}
+
 	mockObjectExists := func(exists bool) {
 		mockS3Client.EXPECT().DoesObjectExist(ctx, gomock.Any()).Return(exists, nil).AnyTimes()
 	}
@@ -842,6 +862,8 @@ invalid YAML content: {
 			Expect(len(responsePayload.Payload)).To(Equal(len(manifests)))
 
 			for i := range manifests {
+				// The manifests define the same object, the lint issues that are found are verified separately
+				responsePayload.Payload[i].LintIssues = nil
 				Expect(manifests).To(ContainElement(*responsePayload.Payload[i]))
 			}
 		})
@@ -886,6 +908,8 @@ invalid YAML content: {
 			Expect(len(responsePayload.Payload)).To(Equal(len(manifests)))
 
 			for i := range manifests {
+				// The manifests define the same object, the lint issues that are found are verified separately
+				responsePayload.Payload[i].LintIssues = nil
 				Expect(manifests).To(ContainElement(*responsePayload.Payload[i]))
 			}
 		})
@@ -913,12 +937,132 @@ invalid YAML content: {
 		})
 	})
 
+	Context("Manifest linting", func() {
+		const lintedMachineConfig = `apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: worker
+  name: 99-worker-kargs
+spec:
+  kernelArguments:
+  - 'loglevel=7'`
+
+		createManifest := func(clusterID *strfmt.UUID, content, fileName string) *models.Manifest {
+			expectUsageCalls()
+			encoded := encodeToBase64(content)
+			response := manifestsAPI.V2CreateClusterManifest(ctx, operations.V2CreateClusterManifestParams{
+				ClusterID: *clusterID,
+				CreateManifestParams: &models.CreateManifestParams{
+					Content:  &encoded,
+					FileName: &fileName,
+					Folder:   &validFolder,
+				},
+			})
+			Expect(response).Should(BeAssignableToTypeOf(operations.NewV2CreateClusterManifestCreated()))
+			return response.(*operations.V2CreateClusterManifestCreated).Payload
+		}
+
+		BeforeEach(func() {
+			mockUpload(2)
+		})
+
+		It("reports no issues for a valid manifest", func() {
+			clusterID := registerCluster().ID
+			mockObjectExists(false)
+			manifest := createManifest(clusterID, lintedMachineConfig, "first.yaml")
+			Expect(manifest.LintIssues).To(BeEmpty())
+		})
+
+		It("reports objects that are defined by more than one manifest", func() {
+			clusterID := registerCluster().ID
+			mockObjectExists(false)
+			Expect(createManifest(clusterID, lintedMachineConfig, "first.yaml").LintIssues).To(BeEmpty())
+			manifest := createManifest(clusterID, lintedMachineConfig, "second.yaml")
+			Expect(manifest.LintIssues).To(HaveLen(1))
+			Expect(manifest.LintIssues[0].Rule).To(Equal(models.ManifestLintIssueRuleDuplicateObject))
+			Expect(manifest.LintIssues[0].Severity).To(Equal(models.ManifestLintIssueSeverityError))
+			Expect(manifest.LintIssues[0].Message).To(ContainSubstring("openshift/first.yaml"))
+
+			cluster, err := common.GetClusterFromDB(db, *clusterID, common.UseEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, err := manifests.LintStoredClusterManifests(db, cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(issues).To(HaveKey("openshift/first.yaml"))
+			Expect(issues).To(HaveKey("openshift/second.yaml"))
+		})
+
+		It("stops reporting issues of deleted manifests", func() {
+			clusterID := registerCluster().ID
+			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, validFolder, "second.yaml")).Return(true, nil).Times(1)
+			mockObjectExists(false)
+			createManifest(clusterID, lintedMachineConfig, "first.yaml")
+			createManifest(clusterID, lintedMachineConfig, "second.yaml")
+
+			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, validFolder, "second.yaml")).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, validFolder, "second.yaml")).Return(false, nil).Times(1)
+			mockListByPrefix(clusterID, []s3wrapper.ObjectInfo{{
+				Path:     getObjectName(clusterID, validFolder, "first.yaml"),
+				Metadata: map[string]string{constants.ManifestSourceAttribute: constants.ManifestSourceUserSupplied},
+			}})
+			response := manifestsAPI.V2DeleteClusterManifest(ctx, operations.V2DeleteClusterManifestParams{
+				ClusterID: *clusterID,
+				FileName:  "second.yaml",
+				Folder:    &validFolder,
+			})
+			Expect(response).Should(BeAssignableToTypeOf(operations.NewV2DeleteClusterManifestOK()))
+
+			cluster, err := common.GetClusterFromDB(db, *clusterID, common.UseEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, err := manifests.LintStoredClusterManifests(db, cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(issues).To(BeEmpty())
+		})
+
+		It("lints manifests that were uploaded before linting in the background", func() {
+			clusterID := registerCluster().ID
+			files := []s3wrapper.ObjectInfo{{
+				Path:     getObjectName(clusterID, validFolder, "legacy.yaml"),
+				Metadata: map[string]string{constants.ManifestSourceAttribute: constants.ManifestSourceUserSupplied},
+			}}
+			list := func() *models.Manifest {
+				mockListByPrefix(clusterID, files)
+				response := manifestsAPI.V2ListClusterManifests(ctx, operations.V2ListClusterManifestsParams{
+					ClusterID: *clusterID,
+				})
+				Expect(response).Should(BeAssignableToTypeOf(operations.NewV2ListClusterManifestsOK()))
+				payload := response.(*operations.V2ListClusterManifestsOK).Payload
+				Expect(payload).To(HaveLen(1))
+				return payload[0]
+			}
+
+			// Listing the manifests doesn't lint them
+			Expect(list().LintIssues).To(BeEmpty())
+
+			content := strings.Replace(lintedMachineConfig, "/v1", "/v1alpha1", 1)
+			mockS3Client.EXPECT().ListObjectsByPrefixWithMetadata(gomock.Any(), filepath.Join(clusterID.String(), constants.ManifestMetadataFolder)).Times(1)
+			mockS3Client.EXPECT().ListObjectsByPrefixWithMetadata(gomock.Any(), fmt.Sprintf("%s/manifests", *clusterID)).Return(files, nil).Times(1)
+			mockS3Client.EXPECT().Download(gomock.Any(), getObjectName(clusterID, validFolder, "legacy.yaml")).
+				Return(io.NopCloser(strings.NewReader(content)), int64(len(content)), nil).Times(1)
+			backfill := manifests.NewLintBackfill(manifestsAPI, &leader.DummyElector{})
+			backfill.Run()
+			// The manifests are only linted once
+			backfill.Run()
+
+			issues := list().LintIssues
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Rule).To(Equal(models.ManifestLintIssueRuleAPIVersion))
+			Expect(issues[0].Severity).To(Equal(models.ManifestLintIssueSeverityWarning))
+		})
+	})
+
 	Context("V2DeleteClusterManifest", func() {
 		It("deletes manifest from default folder", func() {
 			clusterID := registerCluster().ID
 			mockUpload(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(true, nil).Times(1)
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(false, nil).Times(1)
 			mockListByPrefix(clusterID, nil)
 			mockUsageAPI.EXPECT().Remove(gomock.Any(), gomock.Any()).Times(1)
 			mockUsageAPI.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
@@ -936,6 +1080,7 @@ invalid YAML content: {
 			mockUpload(2)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(true, nil).Times(1)
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, "file-1.yaml")).Return(false, nil).Times(1)
 			metadata := map[string]string{constants.ManifestSourceAttribute: constants.ManifestSourceUserSupplied}
 			mockListByPrefix(clusterID, []s3wrapper.ObjectInfo{{Path: getObjectName(clusterID, defaultFolder, "file-2.yaml"), Metadata: metadata}})
 			mockUsageAPI.EXPECT().Remove(gomock.Any(), gomock.Any()).Times(0)
@@ -957,6 +1102,7 @@ invalid YAML content: {
 			mockUpload(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, validFolder, "file-1.yaml")).Return(true, nil).Times(1)
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, validFolder, "file-1.yaml")).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, validFolder, "file-1.yaml")).Return(false, nil).Times(1)
 			mockListByPrefix(clusterID, nil)
 			mockUsageAPI.EXPECT().Remove(gomock.Any(), gomock.Any()).Times(1)
 			mockUsageAPI.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
@@ -1023,6 +1169,133 @@ invalid YAML content: {
 		})
 	})
 
+	Context("Butane manifests", func() {
+		const butaneFileName = "99-worker-custom.yaml"
+		const machineConfigContent = "kind: MachineConfig\n"
+
+		checksum := func(content string) string {
+			return fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+		}
+
+		createButaneManifest := func(clusterID strfmt.UUID) middleware.Responder {
+			return manifestsAPI.V2CreateClusterManifest(ctx, operations.V2CreateClusterManifestParams{
+				ClusterID: clusterID,
+				CreateManifestParams: &models.CreateManifestParams{
+					Content:  swag.String(encodeToBase64(contentAsButane)),
+					FileName: swag.String(butaneFileName),
+				},
+			})
+		}
+
+		It("creates a MachineConfig from a Butane config and stores the source", func() {
+			clusterID := registerCluster().ID
+			expectUsageCalls()
+			mockObjectExists(false)
+			var machineConfig []byte
+			mockS3Client.EXPECT().UploadWithMetadata(ctx, gomock.Any(), getObjectName(clusterID, defaultFolder, butaneFileName), gomock.Any()).
+				DoAndReturn(func(_ context.Context, content []byte, _ string, _ map[string]string) error {
+					machineConfig = content
+					return nil
+				}).Times(1)
+			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsButane),
+				manifests.GetManifestButaneSourceObjectName(*clusterID, filepath.Join(defaultFolder, butaneFileName)), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ []byte, _ string, metadata map[string]string) error {
+					Expect(metadata[constants.ManifestChecksumAttribute]).To(Equal(checksum(string(machineConfig))))
+					return nil
+				}).Times(1)
+
+			response := createButaneManifest(*clusterID)
+			Expect(response).Should(BeAssignableToTypeOf(operations.NewV2CreateClusterManifestCreated()))
+			Expect(string(machineConfig)).To(ContainSubstring("kind: MachineConfig"))
+			Expect(string(machineConfig)).To(ContainSubstring("name: 99-worker-custom"))
+		})
+
+		It("rejects a Butane config newer than the cluster version", func() {
+			clusterID := strfmt.UUID(uuid.New().String())
+			cluster := common.Cluster{Cluster: models.Cluster{
+				ID:               &clusterID,
+				Status:           swag.String(models.ClusterStatusReady),
+				OpenshiftVersion: "4.11.0",
+			}}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+
+			response := createButaneManifest(clusterID)
+			Expect(response).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.New(""))))
+			err := response.(*common.ApiErrorResponse)
+			Expect(err.StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+			Expect(err.Error()).To(ContainSubstring("Butane openshift version 4.12.0 is newer than the OpenShift version 4.11.0"))
+		})
+
+		It("rejects an invalid Butane config with the line of the error", func() {
+			clusterID := registerCluster().ID
+			content := "variant: openshift\nversion: 4.12.0\nmetadata:\n  name: test\n"
+			response := manifestsAPI.V2CreateClusterManifest(ctx, operations.V2CreateClusterManifestParams{
+				ClusterID: *clusterID,
+				CreateManifestParams: &models.CreateManifestParams{
+					Content:  swag.String(encodeToBase64(content)),
+					FileName: swag.String(butaneFileName),
+				},
+			})
+			Expect(response).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.New(""))))
+			err := response.(*common.ApiErrorResponse)
+			Expect(err.Error()).To(ContainSubstring("line 4"))
+		})
+
+		It("downloads the Butane source of a manifest", func() {
+			clusterID := registerCluster().ID
+			manifestObjectName := getObjectName(clusterID, defaultFolder, butaneFileName)
+			sourceObjectName := manifests.GetManifestButaneSourceObjectName(*clusterID, filepath.Join(defaultFolder, butaneFileName))
+			mockS3Client.EXPECT().DoesObjectExist(ctx, manifestObjectName).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().ListObjectsByPrefixWithMetadata(ctx, sourceObjectName).Return([]s3wrapper.ObjectInfo{{
+				Path:     sourceObjectName,
+				Metadata: map[string]string{constants.ManifestChecksumAttribute: checksum(machineConfigContent)},
+			}}, nil).Times(1)
+			mockS3Client.EXPECT().Download(ctx, manifestObjectName).Return(io.NopCloser(strings.NewReader(machineConfigContent)), int64(0), nil).Times(1)
+			mockS3Client.EXPECT().Download(ctx, sourceObjectName).Return(VoidReadCloser{}, int64(0), nil).Times(1)
+
+			response := manifestsAPI.V2DownloadClusterManifest(ctx, operations.V2DownloadClusterManifestParams{
+				ClusterID:    *clusterID,
+				FileName:     butaneFileName,
+				ButaneSource: swag.Bool(true),
+			})
+			Expect(response).Should(BeAssignableToTypeOf(filemiddleware.NewResponder(nil, "", int64(0), nil)))
+		})
+
+		It("doesn't download the Butane source of a manifest that was replaced", func() {
+			clusterID := registerCluster().ID
+			manifestObjectName := getObjectName(clusterID, defaultFolder, butaneFileName)
+			sourceObjectName := manifests.GetManifestButaneSourceObjectName(*clusterID, filepath.Join(defaultFolder, butaneFileName))
+			mockS3Client.EXPECT().DoesObjectExist(ctx, manifestObjectName).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().ListObjectsByPrefixWithMetadata(ctx, sourceObjectName).Return([]s3wrapper.ObjectInfo{{
+				Path:     sourceObjectName,
+				Metadata: map[string]string{constants.ManifestChecksumAttribute: checksum("kind: ConfigMap\n")},
+			}}, nil).Times(1)
+			mockS3Client.EXPECT().Download(ctx, manifestObjectName).Return(io.NopCloser(strings.NewReader(machineConfigContent)), int64(0), nil).Times(1)
+
+			response := manifestsAPI.V2DownloadClusterManifest(ctx, operations.V2DownloadClusterManifestParams{
+				ClusterID:    *clusterID,
+				FileName:     butaneFileName,
+				ButaneSource: swag.Bool(true),
+			})
+			Expect(response).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusNotFound, errors.New(""))))
+		})
+
+		It("deletes the Butane source along with the manifest", func() {
+			clusterID := registerCluster().ID
+			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, butaneFileName)).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, butaneFileName)).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, butaneFileName)).Return(true, nil).Times(1)
+			mockListByPrefix(clusterID, nil)
+			mockUsageAPI.EXPECT().Remove(gomock.Any(), gomock.Any()).AnyTimes()
+			mockUsageAPI.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			response := manifestsAPI.V2DeleteClusterManifest(ctx, operations.V2DeleteClusterManifestParams{
+				ClusterID: *clusterID,
+				FileName:  butaneFileName,
+			})
+			Expect(response).Should(BeAssignableToTypeOf(operations.NewV2DeleteClusterManifestOK()))
+		})
+	})
+
 	Context("DownloadClusterManifest", func() {
 		It("downloads manifest from different folder", func() {
 			clusterID := registerCluster().ID
@@ -1118,6 +1391,7 @@ invalid YAML content: {
 			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsYAML), getObjectName(clusterID, destFolder, destFileName), gomock.Any()).Return(nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil).Times(1)
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil).Times(1)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, fileNameYaml)).Return(false, nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, filepath.Join(clusterID.String(), constants.ManifestFolder, "openshift", destFileName)).Return(false, nil).AnyTimes()
 			response := manifestsAPI.V2UpdateClusterManifest(ctx, operations.V2UpdateClusterManifestParams{
 				ClusterID: *clusterID,
@@ -1252,6 +1526,7 @@ invalid YAML content: {
 			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsYAML), getObjectName(clusterID, destFolder, fileNameYaml), gomock.Any()).Return(nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil).Times(1)
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, fileNameYaml)).Return(false, nil).Times(1)
 			response := manifestsAPI.V2UpdateClusterManifest(ctx, operations.V2UpdateClusterManifestParams{
 				ClusterID: *clusterID,
 				UpdateManifestParams: &models.UpdateManifestParams{
@@ -1275,6 +1550,7 @@ invalid YAML content: {
 			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsYAML), getObjectName(clusterID, defaultFolder, destFileName), gomock.Any()).Return(nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil).AnyTimes()
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, defaultFolder, fileNameYaml)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, defaultFolder, fileNameYaml)).Return(false, nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, filepath.Join(clusterID.String(), constants.ManifestFolder, "openshift", destFileName)).Return(false, nil).AnyTimes()
 			response := manifestsAPI.V2UpdateClusterManifest(ctx, operations.V2UpdateClusterManifestParams{
 				ClusterID: *clusterID,
@@ -1324,6 +1600,7 @@ invalid YAML content: {
 			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsYAML), getObjectName(clusterID, destFolder, destFileName), gomock.Any()).Return(nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, srcFolder, srcFileName)).Return(true, nil).AnyTimes()
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, srcFolder, srcFileName)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, srcFolder, srcFileName)).Return(false, nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, filepath.Join(clusterID.String(), constants.ManifestFolder, "openshift", "test2.json")).Return(false, nil).AnyTimes()
 			response := manifestsAPI.V2UpdateClusterManifest(ctx, operations.V2UpdateClusterManifestParams{
 				ClusterID: *clusterID,
@@ -1371,6 +1648,7 @@ invalid YAML content: {
 			mockS3Client.EXPECT().UploadWithMetadata(ctx, []byte(contentAsYAML), getObjectName(clusterID, destFolder, destFileName), gomock.Any()).Return(nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, getObjectName(clusterID, srcFolder, srcFileName)).Return(true, nil).AnyTimes()
 			mockS3Client.EXPECT().DeleteObject(ctx, getObjectName(clusterID, srcFolder, srcFileName)).Return(true, nil)
+			mockS3Client.EXPECT().DeleteObject(ctx, getButaneSourceObjectName(clusterID, srcFolder, srcFileName)).Return(false, nil).Times(1)
 			mockS3Client.EXPECT().DoesObjectExist(ctx, filepath.Join(clusterID.String(), constants.ManifestFolder, "openshift", "test2.json")).Return(false, nil).AnyTimes()
 			response := manifestsAPI.V2UpdateClusterManifest(ctx, operations.V2UpdateClusterManifestParams{
 				ClusterID: *clusterID,
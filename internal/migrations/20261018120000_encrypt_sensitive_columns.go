@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/encryption"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const encryptSensitiveColumnsID = "20261018120000"
+
+const encryptSensitiveColumnsBatchSize = 500
+
+// encryptSensitiveColumnsTables lists the columns that were first tagged as
+// encrypted. It is fixed so that the migration keeps doing the same thing when
+// more columns are encrypted later on.
+var encryptSensitiveColumnsTables = []struct {
+	table   string
+	columns []string
+}{
+	{table: "clusters", columns: []string{"pull_secret"}},
+	{table: "hosts", columns: []string{"ignition_endpoint_token", "ignition_config_overrides", "ignition_config_overrides_source"}},
+	{table: "infra_envs", columns: []string{"pull_secret", "image_token_key", "ignition_config_override", "ignition_config_override_source"}},
+}
+
+func encryptSensitiveColumns() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		// Nothing to do when encryption is not configured, the rows can be
+		// encrypted later on with the encryption key rotation command
+		keyring := encryption.KeyringFromDB(tx)
+		if keyring == nil {
+			return nil
+		}
+		for _, t := range encryptSensitiveColumnsTables {
+			if err := encryption.ReencryptTableColumns(context.Background(), tx, keyring, log.StandardLogger(),
+				encryptSensitiveColumnsBatchSize, t.table, t.columns...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		keyring := encryption.KeyringFromDB(tx)
+		if keyring == nil {
+			return nil
+		}
+		for _, t := range encryptSensitiveColumnsTables {
+			if err := encryption.DecryptTableColumns(context.Background(), tx, keyring, log.StandardLogger(),
+				encryptSensitiveColumnsBatchSize, t.table, t.columns...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID:       encryptSensitiveColumnsID,
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"bytes"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// withKeyring returns a DB sharing the connections of the given DB that
+// encrypts with the keyring
+func withKeyring(db *gorm.DB, keyring *encryption.Keyring) *gorm.DB {
+	sqlDB, err := db.DB()
+	Expect(err).ToNot(HaveOccurred())
+	encryptedDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(encryptedDB.Use(encryption.NewPlugin(keyring))).To(Succeed())
+	return encryptedDB
+}
+
+var _ = Describe("encryptSensitiveColumns", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		keyring *encryption.Keyring
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		var err error
+		keyring, err = encryption.NewKeyring(map[string][]byte{"key": bytes.Repeat([]byte{1}, 32)}, "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	rawColumn := func(table, column string, id strfmt.UUID) string {
+		var value string
+		Expect(db.Table(table).Where("id = ?", id).Select(column).Row().Scan(&value)).To(Succeed())
+		return value
+	}
+
+	It("Migrates down and up", func() {
+		Expect(migrateToBefore(db, encryptSensitiveColumnsID)).To(Succeed())
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{
+			Cluster:    models.Cluster{ID: &clusterID, SSHPublicKey: "ssh-rsa key"},
+			PullSecret: "{\"auths\":{}}",
+		}).Error).To(Succeed())
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{
+			InfraEnv:      models.InfraEnv{ID: &infraEnvID, IgnitionConfigOverride: "{\"ignition\":{}}"},
+			ImageTokenKey: "token-key",
+		}).Error).To(Succeed())
+		Expect(rawColumn("clusters", "pull_secret", clusterID)).To(Equal("{\"auths\":{}}"))
+
+		db = withKeyring(db, keyring)
+		Expect(migrateTo(db, encryptSensitiveColumnsID)).To(Succeed())
+
+		Expect(rawColumn("clusters", "pull_secret", clusterID)).To(HavePrefix("enc:v1:key:"))
+		Expect(rawColumn("clusters", "ssh_public_key", clusterID)).To(Equal("ssh-rsa key"))
+		for _, column := range []string{"image_token_key", "ignition_config_override"} {
+			Expect(rawColumn("infra_envs", column, infraEnvID)).To(HavePrefix("enc:v1:key:"))
+		}
+		Expect(rawColumn("infra_envs", "pull_secret", infraEnvID)).To(BeEmpty())
+
+		cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.PullSecret).To(Equal("{\"auths\":{}}"))
+		Expect(cluster.SSHPublicKey).To(Equal("ssh-rsa key"))
+
+		// Map based updates are encrypted as well
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("image_token_key", "new-key").Error).To(Succeed())
+		Expect(rawColumn("infra_envs", "image_token_key", infraEnvID)).To(HavePrefix("enc:v1:key:"))
+		infraEnv, err := common.GetInfraEnvFromDB(db, infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(infraEnv.ImageTokenKey).To(Equal("new-key"))
+		Expect(infraEnv.IgnitionConfigOverride).To(Equal("{\"ignition\":{}}"))
+
+		gm := gormigrate.New(db, gormigrate.DefaultOptions, post())
+		Expect(gm.RollbackMigration(encryptSensitiveColumns())).To(Succeed())
+		Expect(rawColumn("clusters", "pull_secret", clusterID)).To(Equal("{\"auths\":{}}"))
+		Expect(rawColumn("infra_envs", "image_token_key", infraEnvID)).To(Equal("new-key"))
+	})
+})
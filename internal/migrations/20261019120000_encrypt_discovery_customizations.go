@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/encryption"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const encryptDiscoveryCustomizationsID = "20261019120000"
+
+// encryptDiscoveryCustomizationsTables lists the tables whose discovery_customization column is encrypted
+var encryptDiscoveryCustomizationsTables = []string{"infra_envs", "discovery_customization_revisions"}
+
+func encryptDiscoveryCustomizations() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		keyring := encryption.KeyringFromDB(tx)
+		if keyring == nil {
+			return nil
+		}
+		for _, table := range encryptDiscoveryCustomizationsTables {
+			if err := encryption.ReencryptTableColumns(context.Background(), tx, keyring, log.StandardLogger(),
+				encryptSensitiveColumnsBatchSize, table, "discovery_customization"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		keyring := encryption.KeyringFromDB(tx)
+		if keyring == nil {
+			return nil
+		}
+		for _, table := range encryptDiscoveryCustomizationsTables {
+			if err := encryption.DecryptTableColumns(context.Background(), tx, keyring, log.StandardLogger(),
+				encryptSensitiveColumnsBatchSize, table, "discovery_customization"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID:       encryptDiscoveryCustomizationsID,
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
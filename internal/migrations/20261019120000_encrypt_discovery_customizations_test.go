@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"bytes"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("encryptDiscoveryCustomizations", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		keyring *encryption.Keyring
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		var err error
+		keyring, err = encryption.NewKeyring(map[string][]byte{"key": bytes.Repeat([]byte{1}, 32)}, "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	rawCustomization := func(table, column string, id strfmt.UUID) string {
+		var value string
+		Expect(db.Table(table).Where(column+" = ?", id).Select("discovery_customization").Row().Scan(&value)).To(Succeed())
+		return value
+	}
+
+	It("Migrates down and up", func() {
+		Expect(migrateToBefore(db, encryptDiscoveryCustomizationsID)).To(Succeed())
+
+		customization := "{\"files\":[]}"
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{
+			InfraEnv: models.InfraEnv{ID: &infraEnvID, DiscoveryCustomization: &customization},
+		}).Error).To(Succeed())
+		Expect(db.Create(&models.DiscoveryCustomizationRevision{
+			InfraEnvID:             &infraEnvID,
+			Revision:               swag.Int64(1),
+			DiscoveryCustomization: customization,
+		}).Error).To(Succeed())
+		Expect(rawCustomization("infra_envs", "id", infraEnvID)).To(Equal(customization))
+		Expect(rawCustomization("discovery_customization_revisions", "infra_env_id", infraEnvID)).To(Equal(customization))
+
+		db = withKeyring(db, keyring)
+		Expect(migrateTo(db, encryptDiscoveryCustomizationsID)).To(Succeed())
+
+		Expect(rawCustomization("infra_envs", "id", infraEnvID)).To(HavePrefix("enc:v1:key:"))
+		Expect(rawCustomization("discovery_customization_revisions", "infra_env_id", infraEnvID)).To(HavePrefix("enc:v1:key:"))
+		infraEnv, err := common.GetInfraEnvFromDB(db, infraEnvID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(infraEnv.DiscoveryCustomization).To(Equal(&customization))
+		var revision models.DiscoveryCustomizationRevision
+		Expect(db.Where("infra_env_id = ?", infraEnvID).Take(&revision).Error).To(Succeed())
+		Expect(revision.DiscoveryCustomization).To(Equal(customization))
+
+		gm := gormigrate.New(db, gormigrate.DefaultOptions, post())
+		Expect(gm.RollbackMigration(encryptDiscoveryCustomizations())).To(Succeed())
+		Expect(rawCustomization("infra_envs", "id", infraEnvID)).To(Equal(customization))
+		Expect(rawCustomization("discovery_customization_revisions", "infra_env_id", infraEnvID)).To(Equal(customization))
+	})
+})
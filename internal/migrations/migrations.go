@@ -52,6 +52,8 @@ func post() []*gormigrate.Migration {
 		addHostsByInfraEnvIdIndex(),
 		populatePrimaryIPStackForExistingClusters(),
 		setEventsAutovacuumSettings(),
+		encryptSensitiveColumns(),
+		encryptDiscoveryCustomizations(),
 	}
 
 	sort.SliceStable(postMigrations, func(i, j int) bool { return postMigrations[i].ID < postMigrations[j].ID })
@@ -0,0 +1,24 @@
+package network
+
+import (
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// BuildMacInterfaceMap builds the MAC to interface name mapping of the static network config of an NMStateConfig
+func BuildMacInterfaceMap(log logrus.FieldLogger, nmStateConfig aiv1beta1.NMStateConfig) models.MacInterfaceMap {
+	macInterfaceMap := make(models.MacInterfaceMap, 0, len(nmStateConfig.Spec.Interfaces))
+	for _, cfg := range nmStateConfig.Spec.Interfaces {
+		if cfg == nil {
+			continue
+		}
+		log.Debugf("adding MAC interface map to host static network config - Name: %s, MacAddress: %s ,",
+			cfg.Name, cfg.MacAddress)
+		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{
+			MacAddress:     cfg.MacAddress,
+			LogicalNicName: cfg.Name,
+		})
+	}
+	return macInterfaceMap
+}
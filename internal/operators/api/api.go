@@ -69,6 +69,15 @@ type OptionalBundleOperator interface {
 	GetOptionalBundleLabels(featureIDs []models.FeatureSupportLevelID) []string
 }
 
+// VersionedSubscriptionOperator is implemented by OLM operators whose supported versions are known. The manager uses
+// a type assertion to check the channel and the starting CSV of the subscription overrides of these operators
+// against the versions that are supported by the OpenShift version of the cluster.
+type VersionedSubscriptionOperator interface {
+	// GetSupportedSubscriptionVersions returns the Major.Minor versions of the operator that are supported by the
+	// given OpenShift version
+	GetSupportedSubscriptionVersions(openshiftVersion string) []string
+}
+
 // Storage Operator provide a generic API for storage operators
 type StorageOperator interface {
 	Operator
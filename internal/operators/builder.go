@@ -7,6 +7,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/authorino"
 	"github.com/openshift/assisted-service/internal/operators/clusterobservability"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/fenceagentsremediation"
 	"github.com/openshift/assisted-service/internal/operators/kmm"
 	"github.com/openshift/assisted-service/internal/operators/kubedescheduler"
@@ -31,8 +32,10 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -51,12 +54,17 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+	// DeclarativeOperatorsDir is the directory of the definitions of the declarative operators, one sub-directory
+	// per operator
+	DeclarativeOperatorsDir string `envconfig:"DECLARATIVE_OPERATORS_DIR" default:""`
+	// Bundles is a JSON list of bundle definitions, the bundles that the administrator defines in addition to the
+	// built-in ones
+	Bundles string `envconfig:"OPERATOR_BUNDLES" default:""`
 }
 
 // NewManager creates new instance of an Operator Manager
-func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API) *Manager {
-	return NewManagerWithOperators(
-		log, manifestAPI, options, objectHandler,
+func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API) (*Manager, error) {
+	olmOperators := []api.Operator{
 		lso.NewLSOperator(),
 		odf.NewOcsOperator(log),
 		odf.NewOdfOperator(log),
@@ -82,11 +90,25 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 		kubedescheduler.NewKubeDeschedulerOperator(log),
 		loki.NewLokiOperator(log),
 		openshiftlogging.NewOpenShiftLoggingOperator(log),
+		sriov.NewSriovOperator(log),
 		clusterobservability.NewClusterObservabilityOperator(log),
 		numaresources.NewNumaResourcesOperator(log),
 		oadp.NewOadpOperator(log),
 		metallb.NewMetalLBOperator(log),
-	)
+	}
+	declarativeOperators, err := declarative.LoadOperators(log, options.DeclarativeOperatorsDir, olmOperators)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the declarative operators")
+	}
+	manager := NewManagerWithOperators(log, manifestAPI, options, objectHandler, append(olmOperators, declarativeOperators...)...)
+	bundleDefinitions, err := ParseBundleDefinitions(options.Bundles)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the operator bundles")
+	}
+	if err = manager.AddBundleDefinitions(bundleDefinitions); err != nil {
+		return nil, errors.Wrap(err, "failed to add the operator bundles")
+	}
+	return manager, nil
 }
 
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
@@ -74,4 +74,16 @@ var _ = Describe("Operators manager builder", func() {
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorConsole.Name, &OperatorConsole))
 		Expect(monitoredOperatorsList).To(HaveKeyWithValue(OperatorCVO.Name, &OperatorCVO))
 	})
+
+	It("should fail when the declarative operators can't be loaded", func() {
+		_, err := NewManager(log, nil, Options{DeclarativeOperatorsDir: "/does/not/exist"}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to load the declarative operators"))
+	})
+
+	It("should fail when the operator bundles are invalid", func() {
+		_, err := NewManager(log, nil, Options{Bundles: "not a bundle definition"}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to parse the operator bundles"))
+	})
 })
@@ -0,0 +1,123 @@
+package operators
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+var bundleIDRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// BundleDefinition describes a bundle that is defined in the configuration of the service, in addition to the
+// built-in bundles. Unlike the built-in bundles, whose operators are calculated scanning the operators, the operators
+// of a defined bundle are listed explicitly, and may have preset properties.
+type BundleDefinition struct {
+	ID                string                         `json:"id"`
+	Title             string                         `json:"title"`
+	Description       string                         `json:"description,omitempty"`
+	Operators         []*models.OperatorCreateParams `json:"operators"`
+	OptionalOperators []*models.OperatorCreateParams `json:"optional_operators,omitempty"`
+}
+
+// ParseBundleDefinitions parses a JSON list of bundle definitions. Empty content defines no bundles.
+func ParseBundleDefinitions(content string) ([]*BundleDefinition, error) {
+	if content == "" {
+		return nil, nil
+	}
+	var definitions []*BundleDefinition
+	if err := json.Unmarshal([]byte(content), &definitions); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the bundle definitions")
+	}
+	return definitions, nil
+}
+
+// AddBundleDefinitions validates the bundle definitions and adds them to the bundles of the manager. The operators
+// of the bundles must be supported by the manager, and their preset properties must match their schemas.
+func (mgr *Manager) AddBundleDefinitions(definitions []*BundleDefinition) error {
+	for _, definition := range definitions {
+		if err := mgr.validateBundleDefinition(definition); err != nil {
+			return errors.Wrapf(err, "invalid definition of bundle '%s'", definition.ID)
+		}
+		mgr.bundleDefinitions = append(mgr.bundleDefinitions, definition)
+	}
+	return nil
+}
+
+func (mgr *Manager) validateBundleDefinition(definition *BundleDefinition) error {
+	if !bundleIDRegex.MatchString(definition.ID) {
+		return errors.New("identifier must consist of lower case alphanumeric characters or '-'")
+	}
+	if _, ok := mgr.lookupBundle(definition.ID); ok {
+		return errors.New("bundle is already defined")
+	}
+	if definition.Title == "" {
+		return errors.New("title is required")
+	}
+	if len(definition.Operators) == 0 {
+		return errors.New("at least one operator is required")
+	}
+
+	names := make(map[string]struct{})
+	for _, operator := range append(append([]*models.OperatorCreateParams{}, definition.Operators...), definition.OptionalOperators...) {
+		if operator == nil {
+			return errors.New("operator is empty")
+		}
+		if _, ok := names[operator.Name]; ok {
+			return fmt.Errorf("operator %s is listed more than once", operator.Name)
+		}
+		names[operator.Name] = struct{}{}
+		if _, ok := mgr.olmOperators[operator.Name]; !ok {
+			return fmt.Errorf("operator %s isn't supported", operator.Name)
+		}
+		if err := mgr.EnsureOperatorProperties(nil, []*models.MonitoredOperator{{Name: operator.Name, Properties: operator.Properties}}); err != nil {
+			return err
+		}
+		if !isEmptySubscriptionOverrides(operator.Subscription) {
+			if err := validateSubscriptionOverrides(operator.Subscription); err != nil {
+				return errors.Wrapf(err, "invalid subscription overrides of operator %s", operator.Name)
+			}
+		}
+		if err := validateFailurePolicy(operator.FailurePolicy, operator.MaxRetries); err != nil {
+			return errors.Wrapf(err, "invalid failure policy of operator %s", operator.Name)
+		}
+	}
+	return nil
+}
+
+// lookupBundleDefinition returns the definition of the bundle with the given identifier, if it's a defined bundle
+func (mgr *Manager) lookupBundleDefinition(bundleID string) (*BundleDefinition, bool) {
+	for _, definition := range mgr.bundleDefinitions {
+		if definition.ID == bundleID {
+			return definition, true
+		}
+	}
+	return nil, false
+}
+
+// allBundles returns the basic information of the built-in bundles and of the defined bundles
+func (mgr *Manager) allBundles() []*models.Bundle {
+	ret := make([]*models.Bundle, 0, len(operatorscommon.Bundles)+len(mgr.bundleDefinitions))
+	ret = append(ret, operatorscommon.Bundles...)
+	for _, definition := range mgr.bundleDefinitions {
+		ret = append(ret, &models.Bundle{
+			ID:          definition.ID,
+			Title:       definition.Title,
+			Description: definition.Description,
+		})
+	}
+	return ret
+}
+
+func operatorNames(operators []*models.OperatorCreateParams) []string {
+	ret := make([]string, 0, len(operators))
+	for _, operator := range operators {
+		ret = append(ret, operator.Name)
+	}
+	sort.Strings(ret)
+	return ret
+}
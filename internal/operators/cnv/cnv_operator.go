@@ -328,6 +328,15 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDCNV
 }
 
+// GetSupportedSubscriptionVersions returns the versions of the operator that are supported by the OpenShift version.
+// The releases of OpenShift Virtualization follow the OpenShift releases, the ones of the upstream operator don't.
+func (o *operator) GetSupportedSubscriptionVersions(openshiftVersion string) []string {
+	if !o.config.Mode {
+		return nil
+	}
+	return operatorscommon.OpenshiftSubscriptionVersions(openshiftVersion)
+}
+
 // GetBundleLabels returns the bundle labels for the Authorino operator
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
@@ -18,9 +18,10 @@ var BundleOpenShiftAI = &models.Bundle{
 	Description: "Train, serve, monitor and manage AI/ML models and applications using GPUs.",
 }
 
-// Bundles is the list of valid bundles. Note that this list contains the basic information of the
+// Bundles is the list of built-in bundles. Note that this list contains the basic information of the
 // bundle, like identifier title and description, but it doesn't contain the list of operators that
-// is part of the bundle. That is calculated dynamically scanning the operators.
+// is part of the bundle. That is calculated dynamically scanning the operators. Additional bundles
+// can be defined in the configuration of the service.
 var Bundles = []*models.Bundle{
 	BundleVirtualization,
 	BundleOpenShiftAI,
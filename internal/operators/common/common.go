@@ -7,6 +7,7 @@ import (
 	"path"
 	"text/template"
 
+	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 )
@@ -30,6 +31,16 @@ func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, m
 	return eligibleDisks, availableDisks
 }
 
+// OpenshiftSubscriptionVersions returns the supported versions of the operators whose releases follow the OpenShift
+// releases, which is the Major.Minor of the OpenShift version
+func OpenshiftSubscriptionVersions(openshiftVersion string) []string {
+	openshift, err := version.NewVersion(openshiftVersion)
+	if err != nil || len(openshift.Segments()) < 2 {
+		return nil
+	}
+	return []string{fmt.Sprintf("%d.%d", openshift.Segments()[0], openshift.Segments()[1])}
+}
+
 func HasOperator(operators []*models.MonitoredOperator, operatorName string) bool {
 	for _, o := range operators {
 		if o.Name == operatorName {
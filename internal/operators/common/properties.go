@@ -0,0 +1,137 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+var propertyJSONTypes = map[string]string{
+	models.OperatorPropertyDataTypeBoolean: "boolean",
+	models.OperatorPropertyDataTypeString:  "string",
+	models.OperatorPropertyDataTypeInteger: "integer",
+	models.OperatorPropertyDataTypeFloat:   "number",
+}
+
+// PropertiesSchema converts the description of the properties of an operator to a JSON Schema. The properties are
+// the fields of a JSON object, and fields that aren't described aren't allowed, unless the operator describes no
+// properties at all.
+func PropertiesSchema(properties models.OperatorProperties) (*spec.Schema, error) {
+	schema := new(spec.Schema).Typed("object", "")
+	if len(properties) > 0 {
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+	}
+	schema.Properties = map[string]spec.Schema{}
+	for _, property := range properties {
+		if property == nil {
+			continue
+		}
+		if property.Name == "" {
+			return nil, errors.New("property name is required")
+		}
+		jsonType, ok := propertyJSONTypes[property.DataType]
+		if !ok {
+			return nil, fmt.Errorf("property %s has unknown data type '%s'", property.Name, property.DataType)
+		}
+		propertySchema := new(spec.Schema).Typed(jsonType, "").WithDescription(property.Description)
+		for _, option := range property.Options {
+			value, err := parsePropertyValue(property.DataType, option)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid option of property %s", property.Name)
+			}
+			propertySchema.Enum = append(propertySchema.Enum, value)
+		}
+		if property.DefaultValue != "" {
+			value, err := parsePropertyValue(property.DataType, property.DefaultValue)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid default value of property %s", property.Name)
+			}
+			propertySchema.Default = value
+		}
+		if property.Mandatory {
+			schema.Required = append(schema.Required, property.Name)
+		}
+		schema.Properties[property.Name] = *propertySchema
+	}
+	return schema, nil
+}
+
+func parsePropertyValue(dataType string, value string) (interface{}, error) {
+	switch dataType {
+	case models.OperatorPropertyDataTypeBoolean:
+		return strconv.ParseBool(value)
+	case models.OperatorPropertyDataTypeInteger:
+		return strconv.ParseInt(value, 10, 64)
+	case models.OperatorPropertyDataTypeFloat:
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+// ValidateProperties validates the properties of an operator, a JSON object, against their schema. Properties that
+// aren't set are validated with their defaults.
+func ValidateProperties(schema *spec.Schema, properties string) error {
+	values, _, err := propertiesWithDefaults(schema, properties)
+	if err != nil {
+		return err
+	}
+	result := validate.NewSchemaValidator(schema, nil, "", strfmt.Default).Validate(values)
+	if result.HasErrors() {
+		reasons := make([]string, 0, len(result.Errors))
+		for _, err := range result.Errors {
+			reasons = append(reasons, strings.TrimPrefix(strings.Replace(err.Error(), " in body", "", 1), "."))
+		}
+		sort.Strings(reasons)
+		return errors.New(strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+// PropertiesWithDefaults returns the properties of an operator, a JSON object, with the defaults of their schema filled
+// in. The properties are returned as is when none of the defaults is missing.
+func PropertiesWithDefaults(schema *spec.Schema, properties string) (string, error) {
+	values, defaulted, err := propertiesWithDefaults(schema, properties)
+	if err != nil {
+		return "", err
+	}
+	if !defaulted {
+		return properties, nil
+	}
+	content, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// propertiesWithDefaults parses the properties, where empty properties are an empty object, and fills the defaults of
+// the schema in them. It returns whether any default was filled in.
+func propertiesWithDefaults(schema *spec.Schema, properties string) (map[string]interface{}, bool, error) {
+	var values map[string]interface{}
+	if strings.TrimSpace(properties) != "" {
+		if err := json.Unmarshal([]byte(properties), &values); err != nil {
+			return nil, false, errors.Wrap(err, "properties must be a JSON object")
+		}
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	defaulted := false
+	for name, propertySchema := range schema.Properties {
+		if _, ok := values[name]; !ok && propertySchema.Default != nil {
+			values[name] = propertySchema.Default
+			defaulted = true
+		}
+	}
+	return values, defaulted, nil
+}
@@ -0,0 +1,102 @@
+package common_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Operator properties", func() {
+	properties := models.OperatorProperties{
+		{Name: "mode", DataType: models.OperatorPropertyDataTypeString, Options: []string{"fast", "safe"}, DefaultValue: "safe"},
+		{Name: "replicas", DataType: models.OperatorPropertyDataTypeInteger, Mandatory: true},
+		{Name: "ratio", DataType: models.OperatorPropertyDataTypeFloat},
+		{Name: "enabled", DataType: models.OperatorPropertyDataTypeBoolean, DefaultValue: "true", Description: "Enables it"},
+	}
+
+	It("should convert the properties to a JSON Schema", func() {
+		schema, err := common.PropertiesSchema(properties)
+		Expect(err).ToNot(HaveOccurred())
+
+		content, err := json.Marshal(schema)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(content).To(MatchJSON(`{
+			"type": "object",
+			"additionalProperties": false,
+			"required": ["replicas"],
+			"properties": {
+				"mode": {"type": "string", "enum": ["fast", "safe"], "default": "safe"},
+				"replicas": {"type": "integer"},
+				"ratio": {"type": "number"},
+				"enabled": {"type": "boolean", "default": true, "description": "Enables it"}
+			}
+		}`))
+	})
+
+	DescribeTable("should reject invalid descriptions", func(property *models.OperatorProperty) {
+		_, err := common.PropertiesSchema(models.OperatorProperties{property})
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("missing name", &models.OperatorProperty{DataType: models.OperatorPropertyDataTypeString}),
+		Entry("unknown data type", &models.OperatorProperty{Name: "p", DataType: "list"}),
+		Entry("invalid option", &models.OperatorProperty{Name: "p", DataType: models.OperatorPropertyDataTypeInteger, Options: []string{"one"}}),
+		Entry("invalid default", &models.OperatorProperty{Name: "p", DataType: models.OperatorPropertyDataTypeBoolean, DefaultValue: "maybe"}),
+	)
+
+	DescribeTable("should validate the properties and fill the defaults", func(value, expected string) {
+		schema, err := common.PropertiesSchema(properties)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.ValidateProperties(schema, value)).To(Succeed())
+		result, err := common.PropertiesWithDefaults(schema, value)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(MatchJSON(expected))
+	},
+		Entry("defaults", `{"replicas": 3}`, `{"replicas": 3, "mode": "safe", "enabled": true}`),
+		Entry("all set", `{"replicas": 3, "mode": "fast", "ratio": 0.5, "enabled": false}`,
+			`{"replicas": 3, "mode": "fast", "ratio": 0.5, "enabled": false}`),
+	)
+
+	DescribeTable("should reject invalid properties", func(value string) {
+		schema, err := common.PropertiesSchema(properties)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.ValidateProperties(schema, value)).ToNot(Succeed())
+	},
+		Entry("not JSON", "replicas=3"),
+		Entry("not an object", "[3]"),
+		Entry("missing mandatory property", `{"mode": "fast"}`),
+		Entry("unknown property", `{"replicas": 3, "replica": 3}`),
+		Entry("wrong type", `{"replicas": "3"}`),
+		Entry("not an integer", `{"replicas": 3.5}`),
+		Entry("not an option", `{"replicas": 3, "mode": "slow"}`),
+	)
+
+	It("should explain why the properties are invalid", func() {
+		schema, err := common.PropertiesSchema(properties)
+		Expect(err).ToNot(HaveOccurred())
+		err = common.ValidateProperties(schema, `{"replica": 3}`)
+		Expect(err).To(MatchError("replica is a forbidden property, replicas is required"))
+	})
+
+	DescribeTable("should keep properties without defaults as is", func(value string) {
+		schema, err := common.PropertiesSchema(models.OperatorProperties{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.ValidateProperties(schema, value)).To(Succeed())
+		result, err := common.PropertiesWithDefaults(schema, value)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(value))
+	},
+		Entry("empty", ""),
+		Entry("empty object", "{}"),
+		Entry("null", "null"),
+	)
+
+	It("should accept any properties of operators that don't describe them", func() {
+		schema, err := common.PropertiesSchema(models.OperatorProperties{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.ValidateProperties(schema, `{"unknown": true}`)).To(Succeed())
+	})
+})
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+)
+
+// StorageCapacityValidationID is the cluster validation that the storage operators share to check that the cluster
+// provides the usable capacity that the user requires
+const StorageCapacityValidationID = string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)
+
+const (
+	RequiredCapacityPropertyName = "required_capacity_gib"
+	ReplicaCountPropertyName     = "replica_count"
+)
+
+// StorageCapacityProperties are the properties of a storage operator that describe the capacity that the workloads
+// need. A zero required capacity disables the check.
+type StorageCapacityProperties struct {
+	RequiredCapacityGiB int64 `json:"required_capacity_gib,omitempty"`
+	ReplicaCount        int64 `json:"replica_count,omitempty"`
+}
+
+// RequiredCapacityProperty describes the property in which users declare the usable capacity that a storage operator
+// must provide
+func RequiredCapacityProperty() *models.OperatorProperty {
+	return &models.OperatorProperty{
+		Name:        RequiredCapacityPropertyName,
+		DataType:    models.OperatorPropertyDataTypeInteger,
+		Description: "Usable storage capacity in GiB that the workloads require, not checked when not set",
+	}
+}
+
+// ParseStorageCapacityProperties parses the properties of the storage operator with the given name from the
+// operators of the cluster. Properties that aren't set are zero.
+func ParseStorageCapacityProperties(operators []*models.MonitoredOperator, operatorName string) (*StorageCapacityProperties, error) {
+	result := &StorageCapacityProperties{}
+	operator := GetOperator(operators, operatorName)
+	if operator == nil || strings.TrimSpace(operator.Properties) == "" {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(operator.Properties), result); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the properties of operator %s", operatorName)
+	}
+	return result, nil
+}
+
+// EligibleDisksSizeBytes returns the total size of the disks that NonInstallationDiskCount counts as eligible
+func EligibleDisksSizeBytes(disks []*models.Disk, installationDiskID string, minSizeGB int64) int64 {
+	var result int64
+	for _, disk := range disks {
+		if (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && installationDiskID != disk.ID &&
+			disk.SizeBytes != 0 && disk.SizeBytes >= conversions.GbToBytes(minSizeGB) {
+			result += disk.SizeBytes
+		}
+	}
+	return result
+}
+
+// ValidateStorageCapacity checks that the usable capacity that the storage provides covers the required capacity,
+// and reports the shortfall otherwise. The storage is described by its provider, for example "ODF with 3 replicas".
+func ValidateStorageCapacity(provider string, usableBytes int64, requiredCapacityGiB int64) api.ValidationResult {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: StorageCapacityValidationID,
+	}
+	if requiredCapacityGiB <= 0 {
+		return result
+	}
+	requiredBytes := conversions.GibToBytes(requiredCapacityGiB)
+	if usableBytes < requiredBytes {
+		result.Status = api.Failure
+		result.Reasons = []string{
+			fmt.Sprintf("%s provides %s of usable capacity, %s less than the required %d GiB.",
+				provider, conversions.BytesToString(usableBytes), conversions.BytesToString(requiredBytes-usableBytes),
+				requiredCapacityGiB),
+		}
+		return result
+	}
+	result.Reasons = []string{
+		fmt.Sprintf("%s provides %s of usable capacity, which satisfies the required %d GiB.",
+			provider, conversions.BytesToString(usableBytes), requiredCapacityGiB),
+	}
+	return result
+}
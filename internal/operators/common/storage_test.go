@@ -0,0 +1,61 @@
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+)
+
+var _ = Describe("Storage capacity", func() {
+	It("should sum the size of the eligible disks", func() {
+		disks := []*models.Disk{
+			{SizeBytes: 100 * conversions.GB, DriveType: models.DriveTypeSSD, ID: "/dev/disk/by-id/disk-1"},
+			{SizeBytes: 200 * conversions.GB, DriveType: models.DriveTypeSSD, ID: "/dev/disk/by-id/disk-2"},
+			{SizeBytes: 300 * conversions.GB, DriveType: models.DriveTypeHDD, ID: "/dev/disk/by-id/disk-3"},
+			{SizeBytes: 20 * conversions.GB, DriveType: models.DriveTypeHDD, ID: "/dev/disk/by-id/disk-4"},
+			{SizeBytes: 400 * conversions.GB, DriveType: models.DriveTypeVirtual, ID: "/dev/disk/by-id/disk-5"},
+		}
+		Expect(common.EligibleDisksSizeBytes(disks, "/dev/disk/by-id/disk-1", 25)).To(Equal(int64(500 * conversions.GB)))
+	})
+
+	It("should parse the storage capacity properties of an operator", func() {
+		operators := []*models.MonitoredOperator{
+			{Name: "lso"},
+			{Name: "odf", Properties: `{"required_capacity_gib": 100, "replica_count": 2}`},
+		}
+		properties, err := common.ParseStorageCapacityProperties(operators, "odf")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(properties).To(Equal(&common.StorageCapacityProperties{RequiredCapacityGiB: 100, ReplicaCount: 2}))
+
+		properties, err = common.ParseStorageCapacityProperties(operators, "lso")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(properties).To(Equal(&common.StorageCapacityProperties{}))
+
+		_, err = common.ParseStorageCapacityProperties([]*models.MonitoredOperator{{Name: "odf", Properties: "invalid"}}, "odf")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should validate the usable capacity",
+		func(usableBytes, requiredCapacityGiB int64, expected api.ValidationResult) {
+			expected.ValidationId = common.StorageCapacityValidationID
+			Expect(common.ValidateStorageCapacity("Storage", usableBytes, requiredCapacityGiB)).To(Equal(expected))
+		},
+		Entry("capacity isn't required", int64(0), int64(0), api.ValidationResult{Status: api.Success}),
+		Entry("capacity is sufficient", int64(120*conversions.GiB), int64(100), api.ValidationResult{
+			Status:  api.Success,
+			Reasons: []string{"Storage provides 120.00 GiB of usable capacity, which satisfies the required 100 GiB."},
+		}),
+		Entry("capacity is exactly sufficient", int64(100*conversions.GiB), int64(100), api.ValidationResult{
+			Status:  api.Success,
+			Reasons: []string{"Storage provides 100.00 GiB of usable capacity, which satisfies the required 100 GiB."},
+		}),
+		Entry("capacity is insufficient", int64(80*conversions.GiB+conversions.GiB/2), int64(100), api.ValidationResult{
+			Status:  api.Failure,
+			Reasons: []string{"Storage provides 80.50 GiB of usable capacity, 19.50 GiB less than the required 100 GiB."},
+		}),
+	)
+})
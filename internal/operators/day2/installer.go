@@ -0,0 +1,634 @@
+package day2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	csvPhaseSucceeded = "Succeeded"
+	csvPhaseFailed    = "Failed"
+
+	// retryPendingStatusInfo is the status info of the operators whose retry was recorded, until the Monitor
+	// reapplies their subscriptions. Whether the retry is pending is stored in the retry_pending column, as the
+	// status info of day-1 operators is overwritten by the reports of the controller.
+	retryPendingStatusInfo = "Waiting to reapply the subscription"
+
+	// reachabilityTimeout bounds the request that checks that the API of the cluster is reachable
+	reachabilityTimeout = 10 * time.Second
+)
+
+// API installs OLM operators in clusters that are already installed
+//
+//go:generate mockgen --build_flags=--mod=mod -package=day2 -destination=mock_day2_api.go . API
+type API interface {
+	// InstallOperators installs OLM operators, and their missing dependencies, in an installed cluster
+	InstallOperators(ctx context.Context, clusterID strfmt.UUID, operators []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error)
+	// Monitor updates the status of the operators that are being installed in installed clusters, and reapplies
+	// the subscriptions of the operators whose retry was recorded
+	Monitor()
+}
+
+// Installer applies the manifests of the operators with the kubeconfig of the cluster, and monitors the operators
+// until they are available, as the installer does during the installation of the cluster.
+type Installer struct {
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	operatorsAPI  operators.API
+	hwValidator   hardware.Validator
+	objectHandler s3wrapper.API
+	clientFactory spoke_k8s_client.SpokeK8sClientFactory
+	eventsHandler eventsapi.Handler
+	leaderElector leader.Leader
+}
+
+// NewInstaller creates a new installer of operators in installed clusters
+func NewInstaller(log logrus.FieldLogger, db *gorm.DB, operatorsAPI operators.API, hwValidator hardware.Validator, objectHandler s3wrapper.API,
+	clientFactory spoke_k8s_client.SpokeK8sClientFactory, eventsHandler eventsapi.Handler, leaderElector leader.Leader) *Installer {
+	return &Installer{
+		log:           log,
+		db:            db,
+		operatorsAPI:  operatorsAPI,
+		hwValidator:   hwValidator,
+		objectHandler: objectHandler,
+		clientFactory: clientFactory,
+		eventsHandler: eventsHandler,
+		leaderElector: leaderElector,
+	}
+}
+
+func (i *Installer) InstallOperators(ctx context.Context, clusterID strfmt.UUID, params []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error) {
+	log := logutil.FromContext(ctx, i.log)
+
+	cluster, err := common.GetClusterFromDB(i.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(cluster.Status) != models.ClusterStatusInstalled {
+		return nil, common.NewApiError(http.StatusConflict, fmt.Errorf("operators can only be installed in %s clusters, cluster %s is %s",
+			models.ClusterStatusInstalled, clusterID, swag.StringValue(cluster.Status)))
+	}
+
+	newOperators, err := i.newOperators(cluster, params)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	// Only the properties of the new operators are validated, the installed operators keep theirs
+	if err = i.operatorsAPI.EnsureOperatorProperties(cluster, newOperators); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = i.operatorsAPI.SetOperatorPropertiesDefaults(cluster, newOperators); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The operators are validated and generated as if they were part of the initial installation
+	updatedCluster := *cluster
+	updatedCluster.MonitoredOperators = append(append([]*models.MonitoredOperator{}, cluster.MonitoredOperators...), newOperators...)
+	if err = i.operatorsAPI.EnsureOperatorPrerequisite(&updatedCluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, updatedCluster.MonitoredOperators); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	client, err := i.spokeClient(ctx, cluster)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = i.validateHosts(ctx, client, &updatedCluster); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	// The operators are recorded before their manifests are applied, so that concurrent requests can't install them
+	// twice
+	if err = i.createOperators(ctx, clusterID, newOperators); err != nil {
+		return nil, err
+	}
+	for index, operator := range newOperators {
+		if err = i.applyOperator(ctx, client, &updatedCluster, operator); err != nil {
+			err = errors.Wrapf(err, "failed to install operator %s", operator.Name)
+			log.WithError(err).Errorf("failed to apply the manifests of the operators of cluster %s", clusterID)
+			// The objects that were applied stay in the cluster, so the records of the operators are kept: the
+			// operators that were applied are monitored, and the others fail or are retried
+			i.failOperators(ctx, newOperators[index:], fmt.Sprintf("Failed to apply the manifests: %s", err))
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		eventgen.SendClusterOperatorStatusEvent(ctx, i.eventsHandler, clusterID, operator.Name, string(operator.Status), operator.StatusInfo)
+	}
+	return newOperators, nil
+}
+
+// failOperators handles the operators whose manifests weren't applied as the Monitor handles the operators that
+// failed: the ones that can be retried are reapplied by the Monitor, and the others fail
+func (i *Installer) failOperators(ctx context.Context, failed []*models.MonitoredOperator, statusInfo string) {
+	log := logutil.FromContext(ctx, i.log)
+	for _, operator := range failed {
+		var err error
+		if operators.ShouldRetry(operator) {
+			err = i.recordRetry(ctx, operator, statusInfo)
+		} else {
+			err = i.updateStatus(ctx, operator, models.OperatorStatusFailed, statusInfo, "")
+		}
+		if err != nil {
+			log.WithError(err).Errorf("failed to update operator %s of cluster %s", operator.Name, operator.ClusterID)
+		}
+	}
+}
+
+// createOperators records the new operators of the cluster as progressing. The cluster is locked, so that the
+// operators are only recorded once, and only while the cluster is installed.
+func (i *Installer) createOperators(ctx context.Context, clusterID strfmt.UUID, newOperators []*models.MonitoredOperator) error {
+	log := logutil.FromContext(ctx, i.log)
+	now := strfmt.DateTime(time.Now())
+	names := make([]string, 0, len(newOperators))
+	for _, operator := range newOperators {
+		operator.ClusterID = clusterID
+		operator.Day2 = true
+		operator.Status = models.OperatorStatusProgressing
+		operator.StatusInfo = "Installing"
+		operator.StatusUpdatedAt = now
+		names = append(names, operator.Name)
+	}
+	return i.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDBForUpdate(tx, clusterID, common.SkipEagerLoading)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if swag.StringValue(cluster.Status) != models.ClusterStatusInstalled {
+			return common.NewApiError(http.StatusConflict, fmt.Errorf("operators can only be installed in %s clusters, cluster %s is %s",
+				models.ClusterStatusInstalled, clusterID, swag.StringValue(cluster.Status)))
+		}
+		var installed []string
+		if err = tx.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name IN (?)", clusterID, names).Pluck("name", &installed).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if len(installed) > 0 {
+			return common.NewApiError(http.StatusConflict, fmt.Errorf("operators %s are already being installed in cluster %s",
+				strings.Join(installed, ", "), clusterID))
+		}
+		if err = tx.Create(&newOperators).Error; err != nil {
+			err = errors.Wrapf(err, "failed to save the operators of cluster %s", clusterID)
+			log.Error(err)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+}
+
+// applyOperator applies the manifests of an operator, the custom manifests are applied by the monitor once the
+// operator is available
+func (i *Installer) applyOperator(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster,
+	operator *models.MonitoredOperator) error {
+	openshiftManifests, _, err := i.operatorsAPI.GenerateOperatorManifests(cluster, operator)
+	if err != nil {
+		return err
+	}
+	objects, err := decodeManifests(openshiftManifests)
+	if err != nil {
+		return err
+	}
+	return applyObjects(ctx, client, objects)
+}
+
+// newOperators returns the requested operators and their dependencies that aren't installed in the cluster yet
+func (i *Installer) newOperators(cluster *common.Cluster, params []*models.OperatorCreateParams) ([]*models.MonitoredOperator, error) {
+	installed := make(map[string]bool)
+	for _, operator := range cluster.MonitoredOperators {
+		installed[operator.Name] = true
+	}
+
+	requested := make([]*models.MonitoredOperator, 0, len(params))
+	for _, param := range params {
+		if param == nil {
+			continue
+		}
+		if installed[param.Name] {
+			return nil, fmt.Errorf("operator %s is already installed in cluster %s", param.Name, cluster.ID)
+		}
+		operator, err := i.operatorsAPI.GetOperatorByName(param.Name)
+		if err != nil {
+			return nil, err
+		}
+		if operator.OperatorType != models.OperatorTypeOlm {
+			return nil, fmt.Errorf("operator %s isn't an OLM operator", param.Name)
+		}
+		operator.Properties = param.Properties
+		operator.Subscription = param.Subscription
+		operators.SetFailurePolicy(operator, param)
+		requested = append(requested, operator)
+	}
+	if len(requested) == 0 {
+		return nil, errors.New("at least one operator is required")
+	}
+
+	resolved, err := i.operatorsAPI.ResolveDependencies(cluster, requested)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*models.MonitoredOperator, 0, len(resolved))
+	for _, operator := range resolved {
+		if !installed[operator.Name] {
+			ret = append(ret, operator)
+		}
+	}
+	return ret, nil
+}
+
+// validateHosts checks that the nodes of the cluster satisfy the requirements of the cluster with its new operators.
+// The capacity of the nodes is taken from the cluster, as it may have changed since the installation; hosts that are
+// no longer nodes of the cluster are ignored.
+func (i *Installer) validateHosts(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster) error {
+	log := logutil.FromContext(ctx, i.log)
+	nodeList := &corev1.NodeList{}
+	if err := client.List(ctx, nodeList); err != nil {
+		return errors.Wrapf(err, "failed to list the nodes of cluster %s", cluster.ID)
+	}
+	nodes := make(map[string]*corev1.Node, len(nodeList.Items))
+	for j := range nodeList.Items {
+		nodes[nodeList.Items[j].Name] = &nodeList.Items[j]
+	}
+	for _, h := range cluster.Hosts {
+		hostname, err := hostutil.GetCurrentHostName(h)
+		if err != nil || hostname == "" {
+			continue
+		}
+		node, ok := nodes[hostname]
+		if !ok {
+			log.Infof("Host %s isn't a node of cluster %s, skipping its validation", hostname, cluster.ID)
+			continue
+		}
+		if !isNodeReady(node) {
+			return fmt.Errorf("node %s isn't ready", hostname)
+		}
+		requirements, err := i.hwValidator.GetClusterHostRequirements(ctx, cluster, h)
+		if err != nil {
+			return err
+		}
+		cpuCores := node.Status.Capacity.Cpu().Value()
+		if cpuCores < requirements.Total.CPUCores {
+			return fmt.Errorf("node %s requires at least %d CPU cores for the operators, found only %d",
+				hostname, requirements.Total.CPUCores, cpuCores)
+		}
+		requiredBytes := conversions.MibToBytes(requirements.Total.RAMMib)
+		memoryBytes := node.Status.Capacity.Memory().Value()
+		if memoryBytes < requiredBytes-conversions.MibToBytes(host.HostMemoryRequirementToleranceMiB) {
+			return fmt.Errorf("node %s requires at least %s RAM for the operators, found only %s",
+				hostname, conversions.BytesToString(requiredBytes), conversions.BytesToString(memoryBytes))
+		}
+	}
+	return nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// spokeClient creates a client of the cluster from the kubeconfig that was stored when the cluster was installed
+func (i *Installer) spokeClient(ctx context.Context, cluster *common.Cluster) (spoke_k8s_client.SpokeK8sClient, error) {
+	reader, _, err := i.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", cluster.ID, constants.Kubeconfig))
+	if err != nil {
+		// The kubeconfig is only stored when the cluster is finalizing, before that the one without the ingress CA
+		// is used
+		reader, _, err = i.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", cluster.ID, constants.KubeconfigNoIngress))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the kubeconfig of cluster %s", cluster.ID)
+	}
+	defer reader.Close()
+	kubeconfig, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the kubeconfig of cluster %s", cluster.ID)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.ID.String(),
+			Name:      constants.Kubeconfig,
+		},
+		Data: map[string][]byte{
+			"kubeconfig": kubeconfig,
+		},
+	}
+	return i.clientFactory.CreateFromSecret(nil, secret)
+}
+
+func (i *Installer) Monitor() {
+	if !i.leaderElector.IsLeader() {
+		i.log.Debugf("Not a leader, exiting operators Monitor")
+		return
+	}
+	requestID := requestid.NewID()
+	ctx := requestid.ToContext(context.Background(), requestID)
+	log := requestid.RequestIDLogger(i.log, requestID)
+
+	// The status of day-1 operators is reported by the controller, only their pending retries are handled here
+	var monitoredOperators []*models.MonitoredOperator
+	if err := i.db.Find(&monitoredOperators, "retry_pending = ? OR (day2 = ? AND status = ?)", true, true, models.OperatorStatusProgressing).Error; err != nil {
+		log.WithError(err).Error("failed to find the operators that are being installed")
+		return
+	}
+	clusterOperators := make(map[strfmt.UUID][]*models.MonitoredOperator)
+	for _, operator := range monitoredOperators {
+		clusterOperators[operator.ClusterID] = append(clusterOperators[operator.ClusterID], operator)
+	}
+	for clusterID, operators := range clusterOperators {
+		if err := i.monitorCluster(ctx, clusterID, operators); err != nil {
+			log.WithError(err).Warnf("failed to monitor the operators of cluster %s", clusterID)
+		}
+	}
+}
+
+func (i *Installer) monitorCluster(ctx context.Context, clusterID strfmt.UUID, monitoredOperators []*models.MonitoredOperator) error {
+	cluster, err := common.GetClusterFromDB(i.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		return err
+	}
+	client, err := i.spokeClient(ctx, cluster)
+	if err == nil && hasPendingRetries(monitoredOperators) {
+		err = checkReachable(ctx, client)
+	}
+	if err != nil {
+		// The subscriptions of the operators can only be reapplied when the cluster is reachable, otherwise their
+		// failure is final
+		for _, operator := range monitoredOperators {
+			if !operator.RetryPending {
+				continue
+			}
+			if updateErr := i.updateStatus(ctx, operator, models.OperatorStatusFailed,
+				fmt.Sprintf("Failed to reapply the subscription: %s: %s", operators.ErrClusterUnreachable, err), ""); updateErr != nil {
+				return updateErr
+			}
+		}
+		return err
+	}
+	for _, operator := range monitoredOperators {
+		if operator.RetryPending {
+			if err = i.reapply(ctx, client, cluster, operator); err != nil {
+				return err
+			}
+			continue
+		}
+		status, statusInfo, version, err := i.operatorStatus(ctx, client, cluster, operator)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the status of operator %s", operator.Name)
+		}
+		if status == models.OperatorStatusProgressing && operator.TimeoutSeconds > 0 &&
+			time.Since(time.Time(operator.StatusUpdatedAt)) > time.Duration(operator.TimeoutSeconds)*time.Second {
+			status = models.OperatorStatusFailed
+			statusInfo = fmt.Sprintf("Operator wasn't available within %d seconds", operator.TimeoutSeconds)
+		}
+		if status == models.OperatorStatusProgressing {
+			continue
+		}
+		if status == models.OperatorStatusFailed && operators.ShouldRetry(operator) {
+			if err = i.retry(ctx, client, cluster, operator, statusInfo); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = i.updateStatus(ctx, operator, status, statusInfo, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryOperator records another attempt of an operator that failed, whose subscription the Monitor reapplies with
+// the kubeconfig of the cluster. The operator is locked, so that concurrent reports of its failure only record a
+// single attempt.
+func (i *Installer) RetryOperator(ctx context.Context, clusterID strfmt.UUID, operatorName string, reason string) (bool, error) {
+	var operator models.MonitoredOperator
+	recorded := false
+	err := i.db.Transaction(func(tx *gorm.DB) error {
+		if err := transaction.AddForUpdateQueryOption(tx).First(&operator, "cluster_id = ? AND name = ?", clusterID, operatorName).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s has no operator %s", clusterID, operatorName))
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if operator.RetryPending || !operators.ShouldRetry(&operator) {
+			return nil
+		}
+		recorded = true
+		return saveRetry(tx, &operator)
+	})
+	if err != nil {
+		return false, err
+	}
+	if recorded {
+		sendRetriedEvent(ctx, i.eventsHandler, &operator, reason)
+	}
+	return operator.RetryPending, nil
+}
+
+func hasPendingRetries(monitoredOperators []*models.MonitoredOperator) bool {
+	for _, operator := range monitoredOperators {
+		if operator.RetryPending {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReachable lists a single namespace of the cluster, with a short timeout, to check that its API responds
+func checkReachable(ctx context.Context, client spoke_k8s_client.SpokeK8sClient) error {
+	ctx, cancel := context.WithTimeout(ctx, reachabilityTimeout)
+	defer cancel()
+	return client.List(ctx, &corev1.NamespaceList{}, ctrlclient.Limit(1))
+}
+
+// retry records another attempt of a failed operator and reapplies its subscriptions
+func (i *Installer) retry(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster,
+	operator *models.MonitoredOperator, reason string) error {
+	if err := i.recordRetry(ctx, operator, reason); err != nil {
+		return err
+	}
+	return i.reapply(ctx, client, cluster, operator)
+}
+
+// recordRetry counts another attempt of a failed operator, whose subscriptions the Monitor reapplies
+func (i *Installer) recordRetry(ctx context.Context, operator *models.MonitoredOperator, reason string) error {
+	if err := saveRetry(i.db, operator); err != nil {
+		return err
+	}
+	sendRetriedEvent(ctx, i.eventsHandler, operator, reason)
+	return nil
+}
+
+func saveRetry(db *gorm.DB, operator *models.MonitoredOperator) error {
+	operator.Retries++
+	operator.RetryPending = true
+	operator.Status = models.OperatorStatusProgressing
+	operator.StatusInfo = fmt.Sprintf("%s, attempt %d of %d", retryPendingStatusInfo, operator.Retries, operators.MaxRetries(operator))
+	operator.StatusUpdatedAt = strfmt.DateTime(time.Now())
+	err := db.Model(&models.MonitoredOperator{}).
+		Where("cluster_id = ? AND name = ?", operator.ClusterID, operator.Name).
+		Updates(map[string]interface{}{
+			"status":            operator.Status,
+			"status_info":       operator.StatusInfo,
+			"status_updated_at": operator.StatusUpdatedAt,
+			"retries":           operator.Retries,
+			"retry_pending":     operator.RetryPending,
+		}).Error
+	return errors.Wrapf(err, "failed to update operator %s of cluster %s", operator.Name, operator.ClusterID)
+}
+
+func sendRetriedEvent(ctx context.Context, eventsHandler eventsapi.Handler, operator *models.MonitoredOperator, reason string) {
+	eventgen.SendClusterOperatorRetriedEvent(ctx, eventsHandler, operator.ClusterID, operator.Name, reason,
+		operator.Retries, operators.MaxRetries(operator))
+}
+
+// reapply reapplies the subscriptions of an operator whose retry was recorded. Failing to reapply them fails the
+// operator.
+func (i *Installer) reapply(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster,
+	operator *models.MonitoredOperator) error {
+	if err := i.reapplySubscriptions(ctx, client, cluster, operator); err != nil {
+		return i.updateStatus(ctx, operator, models.OperatorStatusFailed, fmt.Sprintf("Failed to reapply the subscription: %s", err), "")
+	}
+	statusInfo := fmt.Sprintf("Reapplying the subscription, attempt %d of %d", operator.Retries, operators.MaxRetries(operator))
+	err := i.db.Model(&models.MonitoredOperator{}).
+		Where("cluster_id = ? AND name = ?", operator.ClusterID, operator.Name).
+		Updates(map[string]interface{}{
+			"status_info":   statusInfo,
+			"retry_pending": false,
+		}).Error
+	return errors.Wrapf(err, "failed to update operator %s of cluster %s", operator.Name, operator.ClusterID)
+}
+
+// reapplySubscriptions deletes the subscriptions of the operator, and the cluster service versions that they
+// installed, and applies the manifests of the operator again, so that OLM installs it from scratch
+func (i *Installer) reapplySubscriptions(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster,
+	operator *models.MonitoredOperator) error {
+	openshiftManifests, _, err := i.operatorsAPI.GenerateOperatorManifests(cluster, operator)
+	if err != nil {
+		return err
+	}
+	objects, err := decodeManifests(openshiftManifests)
+	if err != nil {
+		return err
+	}
+	if err = deleteSubscriptions(ctx, client, subscriptions(objects)); err != nil {
+		return err
+	}
+	return applyObjects(ctx, client, objects)
+}
+
+// operatorStatus checks the cluster service versions installed by the subscriptions of the operator. Once they
+// succeeded, the custom manifests of the operator are applied, as they may need the resources that the operator
+// defines, and the operator is available.
+func (i *Installer) operatorStatus(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, cluster *common.Cluster,
+	operator *models.MonitoredOperator) (models.OperatorStatus, string, string, error) {
+	openshiftManifests, customManifests, err := i.operatorsAPI.GenerateOperatorManifests(cluster, operator)
+	if err != nil {
+		return "", "", "", err
+	}
+	objects, err := decodeManifests(openshiftManifests)
+	if err != nil {
+		return "", "", "", err
+	}
+	operatorSubscriptions := subscriptions(objects)
+	if len(operatorSubscriptions) == 0 {
+		return "", "", "", errors.New("operator has no subscription")
+	}
+
+	var statusInfo, version string
+	for _, manifest := range operatorSubscriptions {
+		subscription := &unstructured.Unstructured{}
+		subscription.SetGroupVersionKind(subscriptionGVK)
+		if err = client.Get(ctx, types.NamespacedName{Namespace: manifest.GetNamespace(), Name: manifest.GetName()}, subscription); err != nil {
+			return "", "", "", err
+		}
+		csvName, _, _ := unstructured.NestedString(subscription.Object, "status", "installedCSV")
+		if csvName == "" {
+			return models.OperatorStatusProgressing, "", "", nil
+		}
+
+		csv := &unstructured.Unstructured{}
+		csv.SetGroupVersionKind(csvGVK)
+		if err = client.Get(ctx, types.NamespacedName{Namespace: manifest.GetNamespace(), Name: csvName}, csv); err != nil {
+			return "", "", "", err
+		}
+		phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+		message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+		switch phase {
+		case csvPhaseSucceeded:
+			// The version of the operator is the version of its main subscription
+			if version == "" || manifest.GetName() == operator.SubscriptionName {
+				version, _, _ = unstructured.NestedString(csv.Object, "spec", "version")
+				statusInfo = message
+			}
+		case csvPhaseFailed:
+			return models.OperatorStatusFailed, message, "", nil
+		default:
+			return models.OperatorStatusProgressing, "", "", nil
+		}
+	}
+
+	customObjects, err := decodeManifests(map[string][]byte{"custom": customManifests})
+	if err != nil {
+		return "", "", "", err
+	}
+	if err = applyObjects(ctx, client, customObjects); err != nil {
+		return "", "", "", err
+	}
+	return models.OperatorStatusAvailable, statusInfo, version, nil
+}
+
+func (i *Installer) updateStatus(ctx context.Context, operator *models.MonitoredOperator, status models.OperatorStatus, statusInfo string, version string) error {
+	updates := map[string]interface{}{
+		"status":            status,
+		"status_info":       statusInfo,
+		"status_updated_at": strfmt.DateTime(time.Now()),
+		"retry_pending":     false,
+	}
+	if version != "" {
+		updates["version"] = version
+	}
+	err := i.db.Model(&models.MonitoredOperator{}).
+		Where("cluster_id = ? AND name = ?", operator.ClusterID, operator.Name).
+		Updates(updates).Error
+	if err != nil {
+		return errors.Wrapf(err, "failed to update operator %s of cluster %s", operator.Name, operator.ClusterID)
+	}
+	eventgen.SendClusterOperatorStatusEvent(ctx, i.eventsHandler, operator.ClusterID, operator.Name, string(status), statusInfo)
+	if status == models.OperatorStatusFailed && operator.Retries > 0 {
+		eventgen.SendClusterOperatorRetriesExhaustedEvent(ctx, i.eventsHandler, operator.ClusterID, operator.Name, operator.Retries)
+	}
+	return nil
+}
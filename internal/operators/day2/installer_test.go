@@ -0,0 +1,522 @@
+package day2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const subscriptionManifest = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: local-storage-operator
+  namespace: openshift-local-storage
+spec:
+  channel: stable
+`
+
+var _ = Describe("Installer", func() {
+	var (
+		ctx               = context.Background()
+		db                *gorm.DB
+		dbName            string
+		ctrl              *gomock.Controller
+		mockOperatorsAPI  *operators.MockAPI
+		mockHWValidator   *hardware.MockValidator
+		mockObjectHandler *s3wrapper.MockAPI
+		mockClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockClient        *spoke_k8s_client.MockSpokeK8sClient
+		mockEvents        *eventsapi.MockHandler
+		installer         *day2.Installer
+		clusterID         strfmt.UUID
+		lsoOperator       *models.MonitoredOperator
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockOperatorsAPI = operators.NewMockAPI(ctrl)
+		mockHWValidator = hardware.NewMockValidator(ctrl)
+		mockObjectHandler = s3wrapper.NewMockAPI(ctrl)
+		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		mockClient = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		installer = day2.NewInstaller(logrus.New(), db, mockOperatorsAPI, mockHWValidator, mockObjectHandler,
+			mockClientFactory, mockEvents, &leader.DummyElector{})
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				Status:           swag.String(models.ClusterStatusInstalled),
+				OpenshiftVersion: "4.14.0",
+				CPUArchitecture:  models.ClusterCPUArchitectureX8664,
+				MonitoredOperators: []*models.MonitoredOperator{
+					{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+				},
+			},
+		}
+		Expect(db.Create(cluster).Error).ToNot(HaveOccurred())
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:                &hostID,
+			ClusterID:         &clusterID,
+			InfraEnvID:        clusterID,
+			Status:            swag.String(models.HostStatusInstalled),
+			Inventory:         common.GenerateTestInventory(),
+			RequestedHostname: "worker-0",
+		}).Error).ToNot(HaveOccurred())
+
+		lsoOperator = &models.MonitoredOperator{
+			Name:             "lso",
+			OperatorType:     models.OperatorTypeOlm,
+			Namespace:        "openshift-local-storage",
+			SubscriptionName: "local-storage-operator",
+			TimeoutSeconds:   70 * 60,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	mockSpokeClient := func() {
+		mockObjectHandler.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/kubeconfig", clusterID)).
+			Return(io.NopCloser(strings.NewReader("kubeconfig")), int64(10), nil)
+		mockClientFactory.EXPECT().CreateFromSecret(nil, gomock.Any()).Return(mockClient, nil)
+	}
+
+	mockManifests := func(customManifests string) {
+		mockOperatorsAPI.EXPECT().GenerateOperatorManifests(gomock.Any(), gomock.Any()).
+			Return(map[string][]byte{"50_openshift-lso_subscription.yaml": []byte(subscriptionManifest)}, []byte(customManifests), nil)
+	}
+
+	getOperator := func() *models.MonitoredOperator {
+		var operator models.MonitoredOperator
+		Expect(db.First(&operator, "cluster_id = ? AND name = ?", clusterID, "lso").Error).ToNot(HaveOccurred())
+		return &operator
+	}
+
+	Context("InstallOperators", func() {
+		params := []*models.OperatorCreateParams{{Name: "lso"}}
+
+		mockPrerequisites := func() {
+			mockOperatorsAPI.EXPECT().GetOperatorByName("lso").Return(lsoOperator, nil)
+			mockOperatorsAPI.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return operators, nil
+				})
+			// Only the properties of the new operators are validated, not the properties of the installed ones
+			mockOperatorsAPI.EXPECT().EnsureOperatorProperties(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) error {
+					Expect(operators).To(HaveLen(1))
+					Expect(operators[0].Name).To(Equal("lso"))
+					return nil
+				})
+			mockOperatorsAPI.EXPECT().SetOperatorPropertiesDefaults(gomock.Any(), gomock.Any()).Return(nil)
+			mockOperatorsAPI.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), "4.14.0", models.ClusterCPUArchitectureX8664, gomock.Any()).Return(nil)
+			mockSpokeClient()
+		}
+
+		mockNodes := func(cpu string, memory string, ready corev1.ConditionStatus) {
+			mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(
+				func(_ context.Context, list ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+					list.(*corev1.NodeList).Items = []corev1.Node{{
+						ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+						Status: corev1.NodeStatus{
+							Capacity: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(cpu),
+								corev1.ResourceMemory: resource.MustParse(memory),
+							},
+							Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+						},
+					}}
+					return nil
+				})
+		}
+
+		mockValidations := func(requirements *models.ClusterHostRequirementsDetails) {
+			mockPrerequisites()
+			mockNodes("16", "16Gi", corev1.ConditionTrue)
+			mockHWValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&models.ClusterHostRequirements{Total: requirements}, nil)
+		}
+
+		It("should apply the manifests of the operators and monitor them", func() {
+			mockValidations(&models.ClusterHostRequirementsDetails{CPUCores: 8, RAMMib: 8192})
+			mockManifests("")
+			mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+
+			installed, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(installed).To(HaveLen(1))
+
+			operator := getOperator()
+			Expect(operator.Day2).To(BeTrue())
+			Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+		})
+
+		It("should reject clusters that aren't installed", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("status", models.ClusterStatusInstalling).Error).ToNot(HaveOccurred())
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+
+		It("should reject operators that are already installed", func() {
+			_, err := installer.InstallOperators(ctx, clusterID, []*models.OperatorCreateParams{{Name: "console"}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		})
+
+		It("should reject operators that the hosts can't run", func() {
+			mockValidations(&models.ClusterHostRequirementsDetails{CPUCores: 32, RAMMib: 8192})
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+			Expect(err.Error()).To(ContainSubstring("node worker-0 requires at least 32 CPU cores"))
+		})
+
+		It("should validate the current capacity of the nodes", func() {
+			mockPrerequisites()
+			mockNodes("4", "16Gi", corev1.ConditionTrue)
+			mockHWValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{CPUCores: 8, RAMMib: 8192}}, nil)
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("node worker-0 requires at least 8 CPU cores for the operators, found only 4"))
+		})
+
+		It("should reject nodes that aren't ready", func() {
+			mockPrerequisites()
+			mockNodes("16", "16Gi", corev1.ConditionFalse)
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+			Expect(err.Error()).To(ContainSubstring("node worker-0 isn't ready"))
+		})
+
+		It("should reject operators that are installed concurrently", func() {
+			mockPrerequisites()
+			mockNodes("16", "16Gi", corev1.ConditionTrue)
+			mockHWValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *common.Cluster, _ *models.Host) (*models.ClusterHostRequirements, error) {
+					// Another request records the operator while this one validates the nodes
+					Expect(db.Create(&models.MonitoredOperator{Name: "lso", ClusterID: clusterID, Day2: true}).Error).ToNot(HaveOccurred())
+					return &models.ClusterHostRequirements{Total: &models.ClusterHostRequirementsDetails{CPUCores: 8, RAMMib: 8192}}, nil
+				})
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+
+		It("should fail the operators whose manifests can't be applied", func() {
+			mockValidations(&models.ClusterHostRequirementsDetails{CPUCores: 8, RAMMib: 8192})
+			mockManifests("")
+			mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+			_, err := installer.InstallOperators(ctx, clusterID, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusInternalServerError)))
+
+			operator := getOperator()
+			Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+			Expect(operator.StatusInfo).To(ContainSubstring("forbidden"))
+		})
+
+		It("should leave the operators whose manifests can't be applied to the Monitor when they can be retried", func() {
+			mockValidations(&models.ClusterHostRequirementsDetails{CPUCores: 8, RAMMib: 8192})
+			mockManifests("")
+			mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriedEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+			_, err := installer.InstallOperators(ctx, clusterID, []*models.OperatorCreateParams{
+				{Name: "lso", FailurePolicy: models.OperatorFailurePolicyRetry},
+			})
+			Expect(err).To(HaveOccurred())
+
+			operator := getOperator()
+			Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(operator.StatusInfo).To(HavePrefix("Waiting to reapply the subscription"))
+			Expect(operator.Retries).To(BeEquivalentTo(1))
+			Expect(operator.RetryPending).To(BeTrue())
+		})
+	})
+
+	Context("Monitor", func() {
+		BeforeEach(func() {
+			lsoOperator.ClusterID = clusterID
+			lsoOperator.Day2 = true
+			lsoOperator.Status = models.OperatorStatusProgressing
+			lsoOperator.StatusUpdatedAt = strfmt.DateTime(time.Now())
+			Expect(db.Create(lsoOperator).Error).ToNot(HaveOccurred())
+		})
+
+		mockCSV := func(phase string) {
+			mockClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator"}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					return unstructured.SetNestedField(obj.(*unstructured.Unstructured).Object, "local-storage-operator.v4.14.0", "status", "installedCSV")
+				})
+			mockClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator.v4.14.0"}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					object := obj.(*unstructured.Unstructured).Object
+					Expect(unstructured.SetNestedField(object, "4.14.0", "spec", "version")).To(Succeed())
+					return unstructured.SetNestedField(object, phase, "status", "phase")
+				})
+		}
+
+		It("should apply the custom manifests once the operator succeeded", func() {
+			mockSpokeClient()
+			mockManifests("apiVersion: local.storage.openshift.io/v1\nkind: LocalVolume\nmetadata:\n  name: local-disks\n")
+			mockCSV("Succeeded")
+			mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any())
+
+			installer.Monitor()
+
+			operator := getOperator()
+			Expect(operator.Status).To(Equal(models.OperatorStatusAvailable))
+			Expect(operator.Version).To(Equal("4.14.0"))
+		})
+
+		It("should fail the operator when its cluster service version failed", func() {
+			mockSpokeClient()
+			mockManifests("")
+			mockCSV("Failed")
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any())
+
+			installer.Monitor()
+
+			Expect(getOperator().Status).To(Equal(models.OperatorStatusFailed))
+		})
+
+		It("should keep the operator progressing while it's installed", func() {
+			mockSpokeClient()
+			mockManifests("")
+			mockCSV("Installing")
+
+			installer.Monitor()
+
+			Expect(getOperator().Status).To(Equal(models.OperatorStatusProgressing))
+		})
+
+		It("should fail the operator when it times out", func() {
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+				Update("status_updated_at", strfmt.DateTime(time.Now().Add(-2*time.Hour))).Error).ToNot(HaveOccurred())
+			mockSpokeClient()
+			mockManifests("")
+			mockCSV("Installing")
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any())
+
+			installer.Monitor()
+
+			operator := getOperator()
+			Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+			Expect(operator.StatusInfo).To(ContainSubstring("wasn't available"))
+		})
+
+		Context("with the retry failure policy", func() {
+			BeforeEach(func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Updates(map[string]interface{}{"failure_policy": models.OperatorFailurePolicyRetry, "max_retries": 2}).Error).ToNot(HaveOccurred())
+			})
+
+			It("should reapply the subscription of the failed operator", func() {
+				mockSpokeClient()
+				mockManifests("")
+				mockCSV("Failed")
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriedEventName),
+					eventstest.WithClusterIdMatcher(clusterID.String())))
+				mockManifests("")
+				mockClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator"}, gomock.Any()).Return(nil)
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				installer.Monitor()
+
+				operator := getOperator()
+				Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+				Expect(operator.Retries).To(BeEquivalentTo(1))
+				Expect(operator.RetryPending).To(BeFalse())
+				Expect(operator.StatusInfo).To(Equal("Reapplying the subscription, attempt 1 of 2"))
+			})
+
+			It("should reapply the subscription of a recorded retry", func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Updates(map[string]interface{}{"retries": 1, "retry_pending": true}).Error).ToNot(HaveOccurred())
+				mockSpokeClient()
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockManifests("")
+				mockClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator"}, gomock.Any()).Return(nil)
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				installer.Monitor()
+
+				operator := getOperator()
+				Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+				Expect(operator.Retries).To(BeEquivalentTo(1))
+				Expect(operator.RetryPending).To(BeFalse())
+				Expect(operator.StatusInfo).To(Equal("Reapplying the subscription, attempt 1 of 2"))
+			})
+
+			It("should only reapply the subscription of a recorded retry of a day-1 operator", func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Updates(map[string]interface{}{"day2": false, "retries": 1, "retry_pending": true}).Error).ToNot(HaveOccurred())
+				mockSpokeClient()
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockManifests("")
+				mockClient.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator"}, gomock.Any()).Return(nil)
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				installer.Monitor()
+				Expect(getOperator().RetryPending).To(BeFalse())
+
+				// The status of the operator is then reported by the controller
+				installer.Monitor()
+				Expect(getOperator().Status).To(Equal(models.OperatorStatusProgressing))
+			})
+
+			It("should fail a recorded retry when the API of the cluster doesn't respond", func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Updates(map[string]interface{}{"retries": 1, "retry_pending": true}).Error).ToNot(HaveOccurred())
+				mockSpokeClient()
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName)))
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriesExhaustedEventName)))
+
+				installer.Monitor()
+
+				operator := getOperator()
+				Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+				Expect(operator.RetryPending).To(BeFalse())
+				Expect(operator.StatusInfo).To(ContainSubstring(operators.ErrClusterUnreachable.Error()))
+			})
+
+			It("should fail the operator when the subscription of a recorded retry can't be reapplied", func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Updates(map[string]interface{}{"retries": 2, "retry_pending": true}).Error).ToNot(HaveOccurred())
+				mockSpokeClient()
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockOperatorsAPI.EXPECT().GenerateOperatorManifests(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("no manifests"))
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName)))
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriesExhaustedEventName)))
+
+				installer.Monitor()
+
+				operator := getOperator()
+				Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+				Expect(operator.StatusInfo).To(Equal("Failed to reapply the subscription: no manifests"))
+			})
+
+			It("should fail the operator once its retries are exhausted", func() {
+				Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+					Update("retries", 2).Error).ToNot(HaveOccurred())
+				mockSpokeClient()
+				mockManifests("")
+				mockCSV("Failed")
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName)))
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriesExhaustedEventName)))
+
+				installer.Monitor()
+
+				Expect(getOperator().Status).To(Equal(models.OperatorStatusFailed))
+			})
+		})
+	})
+
+	Context("RetryOperator", func() {
+		BeforeEach(func() {
+			lsoOperator.ClusterID = clusterID
+			lsoOperator.Status = models.OperatorStatusProgressing
+			lsoOperator.FailurePolicy = models.OperatorFailurePolicyRetry
+			Expect(db.Create(lsoOperator).Error).ToNot(HaveOccurred())
+		})
+
+		It("should record the retry for the monitor to reapply the subscription", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriedEventName)))
+
+			retried, err := installer.RetryOperator(ctx, clusterID, "lso", "csv failed")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried).To(BeTrue())
+
+			operator := getOperator()
+			Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(operator.Retries).To(BeEquivalentTo(1))
+			Expect(operator.RetryPending).To(BeTrue())
+			Expect(operator.StatusInfo).To(Equal("Waiting to reapply the subscription, attempt 1 of 3"))
+		})
+
+		It("should record a single retry for repeated reports of the failure", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriedEventName))).Times(1)
+
+			for j := 0; j < 2; j++ {
+				retried, err := installer.RetryOperator(ctx, clusterID, "lso", "csv failed")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retried).To(BeTrue())
+			}
+			Expect(getOperator().Retries).To(BeEquivalentTo(1))
+		})
+
+		It("should not retry operators whose retries are exhausted", func() {
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", clusterID, "lso").
+				Update("retries", operators.DefaultMaxRetries).Error).ToNot(HaveOccurred())
+			retried, err := installer.RetryOperator(ctx, clusterID, "lso", "csv failed")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried).To(BeFalse())
+			Expect(getOperator().RetryPending).To(BeFalse())
+		})
+
+		It("should reject unknown operators", func() {
+			_, err := installer.RetryOperator(ctx, clusterID, "unknown", "csv failed")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+		})
+	})
+})
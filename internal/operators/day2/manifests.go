@@ -0,0 +1,118 @@
+package day2
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+var (
+	subscriptionGVK = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "Subscription"}
+	csvGVK          = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "ClusterServiceVersion"}
+
+	documentSplitter = regexp.MustCompile(`(?m)^---[ \t]*\n`)
+)
+
+// decodeManifests decodes the objects of manifests, that may contain several YAML documents. The namespaces come
+// first, so that the objects can be created in them, and then the rest of the objects in the order of the names of
+// the manifests.
+func decodeManifests(manifests map[string][]byte) ([]*unstructured.Unstructured, error) {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var objects []*unstructured.Unstructured
+	for _, name := range names {
+		decoded, err := decodeManifest(manifests[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode manifest %s", name)
+		}
+		objects = append(objects, decoded...)
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].GetKind() == "Namespace" && objects[j].GetKind() != "Namespace"
+	})
+	return objects, nil
+}
+
+func decodeManifest(content []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, document := range documentSplitter.Split(string(content), -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		var object map[string]interface{}
+		if err := k8syaml.Unmarshal([]byte(document), &object); err != nil {
+			return nil, err
+		}
+		// Documents that only have comments are empty
+		if len(object) == 0 {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: object})
+	}
+	return objects, nil
+}
+
+// subscriptions returns the subscriptions among the objects
+func subscriptions(objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	var ret []*unstructured.Unstructured
+	for _, object := range objects {
+		if object.GroupVersionKind() == subscriptionGVK {
+			ret = append(ret, object)
+		}
+	}
+	return ret
+}
+
+// applyObjects creates the objects in the cluster. Objects that already exist are kept as is, so that the manifests
+// can be applied again when a previous attempt failed.
+func applyObjects(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, objects []*unstructured.Unstructured) error {
+	for _, object := range objects {
+		err := client.Create(ctx, object.DeepCopy())
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "failed to create %s %s", object.GetKind(), object.GetName())
+		}
+	}
+	return nil
+}
+
+// deleteSubscriptions deletes the subscriptions, and the cluster service versions that they installed, from the
+// cluster. Objects that don't exist are ignored.
+func deleteSubscriptions(ctx context.Context, client spoke_k8s_client.SpokeK8sClient, manifests []*unstructured.Unstructured) error {
+	for _, manifest := range manifests {
+		subscription := &unstructured.Unstructured{}
+		subscription.SetGroupVersionKind(subscriptionGVK)
+		err := client.Get(ctx, types.NamespacedName{Namespace: manifest.GetNamespace(), Name: manifest.GetName()}, subscription)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get subscription %s", manifest.GetName())
+		}
+		if csvName, _, _ := unstructured.NestedString(subscription.Object, "status", "installedCSV"); csvName != "" {
+			csv := &unstructured.Unstructured{}
+			csv.SetGroupVersionKind(csvGVK)
+			csv.SetNamespace(manifest.GetNamespace())
+			csv.SetName(csvName)
+			if err = client.Delete(ctx, csv); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to delete cluster service version %s", csvName)
+			}
+		}
+		if err = client.Delete(ctx, subscription); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete subscription %s", manifest.GetName())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package day2
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	namespaceManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openshift-local-storage
+`
+	operatorManifest = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: local-operator-group
+  namespace: openshift-local-storage
+---
+# The subscription of the operator
+apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: local-storage-operator
+  namespace: openshift-local-storage
+spec:
+  channel: stable
+---
+# Nothing here
+`
+)
+
+var _ = Describe("Manifests", func() {
+	It("should decode the objects of the manifests with the namespaces first", func() {
+		objects, err := decodeManifests(map[string][]byte{
+			"50_openshift-lso_operator.yaml": []byte(operatorManifest),
+			"50_openshift-lso_ns.yaml":       []byte(namespaceManifest),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(3))
+		Expect(objects[0].GetKind()).To(Equal("Namespace"))
+		Expect(objects[1].GetKind()).To(Equal("OperatorGroup"))
+		Expect(objects[2].GetKind()).To(Equal("Subscription"))
+	})
+
+	It("should fail to decode invalid manifests", func() {
+		_, err := decodeManifests(map[string][]byte{"invalid.yaml": []byte("kind: [")})
+		Expect(err).To(MatchError(ContainSubstring("invalid.yaml")))
+	})
+
+	It("should find the subscriptions", func() {
+		objects, err := decodeManifests(map[string][]byte{"operator.yaml": []byte(operatorManifest)})
+		Expect(err).ToNot(HaveOccurred())
+		found := subscriptions(objects)
+		Expect(found).To(HaveLen(1))
+		Expect(found[0].GetName()).To(Equal("local-storage-operator"))
+		Expect(found[0].GetNamespace()).To(Equal("openshift-local-storage"))
+	})
+
+	Context("applyObjects", func() {
+		var (
+			ctrl    *gomock.Controller
+			client  *spoke_k8s_client.MockSpokeK8sClient
+			objects []*unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			client = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+			var err error
+			objects, err = decodeManifests(map[string][]byte{"operator.yaml": []byte(operatorManifest)})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should keep the objects that already exist", func() {
+			alreadyExists := apierrors.NewAlreadyExists(schema.GroupResource{Group: "operators.coreos.com", Resource: "operatorgroups"}, "local-operator-group")
+			client.EXPECT().Create(gomock.Any(), gomock.Any()).Return(alreadyExists)
+			client.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			Expect(applyObjects(context.Background(), client, objects)).To(Succeed())
+		})
+
+		It("should fail when an object can't be created", func() {
+			client.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+			err := applyObjects(context.Background(), client, objects)
+			Expect(err).To(MatchError("failed to create OperatorGroup local-operator-group: forbidden"))
+		})
+	})
+
+	Context("deleteSubscriptions", func() {
+		var (
+			ctrl          *gomock.Controller
+			client        *spoke_k8s_client.MockSpokeK8sClient
+			subscriptions []*unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			client = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+			objects, err := decodeManifests(map[string][]byte{"operator.yaml": []byte(operatorManifest)})
+			Expect(err).ToNot(HaveOccurred())
+			subscriptions = objects[1:]
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		mockSubscription := func(installedCSV string) {
+			client.EXPECT().Get(gomock.Any(), ctrlclient.ObjectKey{Namespace: "openshift-local-storage", Name: "local-storage-operator"}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					obj.SetNamespace(key.Namespace)
+					obj.SetName(key.Name)
+					if installedCSV == "" {
+						return nil
+					}
+					return unstructured.SetNestedField(obj.(*unstructured.Unstructured).Object, installedCSV, "status", "installedCSV")
+				})
+		}
+
+		It("should delete the subscription and its cluster service version", func() {
+			mockSubscription("local-storage-operator.v4.14.0")
+			client.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj ctrlclient.Object, _ ...ctrlclient.DeleteOption) error {
+				Expect(obj.GetObjectKind().GroupVersionKind()).To(Equal(csvGVK))
+				Expect(obj.GetName()).To(Equal("local-storage-operator.v4.14.0"))
+				return nil
+			})
+			client.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj ctrlclient.Object, _ ...ctrlclient.DeleteOption) error {
+				Expect(obj.GetObjectKind().GroupVersionKind()).To(Equal(subscriptionGVK))
+				Expect(obj.GetName()).To(Equal("local-storage-operator"))
+				return nil
+			})
+			Expect(deleteSubscriptions(context.Background(), client, subscriptions)).To(Succeed())
+		})
+
+		It("should only delete the subscription when it didn't install a cluster service version", func() {
+			mockSubscription("")
+			client.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			Expect(deleteSubscriptions(context.Background(), client, subscriptions)).To(Succeed())
+		})
+
+		It("should ignore subscriptions that don't exist", func() {
+			notFound := apierrors.NewNotFound(schema.GroupResource{Group: "operators.coreos.com", Resource: "subscriptions"}, "local-storage-operator")
+			client.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+			Expect(deleteSubscriptions(context.Background(), client, subscriptions)).To(Succeed())
+		})
+
+		It("should fail when the subscription can't be deleted", func() {
+			mockSubscription("")
+			client.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+			err := deleteSubscriptions(context.Background(), client, subscriptions)
+			Expect(err).To(MatchError("failed to delete subscription local-storage-operator: forbidden"))
+		})
+	})
+})
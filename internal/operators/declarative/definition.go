@@ -0,0 +1,244 @@
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefinitionFileName is the name of the file that defines an operator in its directory
+	DefinitionFileName = "operator.yaml"
+
+	// TemplatesDirName is the name of the directory, next to the definition, that contains the manifest templates of
+	// an operator. Templates in its openshift sub-directory are added to the installation manifests, and templates
+	// in its custom sub-directory are applied once the operator is installed.
+	TemplatesDirName = "templates"
+
+	defaultTimeoutSeconds  = 60 * 60
+	defaultSource          = "redhat-operators"
+	defaultSourceNamespace = "openshift-marketplace"
+	globalNamespace        = "openshift-operators"
+)
+
+var (
+	nameRegex             = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	featureSupportIDRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+	supportLevels = []models.SupportLevel{
+		models.SupportLevelSupported,
+		models.SupportLevelTechPreview,
+		models.SupportLevelDevPreview,
+	}
+
+	cpuArchitectures = []string{
+		models.ClusterCPUArchitectureX8664,
+		models.ClusterCPUArchitectureArm64,
+		models.ClusterCPUArchitectureS390x,
+		models.ClusterCPUArchitecturePpc64le,
+		models.ClusterCPUArchitectureMulti,
+	}
+)
+
+// Definition describes an OLM operator that is installed like the operators that the service has plugins for
+type Definition struct {
+	// Name is the name of the operator in the API, e.g. in the olm_operators of a cluster
+	Name string `json:"name"`
+	// FullName is the user friendly name of the operator
+	FullName string `json:"full_name"`
+	// FeatureSupportID is the ID of the operator in the feature support levels
+	FeatureSupportID models.FeatureSupportLevelID `json:"feature_support_id"`
+	// SupportLevel is the support level of the operator, dev-preview by default
+	SupportLevel models.SupportLevel `json:"support_level,omitempty"`
+	// Namespace is the namespace that the operator is installed in
+	Namespace string `json:"namespace"`
+	// ClusterMonitoring labels the namespace of the operator to be scraped by the cluster monitoring
+	ClusterMonitoring bool `json:"cluster_monitoring,omitempty"`
+	// Subscription describes the OLM subscription of the operator
+	Subscription Subscription `json:"subscription"`
+	// TimeoutSeconds is how long the installation waits for the operator to be available, an hour by default
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+	// FailurePolicy is what a failure of the operator during the installation means for the cluster, best-effort
+	// by default
+	FailurePolicy models.OperatorFailurePolicy `json:"failure_policy,omitempty"`
+	// MaxRetries is how many times the subscription is reapplied when the failure policy is retry
+	MaxRetries int64 `json:"max_retries,omitempty"`
+	// Dependencies are the names of the operators that the operator requires
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Architectures are the CPU architectures that the operator supports, all of them when empty
+	Architectures []string `json:"architectures,omitempty"`
+	// OpenshiftVersion is the range of OpenShift versions that the operator supports
+	OpenshiftVersion VersionRange `json:"openshift_version,omitempty"`
+	// Bundles are the IDs of the bundles that the operator is a member of
+	Bundles []string `json:"bundles,omitempty"`
+	// Requirements are the additional resources that the operator requires from the hosts, per role
+	Requirements Requirements `json:"requirements,omitempty"`
+
+	// templatesDir is the directory of the manifest templates of the operator, if it has any
+	templatesDir string
+}
+
+// Subscription describes the OLM subscription of an operator
+type Subscription struct {
+	// Name is the name of the subscription, the package name by default
+	Name string `json:"name,omitempty"`
+	// Package is the name of the operator package in the catalog
+	Package string `json:"package"`
+	// Channel is the channel of the operator package
+	Channel string `json:"channel"`
+	// Source is the catalog source of the operator package, redhat-operators by default
+	Source string `json:"source,omitempty"`
+	// SourceNamespace is the namespace of the catalog source, openshift-marketplace by default
+	SourceNamespace string `json:"source_namespace,omitempty"`
+}
+
+// VersionRange is an inclusive range of OpenShift versions. The maximum is a minor version, so that all of its patch
+// versions are in the range.
+type VersionRange struct {
+	Min string `json:"min,omitempty"`
+	Max string `json:"max,omitempty"`
+}
+
+// Requirements are the resources that an operator requires from the hosts, in addition to the requirements of
+// OpenShift. Masters requirements apply to single node clusters as well.
+type Requirements struct {
+	Master models.ClusterHostRequirementsDetails `json:"master,omitempty"`
+	Worker models.ClusterHostRequirementsDetails `json:"worker,omitempty"`
+}
+
+// LoadDefinitions loads the definitions from the sub-directories of the directory. Every sub-directory contains the
+// definition of one operator.
+func LoadDefinitions(dir string) ([]*Definition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the operator definitions directory %s", dir)
+	}
+	var definitions []*Definition
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		definition, err := LoadDefinition(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+// LoadDefinition loads and validates the definition of an operator from its directory
+func LoadDefinition(dir string) (*Definition, error) {
+	path := filepath.Join(dir, DefinitionFileName)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the operator definition %s", path)
+	}
+	definition, err := ParseDefinition(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid operator definition %s", path)
+	}
+	templatesDir := filepath.Join(dir, TemplatesDirName)
+	if _, err = os.Stat(templatesDir); err == nil {
+		definition.templatesDir = templatesDir
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read the templates directory %s", templatesDir)
+	}
+	return definition, nil
+}
+
+// ParseDefinition parses and validates the definition of an operator, and fills the defaults of the fields that
+// aren't set
+func ParseDefinition(content []byte) (*Definition, error) {
+	var definition Definition
+	if err := yaml.UnmarshalStrict(content, &definition); err != nil {
+		return nil, err
+	}
+	definition.setDefaults()
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+func (d *Definition) setDefaults() {
+	if d.FullName == "" {
+		d.FullName = d.Name
+	}
+	if d.SupportLevel == "" {
+		d.SupportLevel = models.SupportLevelDevPreview
+	}
+	if d.TimeoutSeconds == 0 {
+		d.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	if d.Subscription.Name == "" {
+		d.Subscription.Name = d.Subscription.Package
+	}
+	if d.Subscription.Source == "" {
+		d.Subscription.Source = defaultSource
+	}
+	if d.Subscription.SourceNamespace == "" {
+		d.Subscription.SourceNamespace = defaultSourceNamespace
+	}
+}
+
+func (d *Definition) validate() error {
+	if !nameRegex.MatchString(d.Name) {
+		return fmt.Errorf("name '%s' must consist of lower case alphanumeric characters or '-'", d.Name)
+	}
+	if !featureSupportIDRegex.MatchString(string(d.FeatureSupportID)) {
+		return fmt.Errorf("feature support ID '%s' must consist of upper case alphanumeric characters or '_'", d.FeatureSupportID)
+	}
+	if !slices.Contains(supportLevels, d.SupportLevel) {
+		return fmt.Errorf("support level '%s' must be one of %v", d.SupportLevel, supportLevels)
+	}
+	if !nameRegex.MatchString(d.Namespace) {
+		return fmt.Errorf("namespace '%s' is not a valid namespace name", d.Namespace)
+	}
+	if d.Subscription.Package == "" || d.Subscription.Channel == "" {
+		return errors.New("subscription package and channel are required")
+	}
+	if d.TimeoutSeconds < 0 {
+		return errors.New("timeout must be positive")
+	}
+	if d.FailurePolicy != "" {
+		if err := d.FailurePolicy.Validate(nil); err != nil {
+			return errors.Wrap(err, "invalid failure policy")
+		}
+	}
+	if d.MaxRetries < 0 {
+		return errors.New("max retries must be positive")
+	}
+	if d.MaxRetries != 0 && d.FailurePolicy != models.OperatorFailurePolicyRetry {
+		return fmt.Errorf("max retries can only be set with the %s failure policy", models.OperatorFailurePolicyRetry)
+	}
+	for _, architecture := range d.Architectures {
+		if !slices.Contains(cpuArchitectures, architecture) {
+			return fmt.Errorf("architecture '%s' must be one of %v", architecture, cpuArchitectures)
+		}
+	}
+	for _, version := range []string{d.OpenshiftVersion.Min, d.OpenshiftVersion.Max} {
+		if version != "" && common.GetVersionFormat(version) == common.NoneVersion {
+			return fmt.Errorf("OpenShift version '%s' is not valid", version)
+		}
+	}
+	for _, bundle := range d.Bundles {
+		if !slices.ContainsFunc(operatorscommon.Bundles, func(b *models.Bundle) bool { return b.ID == bundle }) {
+			return fmt.Errorf("bundle '%s' doesn't exist", bundle)
+		}
+	}
+	for _, dependency := range d.Dependencies {
+		if dependency == d.Name {
+			return errors.New("operator can't depend on itself")
+		}
+	}
+	return nil
+}
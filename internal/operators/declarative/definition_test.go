@@ -0,0 +1,151 @@
+package declarative
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+const minimalDefinition = `
+name: example
+feature_support_id: EXAMPLE
+namespace: example-operator
+subscription:
+  package: example-operator
+  channel: stable
+`
+
+var _ = Describe("Definition", func() {
+	It("should fill the defaults", func() {
+		definition, err := ParseDefinition([]byte(minimalDefinition))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definition.FullName).To(Equal("example"))
+		Expect(definition.SupportLevel).To(Equal(models.SupportLevelDevPreview))
+		Expect(definition.TimeoutSeconds).To(BeEquivalentTo(60 * 60))
+		Expect(definition.Subscription).To(Equal(Subscription{
+			Name:            "example-operator",
+			Package:         "example-operator",
+			Channel:         "stable",
+			Source:          "redhat-operators",
+			SourceNamespace: "openshift-marketplace",
+		}))
+	})
+
+	It("should parse all the fields", func() {
+		definition, err := ParseDefinition([]byte(`
+name: example
+full_name: Example Operator
+feature_support_id: EXAMPLE
+support_level: tech-preview
+namespace: example-operator
+cluster_monitoring: true
+subscription:
+  name: example
+  package: example-operator
+  channel: stable
+  source: community-operators
+  source_namespace: marketplace
+timeout_seconds: 600
+dependencies:
+- lso
+architectures:
+- x86_64
+- arm64
+openshift_version:
+  min: "4.14"
+  max: "4.18"
+failure_policy: retry
+max_retries: 2
+bundles:
+- virtualization
+requirements:
+  master:
+    cpu_cores: 2
+    ram_mib: 1024
+  worker:
+    cpu_cores: 1
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definition.FullName).To(Equal("Example Operator"))
+		Expect(definition.SupportLevel).To(Equal(models.SupportLevelTechPreview))
+		Expect(definition.ClusterMonitoring).To(BeTrue())
+		Expect(definition.Subscription.Name).To(Equal("example"))
+		Expect(definition.Subscription.Source).To(Equal("community-operators"))
+		Expect(definition.Subscription.SourceNamespace).To(Equal("marketplace"))
+		Expect(definition.TimeoutSeconds).To(BeEquivalentTo(600))
+		Expect(definition.FailurePolicy).To(Equal(models.OperatorFailurePolicyRetry))
+		Expect(definition.MaxRetries).To(BeEquivalentTo(2))
+		Expect(definition.Dependencies).To(Equal([]string{"lso"}))
+		Expect(definition.Architectures).To(Equal([]string{"x86_64", "arm64"}))
+		Expect(definition.OpenshiftVersion).To(Equal(VersionRange{Min: "4.14", Max: "4.18"}))
+		Expect(definition.Bundles).To(Equal([]string{"virtualization"}))
+		Expect(definition.Requirements.Master).To(Equal(models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 1024}))
+		Expect(definition.Requirements.Worker).To(Equal(models.ClusterHostRequirementsDetails{CPUCores: 1}))
+	})
+
+	DescribeTable("should reject invalid definitions", func(content, expectedError string) {
+		_, err := ParseDefinition([]byte(content))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(expectedError))
+	},
+		Entry("unknown field", minimalDefinition+"unknown: true\n", "unknown"),
+		Entry("invalid name", "name: Example\n", "name 'Example'"),
+		Entry("invalid feature support ID", "name: example\nfeature_support_id: example\n", "feature support ID 'example'"),
+		Entry("invalid support level", minimalDefinition+"support_level: ga\n", "support level 'ga'"),
+		Entry("missing namespace", "name: example\nfeature_support_id: EXAMPLE\n", "namespace ''"),
+		Entry("missing channel", "name: example\nfeature_support_id: EXAMPLE\nnamespace: example\nsubscription:\n  package: example\n", "package and channel"),
+		Entry("negative timeout", minimalDefinition+"timeout_seconds: -1\n", "timeout"),
+		Entry("invalid failure policy", minimalDefinition+"failure_policy: ignore\n", "failure policy"),
+		Entry("max retries without retry policy", minimalDefinition+"failure_policy: required\nmax_retries: 2\n", "max retries"),
+		Entry("invalid architecture", minimalDefinition+"architectures:\n- amd64\n", "architecture 'amd64'"),
+		Entry("invalid version", minimalDefinition+"openshift_version:\n  min: latest\n", "version 'latest'"),
+		Entry("unknown bundle", minimalDefinition+"bundles:\n- unknown\n", "bundle 'unknown'"),
+		Entry("self dependency", minimalDefinition+"dependencies:\n- example\n", "itself"),
+	)
+
+	Context("loading", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "declarative-operators")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should load a definition from every sub-directory", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "example", TemplatesDirName), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "example", DefinitionFileName), []byte(minimalDefinition), 0o600)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(dir, "other"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "other", DefinitionFileName),
+				[]byte("name: other\nfeature_support_id: OTHER\nnamespace: other\nsubscription:\n  package: other\n  channel: stable\n"), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0o600)).To(Succeed())
+
+			definitions, err := LoadDefinitions(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0].Name).To(Equal("example"))
+			Expect(definitions[0].templatesDir).To(Equal(filepath.Join(dir, "example", TemplatesDirName)))
+			Expect(definitions[1].Name).To(Equal("other"))
+			Expect(definitions[1].templatesDir).To(BeEmpty())
+		})
+
+		It("should fail when a definition is missing", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "example"), 0o755)).To(Succeed())
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail when the directory doesn't exist", func() {
+			_, err := LoadDefinitions(filepath.Join(dir, "missing"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
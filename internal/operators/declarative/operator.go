@@ -0,0 +1,307 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"text/template"
+
+	"github.com/lib/pq"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/templating"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Declarative operators share their validations. The operators manager merges their results, so that the state
+// machines can require them regardless of which declarative operators are configured.
+const (
+	ClusterValidationID = string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)
+	HostValidationID    = string(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied)
+)
+
+// operator is an OLM operator plugin that is driven by a definition; it implements api.Operator
+type operator struct {
+	log                  logrus.FieldLogger
+	definition           *Definition
+	monitoredOperator    models.MonitoredOperator
+	feature              *featuresupport.OperatorFeature
+	dependencyFeatureIDs []models.FeatureSupportLevelID
+	templates            *template.Template
+	customTemplatesRoot  fs.FS
+	customTemplates      *template.Template
+}
+
+// LoadOperators creates the operators that are defined in the sub-directories of the directory. Nothing is loaded
+// when the directory isn't set. The operators may depend on each other and on the given operators.
+func LoadOperators(log logrus.FieldLogger, dir string, operators []api.Operator) ([]api.Operator, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	definitions, err := LoadDefinitions(dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewOperators(log, definitions, operators)
+}
+
+// NewOperators creates the operators of the definitions, and registers their feature support levels
+func NewOperators(log logrus.FieldLogger, definitions []*Definition, operators []api.Operator) ([]api.Operator, error) {
+	featureIDs := make(map[string]models.FeatureSupportLevelID)
+	for _, o := range operators {
+		featureIDs[o.GetName()] = o.GetFeatureSupportID()
+	}
+	for _, definition := range definitions {
+		if _, ok := featureIDs[definition.Name]; ok {
+			return nil, fmt.Errorf("operator %s is already defined", definition.Name)
+		}
+		featureIDs[definition.Name] = definition.FeatureSupportID
+	}
+
+	result := make([]api.Operator, 0, len(definitions))
+	for _, definition := range definitions {
+		o, err := newOperator(log, definition, featureIDs)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, o)
+	}
+	return result, nil
+}
+
+func newOperator(log logrus.FieldLogger, definition *Definition, featureIDs map[string]models.FeatureSupportLevelID) (*operator, error) {
+	o := &operator{
+		log:        log.WithField("operator", definition.Name),
+		definition: definition,
+		monitoredOperator: models.MonitoredOperator{
+			Name:             definition.Name,
+			Namespace:        definition.Namespace,
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: definition.Subscription.Name,
+			TimeoutSeconds:   definition.TimeoutSeconds,
+			FailurePolicy:    definition.FailurePolicy,
+			MaxRetries:       definition.MaxRetries,
+			Bundles:          pq.StringArray(definition.Bundles),
+		},
+		feature: &featuresupport.OperatorFeature{
+			ID:                  definition.FeatureSupportID,
+			Name:                definition.FullName,
+			OperatorName:        definition.Name,
+			SupportLevel:        definition.SupportLevel,
+			Architectures:       definition.Architectures,
+			MinOpenshiftVersion: definition.OpenshiftVersion.Min,
+			MaxOpenshiftVersion: definition.OpenshiftVersion.Max,
+		},
+	}
+
+	for _, dependency := range definition.Dependencies {
+		featureID, ok := featureIDs[dependency]
+		if !ok {
+			return nil, fmt.Errorf("operator %s depends on operator %s, which isn't defined", definition.Name, dependency)
+		}
+		o.dependencyFeatureIDs = append(o.dependencyFeatureIDs, featureID)
+	}
+
+	var err error
+	o.templates, err = templating.LoadTemplates(templatesRoot)
+	if err != nil {
+		return nil, err
+	}
+	if definition.templatesDir != "" {
+		o.customTemplatesRoot = os.DirFS(definition.templatesDir)
+		o.customTemplates, err = templating.LoadTemplates(o.customTemplatesRoot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the templates of operator %s", definition.Name)
+		}
+	}
+
+	if err = featuresupport.RegisterOperatorFeature(o.feature); err != nil {
+		return nil, errors.Wrapf(err, "failed to register the feature of operator %s", definition.Name)
+	}
+	return o, nil
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+// GetFullName reports the full name of the specified Operator
+func (o *operator) GetFullName() string {
+	return o.definition.FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(_ *common.Cluster) []string {
+	return append([]string{}, o.definition.Dependencies...)
+}
+
+// GetDependenciesFeatureSupportID provides the feature support IDs of the dependencies of the Operator
+func (o *operator) GetDependenciesFeatureSupportID() []models.FeatureSupportLevelID {
+	return append([]models.FeatureSupportLevelID{}, o.dependencyFeatureIDs...)
+}
+
+// GetClusterValidationIDs returns cluster validation IDs for the Operator
+func (o *operator) GetClusterValidationIDs() []string {
+	return []string{ClusterValidationID}
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return HostValidationID
+}
+
+// ValidateCluster verifies whether this operator is valid for given cluster
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) ([]api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: ClusterValidationID,
+	}
+
+	if !featuresupport.IsFeatureCompatibleWithArchitecture(o.feature.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture) {
+		result.Status = api.Failure
+		result.Reasons = []string{fmt.Sprintf("%s is not supported for %s CPU architecture.", o.GetFullName(), cluster.CPUArchitecture)}
+		return []api.ValidationResult{result}, nil
+	}
+
+	if !o.feature.SupportsOpenshiftVersion(cluster.OpenshiftVersion) {
+		result.Status = api.Failure
+		result.Reasons = []string{fmt.Sprintf("%s is not supported for openshift version %s.", o.GetFullName(), cluster.OpenshiftVersion)}
+		return []api.ValidationResult{result}, nil
+	}
+
+	return []api.ValidationResult{result}, nil
+}
+
+// ValidateHost returns validationResult based on node type requirements such as memory and cpu
+func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, host *models.Host, _ *models.ClusterHostRequirementsDetails) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	requirements, err := o.GetHostRequirements(ctx, cluster, host)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	if inventory.CPU.Count < requirements.CPUCores {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{fmt.Sprintf("Insufficient CPU to deploy %s. Required CPU count is %d but found %d", o.GetFullName(), requirements.CPUCores, inventory.CPU.Count)}}, nil
+	}
+
+	if inventory.Memory.UsableBytes < conversions.MibToBytes(requirements.RAMMib) {
+		usableMemory := conversions.BytesToMib(inventory.Memory.UsableBytes)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{fmt.Sprintf("Insufficient memory to deploy %s. Required memory is %d MiB but found %d MiB", o.GetFullName(), requirements.RAMMib, usableMemory)}}, nil
+	}
+
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+}
+
+// GenerateManifests generates manifests for the operator
+func (o *operator) GenerateManifests(_ *common.Cluster) (map[string][]byte, []byte, error) {
+	openshiftManifests := map[string][]byte{}
+	templateNames := []string{"openshift/subscription.yaml"}
+	if o.definition.Namespace != globalNamespace {
+		templateNames = append(templateNames, "openshift/ns.yaml", "openshift/operator_group.yaml")
+	}
+	for _, templateName := range templateNames {
+		content, err := operatorscommon.ExecuteTemplate(templateName, o.templates, o.definition, &o.monitoredOperator)
+		if err != nil {
+			return nil, nil, err
+		}
+		openshiftManifests[o.manifestName(path.Base(templateName))] = content
+	}
+
+	if o.customTemplatesRoot == nil {
+		return openshiftManifests, nil, nil
+	}
+	customOpenshiftManifests, customManifests, err := operatorscommon.GenerateManifests(o.customTemplatesRoot, o.customTemplates,
+		o.definition, &o.monitoredOperator)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, content := range customOpenshiftManifests {
+		openshiftManifests[o.manifestName(name)] = content
+	}
+	return openshiftManifests, customManifests, nil
+}
+
+// manifestName prefixes the name of a manifest with the name of the operator, so that the manifests of different
+// declarative operators don't overwrite each other
+func (o *operator) manifestName(name string) string {
+	return fmt.Sprintf("50_%s_%s", o.definition.Name, name)
+}
+
+// GetProperties provides description of operator properties: none required
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the Operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &o.monitoredOperator
+}
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	role := common.GetEffectiveRole(host)
+	if role == models.HostRoleArbiter {
+		return &models.ClusterHostRequirementsDetails{}, nil
+	}
+
+	preflightRequirements := o.GetPreflightRequirements(ctx, cluster)
+	if role == models.HostRoleMaster || common.IsSingleNodeCluster(cluster) {
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	}
+	return preflightRequirements.Requirements.Worker.Quantitative, nil
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.Cluster) *models.OperatorHardwareRequirements {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(cluster),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: hardwareRequirements(o.definition.Requirements.Master),
+			Worker: hardwareRequirements(o.definition.Requirements.Worker),
+		},
+	}
+}
+
+func hardwareRequirements(requirements models.ClusterHostRequirementsDetails) *models.HostTypeHardwareRequirements {
+	var qualitative []string
+	if requirements.RAMMib > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d MiB of additional RAM", requirements.RAMMib))
+	}
+	if requirements.CPUCores > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d additional CPUs", requirements.CPUCores))
+	}
+	if requirements.DiskSizeGb > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d GB of additional installation disk space", requirements.DiskSizeGb))
+	}
+	return &models.HostTypeHardwareRequirements{
+		Qualitative:  qualitative,
+		Quantitative: &requirements,
+	}
+}
+
+// GetFeatureSupportID returns the operator unique feature-support ID
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return o.feature.ID
+}
+
+// GetBundleLabels returns the bundle labels for the operator
+func (o *operator) GetBundleLabels(_ []models.FeatureSupportLevelID) []string {
+	return []string(o.monitoredOperator.Bundles)
+}
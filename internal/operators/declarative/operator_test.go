@@ -0,0 +1,245 @@
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Declarative operator", func() {
+	var (
+		log        = logrus.New()
+		definition *Definition
+		cluster    *common.Cluster
+	)
+
+	newTestOperator := func() api.Operator {
+		operators, err := NewOperators(log, []*Definition{definition}, []api.Operator{lso.NewLSOperator()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(1))
+		return operators[0]
+	}
+
+	BeforeEach(func() {
+		var err error
+		definition, err = ParseDefinition([]byte(`
+name: example
+full_name: Example Operator
+feature_support_id: DECLARATIVE_EXAMPLE
+namespace: example-operator
+subscription:
+  package: example-operator
+  channel: stable
+dependencies:
+- lso
+architectures:
+- x86_64
+openshift_version:
+  min: "4.14"
+  max: "4.16"
+bundles:
+- virtualization
+requirements:
+  master:
+    cpu_cores: 2
+    ram_mib: 2048
+  worker:
+    cpu_cores: 1
+    ram_mib: 1024
+`))
+		Expect(err).ToNot(HaveOccurred())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:  "4.15.0",
+			CPUArchitecture:   models.ClusterCPUArchitectureX8664,
+			ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode,
+		}}
+	})
+
+	It("should be described by its definition", func() {
+		operator := newTestOperator()
+		Expect(operator.GetName()).To(Equal("example"))
+		Expect(operator.GetFullName()).To(Equal("Example Operator"))
+		Expect(operator.GetFeatureSupportID()).To(BeEquivalentTo("DECLARATIVE_EXAMPLE"))
+		Expect(operator.GetDependencies(cluster)).To(Equal([]string{lso.Operator.Name}))
+		Expect(operator.GetDependenciesFeatureSupportID()).To(Equal([]models.FeatureSupportLevelID{models.FeatureSupportLevelIDLSO}))
+		Expect(operator.GetClusterValidationIDs()).To(Equal([]string{string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied)}))
+		Expect(operator.GetHostValidationID()).To(Equal(string(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied)))
+		Expect(operator.GetBundleLabels(nil)).To(Equal([]string{"virtualization"}))
+		Expect(*operator.GetMonitoredOperator()).To(Equal(models.MonitoredOperator{
+			Name:             "example",
+			Namespace:        "example-operator",
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: "example-operator",
+			TimeoutSeconds:   60 * 60,
+			Bundles:          []string{"virtualization"},
+		}))
+	})
+
+	It("should register its feature", func() {
+		newTestOperator()
+		supportLevel := func(openshiftVersion, cpuArchitecture string) models.SupportLevel {
+			for _, feature := range featuresupport.GetFeatureSupportList(openshiftVersion, &cpuArchitecture, nil, nil) {
+				if feature.FeatureSupportLevelID == "DECLARATIVE_EXAMPLE" {
+					return feature.SupportLevel
+				}
+			}
+			return ""
+		}
+		Expect(supportLevel("4.15", models.ClusterCPUArchitectureX8664)).To(Equal(models.SupportLevelDevPreview))
+		Expect(supportLevel("4.15", models.ClusterCPUArchitectureArm64)).To(Equal(models.SupportLevelUnavailable))
+		Expect(supportLevel("4.17", models.ClusterCPUArchitectureX8664)).To(Equal(models.SupportLevelUnavailable))
+	})
+
+	It("should fail to be created with an unknown dependency", func() {
+		definition.Dependencies = []string{"unknown"}
+		_, err := NewOperators(log, []*Definition{definition}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to be created with the name of an existing operator", func() {
+		definition.Name = lso.Operator.Name
+		_, err := NewOperators(log, []*Definition{definition}, []api.Operator{lso.NewLSOperator()})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to be created with the feature support ID of an existing feature", func() {
+		definition.FeatureSupportID = models.FeatureSupportLevelIDSNO
+		_, err := NewOperators(log, []*Definition{definition}, []api.Operator{lso.NewLSOperator()})
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("cluster validation", func(openshiftVersion, cpuArchitecture string, expectedStatus api.ValidationStatus) {
+		cluster.OpenshiftVersion = openshiftVersion
+		cluster.CPUArchitecture = cpuArchitecture
+		results, err := newTestOperator().ValidateCluster(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].ValidationId).To(Equal(ClusterValidationID))
+		Expect(results[0].Status).To(Equal(expectedStatus))
+	},
+		Entry("supported", "4.15.0", models.ClusterCPUArchitectureX8664, api.Success),
+		Entry("patch version of the maximum", "4.16.9", models.ClusterCPUArchitectureX8664, api.Success),
+		Entry("older version", "4.13.0", models.ClusterCPUArchitectureX8664, api.Failure),
+		Entry("newer version", "4.17.0", models.ClusterCPUArchitectureX8664, api.Failure),
+		Entry("unsupported architecture", "4.15.0", models.ClusterCPUArchitectureArm64, api.Failure),
+	)
+
+	DescribeTable("host requirements", func(role models.HostRole, expected *models.ClusterHostRequirementsDetails) {
+		requirements, err := newTestOperator().GetHostRequirements(context.TODO(), cluster, &models.Host{Role: role})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements).To(Equal(expected))
+	},
+		Entry("master", models.HostRoleMaster, &models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 2048}),
+		Entry("worker", models.HostRoleWorker, &models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 1024}),
+		Entry("arbiter", models.HostRoleArbiter, &models.ClusterHostRequirementsDetails{}),
+	)
+
+	It("should provide the preflight requirements", func() {
+		requirements := newTestOperator().GetPreflightRequirements(context.TODO(), cluster)
+		Expect(requirements.OperatorName).To(Equal("example"))
+		Expect(requirements.Dependencies).To(Equal([]string{lso.Operator.Name}))
+		Expect(requirements.Requirements.Master.Qualitative).To(Equal([]string{"2048 MiB of additional RAM", "2 additional CPUs"}))
+		Expect(requirements.Requirements.Worker.Quantitative).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 1024}))
+	})
+
+	Context("host validation", func() {
+		inventory := func(cpus, memoryMib int64) string {
+			b, err := json.Marshal(&models.Inventory{
+				CPU:    &models.CPU{Count: cpus},
+				Memory: &models.Memory{UsableBytes: conversions.MibToBytes(memoryMib)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return string(b)
+		}
+
+		DescribeTable("should validate the resources of the host", func(host *models.Host, expectedStatus api.ValidationStatus) {
+			result, err := newTestOperator().ValidateHost(context.TODO(), cluster, host, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ValidationId).To(Equal(HostValidationID))
+			Expect(result.Status).To(Equal(expectedStatus))
+		},
+			Entry("enough resources", &models.Host{Role: models.HostRoleMaster, Inventory: inventory(2, 2048)}, api.Success),
+			Entry("not enough CPUs", &models.Host{Role: models.HostRoleMaster, Inventory: inventory(1, 2048)}, api.Failure),
+			Entry("not enough memory", &models.Host{Role: models.HostRoleMaster, Inventory: inventory(2, 1024)}, api.Failure),
+			Entry("worker requirements", &models.Host{Role: models.HostRoleWorker, Inventory: inventory(1, 1024)}, api.Success),
+			Entry("no inventory", &models.Host{Role: models.HostRoleMaster}, api.Pending),
+		)
+	})
+
+	Context("manifests", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "declarative-operator")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should generate the subscription, namespace and operator group", func() {
+			definition.ClusterMonitoring = true
+			openshiftManifests, customManifests, err := newTestOperator().GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customManifests).To(BeNil())
+			Expect(openshiftManifests).To(HaveLen(3))
+
+			var subscription map[string]interface{}
+			Expect(yaml.Unmarshal(openshiftManifests["50_example_subscription.yaml"], &subscription)).To(Succeed())
+			Expect(subscription["metadata"]).To(HaveKeyWithValue("namespace", "example-operator"))
+			Expect(subscription["spec"]).To(Equal(map[string]interface{}{
+				"channel":             "stable",
+				"installPlanApproval": "Automatic",
+				"name":                "example-operator",
+				"source":              "redhat-operators",
+				"sourceNamespace":     "openshift-marketplace",
+			}))
+			Expect(string(openshiftManifests["50_example_ns.yaml"])).To(ContainSubstring(`openshift.io/cluster-monitoring: "true"`))
+			Expect(openshiftManifests).To(HaveKey("50_example_operator_group.yaml"))
+		})
+
+		It("should only generate the subscription in the global namespace", func() {
+			definition.Namespace = globalNamespace
+			openshiftManifests, _, err := newTestOperator().GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(1))
+			Expect(openshiftManifests).To(HaveKey("50_example_subscription.yaml"))
+		})
+
+		It("should generate the manifests of its templates", func() {
+			Expect(os.WriteFile(filepath.Join(dir, DefinitionFileName), []byte(minimalDefinition), 0o600)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(dir, TemplatesDirName, "openshift"), 0o755)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(dir, TemplatesDirName, "custom"), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, TemplatesDirName, "openshift", "config.yaml"),
+				[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: {{ .Operator.Namespace }}\n"), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, TemplatesDirName, "custom", "instance.yaml"),
+				[]byte("apiVersion: example.com/v1\nkind: Example\nmetadata:\n  name: {{ .Config.Name }}\n  namespace: {{ .Operator.Namespace }}\n"), 0o600)).To(Succeed())
+
+			var err error
+			definition, err = LoadDefinition(dir)
+			Expect(err).ToNot(HaveOccurred())
+
+			openshiftManifests, customManifests, err := newTestOperator().GenerateManifests(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(4))
+			Expect(string(openshiftManifests["50_example_config.yaml"])).To(ContainSubstring("namespace: example-operator"))
+			Expect(string(customManifests)).To(ContainSubstring("kind: Example"))
+			Expect(string(customManifests)).To(ContainSubstring("name: example\n"))
+		})
+	})
+})
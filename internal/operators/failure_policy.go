@@ -0,0 +1,101 @@
+package operators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// DefaultMaxRetries is how many times the subscription of an operator with the retry failure policy is reapplied when
+// its max retries aren't set
+const DefaultMaxRetries = 3
+
+// Retrier reapplies the subscriptions of the OLM operators that failed, when their failure policy allows another
+// attempt. RetryOperator only records the attempt, and returns false when the operator can't be retried anymore. The
+// subscriptions are reapplied in the background by the service itself, so only the operators of clusters whose API
+// is reachable from the service are retried, the others fail once the service finds that it can't reach them.
+//
+//go:generate mockgen --build_flags=--mod=mod -package=operators -destination=mock_retrier.go . Retrier
+type Retrier interface {
+	RetryOperator(ctx context.Context, clusterID strfmt.UUID, operatorName string, reason string) (bool, error)
+}
+
+// ErrClusterUnreachable is the reason of the failure of the operators that can't be retried because the API of their
+// cluster can't be reached from the service
+var ErrClusterUnreachable = errors.New("the API of the cluster can't be reached")
+
+// FailurePolicy returns the failure policy of the operator, best-effort when it isn't set
+func FailurePolicy(operator *models.MonitoredOperator) models.OperatorFailurePolicy {
+	if operator.FailurePolicy == "" {
+		return models.OperatorFailurePolicyBestEffort
+	}
+	return operator.FailurePolicy
+}
+
+// MaxRetries returns how many times the subscription of the operator is reapplied when it fails
+func MaxRetries(operator *models.MonitoredOperator) int64 {
+	if FailurePolicy(operator) != models.OperatorFailurePolicyRetry {
+		return 0
+	}
+	if operator.MaxRetries <= 0 {
+		return DefaultMaxRetries
+	}
+	return operator.MaxRetries
+}
+
+// ShouldRetry checks if the subscription of the failed operator should be reapplied
+func ShouldRetry(operator *models.MonitoredOperator) bool {
+	return operator.OperatorType == models.OperatorTypeOlm && operator.Retries < MaxRetries(operator)
+}
+
+// IsRequired checks if a failure of the operator fails the installation
+func IsRequired(operator *models.MonitoredOperator) bool {
+	return operator.OperatorType == models.OperatorTypeOlm && FailurePolicy(operator) == models.OperatorFailurePolicyRequired
+}
+
+// EnsureFailurePolicies validates the failure policies of the operators. Only OLM operators have failure policies, as
+// the builtin operators are always required, and max retries are only meaningful with the retry policy.
+func (mgr *Manager) EnsureFailurePolicies(operators []*models.MonitoredOperator) error {
+	for _, operator := range operators {
+		if operator.FailurePolicy == "" && operator.MaxRetries == 0 {
+			continue
+		}
+		if _, ok := mgr.olmOperators[operator.Name]; !ok {
+			return fmt.Errorf("the failure policy of operator %s can't be set, it isn't an OLM operator", operator.Name)
+		}
+		if err := validateFailurePolicy(operator.FailurePolicy, operator.MaxRetries); err != nil {
+			return fmt.Errorf("invalid failure policy of operator %s: %w", operator.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateFailurePolicy(policy models.OperatorFailurePolicy, maxRetries int64) error {
+	if policy != "" {
+		if err := policy.Validate(nil); err != nil {
+			return err
+		}
+	}
+	if maxRetries != 0 && policy != models.OperatorFailurePolicyRetry {
+		return fmt.Errorf("max retries can only be set with the %s failure policy", models.OperatorFailurePolicyRetry)
+	}
+	if maxRetries < 0 {
+		return fmt.Errorf("max retries must be positive, got %d", maxRetries)
+	}
+	return nil
+}
+
+// SetFailurePolicy applies the failure policy that the user requested to the operator. Setting only the max retries
+// keeps the failure policy of the operator.
+func SetFailurePolicy(operator *models.MonitoredOperator, params *models.OperatorCreateParams) {
+	if params.FailurePolicy != "" {
+		operator.FailurePolicy = params.FailurePolicy
+		operator.MaxRetries = params.MaxRetries
+	} else if params.MaxRetries != 0 {
+		operator.MaxRetries = params.MaxRetries
+	}
+}
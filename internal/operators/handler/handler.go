@@ -12,6 +12,8 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
+	"github.com/openshift/assisted-service/internal/operators/planner"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
@@ -28,11 +30,17 @@ type Handler struct {
 	log                logrus.FieldLogger
 	eventsHandler      eventsapi.Handler
 	clusterProgressAPI cluster.ProgressAPI
+	// day2API installs operators in installed clusters
+	day2API day2.API
+	// retrier reapplies the subscriptions of failed operators whose failure policy is retry
+	retrier operators.Retrier
+	// plannerAPI plans the hosts needed to run sets of operators
+	plannerAPI planner.API
 }
 
 // NewHandler creates new handler
-func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, clusterProgressAPI cluster.ProgressAPI) *Handler {
-	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler, clusterProgressAPI: clusterProgressAPI}
+func NewHandler(operatorsAPI operators.API, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, clusterProgressAPI cluster.ProgressAPI, day2API day2.API, retrier operators.Retrier, plannerAPI planner.API) *Handler {
+	return &Handler{operatorsAPI: operatorsAPI, log: log, db: db, eventsHandler: eventsHandler, clusterProgressAPI: clusterProgressAPI, day2API: day2API, retrier: retrier, plannerAPI: plannerAPI}
 }
 
 // ReportMonitoredOperatorStatus Controller API to report of monitored operators.
@@ -40,6 +48,26 @@ func (h *Handler) V2ReportMonitoredOperatorStatus(ctx context.Context, params re
 
 	log := logutil.FromContext(ctx, h.log)
 
+	// Failed operators whose failure policy allows another attempt are retried, instead of completing the finalizing
+	// of the cluster. Only the retry is recorded here, its subscription is reapplied by the day-2 operators Monitor.
+	if params.ReportParams.Status == models.OperatorStatusFailed {
+		operator, err := h.FindMonitoredOperator(ctx, params.ClusterID, params.ReportParams.Name, h.db)
+		if err != nil {
+			log.Error(err)
+			return common.GenerateErrorResponder(err)
+		}
+		if operators.ShouldRetry(operator) {
+			retried, err := h.retrier.RetryOperator(ctx, params.ClusterID, operator.Name, params.ReportParams.StatusInfo)
+			if err != nil {
+				log.WithError(err).Errorf("failed to retry operator %s of cluster %s", operator.Name, params.ClusterID)
+				return common.GenerateErrorResponder(err)
+			}
+			if retried {
+				return restoperators.NewV2ReportMonitoredOperatorStatusOK()
+			}
+		}
+	}
+
 	err := h.db.Transaction(func(tx *gorm.DB) error {
 		if err := h.UpdateMonitoredOperatorStatus(ctx, params.ClusterID, params.ReportParams.Name, params.ReportParams.Version, params.ReportParams.Status, params.ReportParams.StatusInfo, tx); err != nil {
 			return err
@@ -125,5 +153,8 @@ func (h *Handler) UpdateMonitoredOperatorStatus(ctx context.Context, clusterID s
 	}
 
 	eventgen.SendClusterOperatorStatusEvent(ctx, h.eventsHandler, clusterID, operator.Name, string(status), statusInfo)
+	if status == models.OperatorStatusFailed && operator.Retries > 0 {
+		eventgen.SendClusterOperatorRetriesExhaustedEvent(ctx, h.eventsHandler, clusterID, operator.Name, operator.Retries)
+	}
 	return nil
 }
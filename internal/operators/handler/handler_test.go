@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -18,8 +19,10 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/day2"
 	operatorsHandler "github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/planner"
 	"github.com/openshift/assisted-service/models"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/sirupsen/logrus"
@@ -47,7 +50,7 @@ var _ = Describe("Operators manager", func() {
 		mockApi = operators.NewMockAPI(ctrl)
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockClusterProgressApi = cluster.NewMockProgressAPI(ctrl)
-		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockClusterProgressApi)
+		handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockClusterProgressApi, nil, nil, nil)
 
 		// create simple cluster #1
 		clusterID := strfmt.UUID(uuid.New().String())
@@ -207,6 +210,233 @@ var _ = Describe("Operators manager", func() {
 				Expect(time.Time(operator.StatusUpdatedAt).UTC()).To(Equal(time.Time(lastUpdatedTime).UTC()))
 			}
 		})
+
+		It("should report that the retries of the operator are exhausted", func() {
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", *c.ID, lso.Operator.Name).
+				Updates(map[string]interface{}{"failure_policy": models.OperatorFailurePolicyRetry, "retries": 3}).Error).ToNot(HaveOccurred())
+			mockEvents.EXPECT().SendClusterEvent(context.TODO(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName))).Times(1)
+			mockEvents.EXPECT().SendClusterEvent(context.TODO(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorRetriesExhaustedEventName),
+				eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+
+			err := handler.UpdateMonitoredOperatorStatus(context.TODO(), *c.ID, lso.Operator.Name, "", models.OperatorStatusFailed, "csv failed", db)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("V2ReportMonitoredOperatorStatus", func() {
+		var mockRetrier *operators.MockRetrier
+
+		BeforeEach(func() {
+			mockRetrier = operators.NewMockRetrier(ctrl)
+			handler = operatorsHandler.NewHandler(mockApi, log, db, mockEvents, mockClusterProgressApi, nil, mockRetrier, nil)
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", *c.ID, lso.Operator.Name).
+				Update("failure_policy", models.OperatorFailurePolicyRetry).Error).ToNot(HaveOccurred())
+		})
+
+		report := func(status models.OperatorStatus) middleware.Responder {
+			return handler.V2ReportMonitoredOperatorStatus(context.TODO(), restoperators.V2ReportMonitoredOperatorStatusParams{
+				ClusterID: *c.ID,
+				ReportParams: &models.OperatorMonitorReport{
+					Name:       lso.Operator.Name,
+					Status:     status,
+					StatusInfo: "csv failed",
+				},
+			})
+		}
+
+		It("should reapply the subscription of a failed operator with the retry failure policy", func() {
+			mockRetrier.EXPECT().RetryOperator(context.TODO(), *c.ID, lso.Operator.Name, "csv failed").Return(true, nil)
+			Expect(report(models.OperatorStatusFailed)).To(BeAssignableToTypeOf(restoperators.NewV2ReportMonitoredOperatorStatusOK()))
+			operator, err := handler.FindMonitoredOperator(context.TODO(), *c.ID, lso.Operator.Name, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operator.Status).ToNot(Equal(models.OperatorStatusFailed))
+		})
+
+		It("should fail the operator when the retrier doesn't retry it", func() {
+			mockRetrier.EXPECT().RetryOperator(context.TODO(), *c.ID, lso.Operator.Name, "csv failed").Return(false, nil)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any()).Times(1)
+			mockClusterProgressApi.EXPECT().UpdateFinalizingProgress(gomock.Any(), gomock.Any(), *c.ID).Return(nil)
+			Expect(report(models.OperatorStatusFailed)).To(BeAssignableToTypeOf(restoperators.NewV2ReportMonitoredOperatorStatusOK()))
+			operator, err := handler.FindMonitoredOperator(context.TODO(), *c.ID, lso.Operator.Name, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+		})
+
+		It("should fail the report when the retry can't be recorded", func() {
+			mockRetrier.EXPECT().RetryOperator(context.TODO(), *c.ID, lso.Operator.Name, "csv failed").
+				Return(false, common.NewApiError(http.StatusInternalServerError, errors.New("db error")))
+			Expect(report(models.OperatorStatusFailed)).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		})
+
+		It("should fail the operator once its retries are exhausted", func() {
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ? AND name = ?", *c.ID, lso.Operator.Name).
+				Update("retries", operators.DefaultMaxRetries).Error).ToNot(HaveOccurred())
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any()).Times(2)
+			mockClusterProgressApi.EXPECT().UpdateFinalizingProgress(gomock.Any(), gomock.Any(), *c.ID).Return(nil)
+			Expect(report(models.OperatorStatusFailed)).To(BeAssignableToTypeOf(restoperators.NewV2ReportMonitoredOperatorStatusOK()))
+			operator, err := handler.FindMonitoredOperator(context.TODO(), *c.ID, lso.Operator.Name, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operator.Status).To(Equal(models.OperatorStatusFailed))
+		})
+	})
+})
+
+var _ = Describe("V2InstallOperators", func() {
+	var (
+		log         = logrus.New()
+		ctrl        *gomock.Controller
+		mockDay2API *day2.MockAPI
+		handler     *operatorsHandler.Handler
+		clusterID   strfmt.UUID
+		params      *models.InstallOperatorsParams
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDay2API = day2.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(nil, log, nil, nil, nil, mockDay2API, nil, nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+		params = &models.InstallOperatorsParams{
+			OlmOperators: []*models.OperatorCreateParams{{Name: lso.Operator.Name}},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return the operators that are being installed", func() {
+		installed := models.MonitoredOperatorsList{{ClusterID: clusterID, Name: lso.Operator.Name, Day2: true, Status: models.OperatorStatusProgressing}}
+		mockDay2API.EXPECT().InstallOperators(gomock.Any(), clusterID, params.OlmOperators).Return(installed, nil)
+
+		response := handler.V2InstallOperators(context.Background(), restoperators.V2InstallOperatorsParams{ClusterID: clusterID, InstallOperatorsParams: params})
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2InstallOperatorsAccepted()))
+		Expect(response.(*restoperators.V2InstallOperatorsAccepted).Payload).To(Equal(installed))
+	})
+
+	It("should return the error of the installation", func() {
+		mockDay2API.EXPECT().InstallOperators(gomock.Any(), clusterID, params.OlmOperators).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New("cluster isn't installed")))
+
+		response := handler.V2InstallOperators(context.Background(), restoperators.V2InstallOperatorsParams{ClusterID: clusterID, InstallOperatorsParams: params})
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusConflict, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+	})
+})
+
+var _ = Describe("V2PlanCapacity", func() {
+	var (
+		log            = logrus.New()
+		ctrl           *gomock.Controller
+		mockPlannerAPI *planner.MockAPI
+		handler        *operatorsHandler.Handler
+		params         *models.CapacityPlanParams
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockPlannerAPI = planner.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(nil, log, nil, nil, nil, nil, nil, mockPlannerAPI)
+		params = &models.CapacityPlanParams{
+			OpenshiftVersion: swag.String("4.16.0"),
+			OlmOperators:     []*models.OperatorCreateParams{{Name: lso.Operator.Name}},
+			HostProfiles:     []*models.HostProfile{{Name: swag.String("small"), CPUCores: swag.Int64(8), RAMMib: swag.Int64(32768)}},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return the plan", func() {
+		plan := &models.CapacityPlan{Feasible: true, ControlPlane: &models.CapacityPlanHosts{HostProfile: "small", Count: 3}}
+		mockPlannerAPI.EXPECT().PlanCapacity(gomock.Any(), params).Return(plan, nil)
+
+		response := handler.V2PlanCapacity(context.Background(), restoperators.V2PlanCapacityParams{CapacityPlanParams: params})
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2PlanCapacityOK()))
+		Expect(response.(*restoperators.V2PlanCapacityOK).Payload).To(Equal(plan))
+	})
+
+	It("should return the error of the planning", func() {
+		mockPlannerAPI.EXPECT().PlanCapacity(gomock.Any(), params).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid openshift version")))
+
+		response := handler.V2PlanCapacity(context.Background(), restoperators.V2PlanCapacityParams{CapacityPlanParams: params})
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
+var _ = Describe("V2ResolveOperators", func() {
+	var (
+		log     = logrus.New()
+		ctrl    *gomock.Controller
+		mockApi *operators.MockAPI
+		handler *operatorsHandler.Handler
+		params  restoperators.V2ResolveOperatorsParams
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockApi = operators.NewMockAPI(ctrl)
+		handler = operatorsHandler.NewHandler(mockApi, log, nil, nil, nil, nil, nil, nil)
+		params = restoperators.V2ResolveOperatorsParams{
+			Operators:         []string{"odf"},
+			OpenshiftVersion:  "4.16.0",
+			CPUArchitecture:   swag.String(models.ClusterCPUArchitectureX8664),
+			PlatformType:      swag.String(string(models.PlatformTypeBaremetal)),
+			ControlPlaneCount: swag.Int64(1),
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return the resolution of the operators for the described cluster", func() {
+		resolution := &models.OperatorsResolution{
+			Operators: []*models.ResolvedOperator{{Name: "odf", Reason: "Requested"}},
+		}
+		mockApi.EXPECT().ExplainDependencies(gomock.Any(), gomock.Any(), []string{"odf"}).DoAndReturn(
+			func(_ context.Context, cluster *common.Cluster, _ []string) (*models.OperatorsResolution, error) {
+				Expect(cluster.OpenshiftVersion).To(Equal("4.16.0"))
+				Expect(cluster.CPUArchitecture).To(Equal(models.ClusterCPUArchitectureX8664))
+				Expect(cluster.ControlPlaneCount).To(BeEquivalentTo(1))
+				Expect(swag.StringValue(cluster.HighAvailabilityMode)).To(Equal(models.ClusterHighAvailabilityModeNone))
+				Expect(*cluster.Platform.Type).To(Equal(models.PlatformTypeBaremetal))
+				return resolution, nil
+			})
+
+		response := handler.V2ResolveOperators(context.Background(), params)
+
+		Expect(response).To(BeAssignableToTypeOf(restoperators.NewV2ResolveOperatorsOK()))
+		Expect(response.(*restoperators.V2ResolveOperatorsOK).Payload).To(Equal(resolution))
+	})
+
+	It("should reject an invalid OpenShift version", func() {
+		params.OpenshiftVersion = "invalid-version"
+
+		response := handler.V2ResolveOperators(context.Background(), params)
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+		Expect(response.(*common.ApiErrorResponse).Error()).To(ContainSubstring("invalid openshift version"))
+	})
+
+	It("should reject unknown operators", func() {
+		params.Operators = []string{"unknown"}
+		mockApi.EXPECT().ExplainDependencies(gomock.Any(), gomock.Any(), []string{"unknown"}).
+			Return(nil, errors.New("operator unknown isn't supported"))
+
+		response := handler.V2ResolveOperators(context.Background(), params)
+
+		Expect(response).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
 	})
 })
 
@@ -224,7 +454,7 @@ var _ = Describe("V2ListBundles validation", func() {
 		db, dbName = common.PrepareTestDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockApi = operators.NewMockAPI(ctrl)
-		handler = operatorsHandler.NewHandler(mockApi, log, db, nil, nil)
+		handler = operatorsHandler.NewHandler(mockApi, log, db, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
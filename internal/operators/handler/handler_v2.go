@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/hashicorp/go-version"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
@@ -147,3 +148,64 @@ func (h *Handler) V2GetBundle(ctx context.Context, params restoperators.V2GetBun
 
 	return restoperators.NewV2GetBundleOK().WithPayload(bundle)
 }
+
+// V2InstallOperators Installs OLM operators in an installed cluster.
+func (h *Handler) V2InstallOperators(ctx context.Context, params restoperators.V2InstallOperatorsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	operators, err := h.day2API.InstallOperators(ctx, params.ClusterID, params.InstallOperatorsParams.OlmOperators)
+	if err != nil {
+		log.WithError(err).Errorf("failed to install operators in cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2InstallOperatorsAccepted().WithPayload(operators)
+}
+
+// V2ResolveOperators Explains the resolution of the dependencies of a set of operators.
+func (h *Handler) V2ResolveOperators(ctx context.Context, params restoperators.V2ResolveOperatorsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	platformType := models.PlatformType(swag.StringValue(params.PlatformType))
+	if responder := h.validateBundleParameters(&params.OpenshiftVersion, params.CPUArchitecture, &platformType, params.ExternalPlatformName); responder != nil {
+		return responder
+	}
+
+	cluster := &common.Cluster{
+		Cluster: models.Cluster{
+			OpenshiftVersion:  params.OpenshiftVersion,
+			CPUArchitecture:   swag.StringValue(params.CPUArchitecture),
+			ControlPlaneCount: swag.Int64Value(params.ControlPlaneCount),
+			Platform: &models.Platform{
+				Type: &platformType,
+			},
+		},
+	}
+	if platformType == models.PlatformTypeExternal {
+		cluster.Platform.External = &models.PlatformExternal{PlatformName: params.ExternalPlatformName}
+	}
+	if cluster.ControlPlaneCount == 1 {
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+	} else {
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeFull)
+	}
+
+	resolution, err := h.operatorsAPI.ExplainDependencies(ctx, cluster, params.Operators)
+	if err != nil {
+		log.WithError(err).Errorf("failed to resolve operators %v", params.Operators)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	return restoperators.NewV2ResolveOperatorsOK().WithPayload(resolution)
+}
+
+// V2PlanCapacity Finds the smallest layout of hosts that runs a set of operators.
+func (h *Handler) V2PlanCapacity(ctx context.Context, params restoperators.V2PlanCapacityParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	plan, err := h.plannerAPI.PlanCapacity(ctx, params.CapacityPlanParams)
+	if err != nil {
+		log.WithError(err).Error("failed to plan capacity")
+		return common.GenerateErrorResponder(err)
+	}
+
+	return restoperators.NewV2PlanCapacityOK().WithPayload(plan)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -112,6 +113,12 @@ func (l *lsOperator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDLSO
 }
 
+// GetSupportedSubscriptionVersions returns the versions of the operator that are supported by the OpenShift version,
+// as its releases follow the OpenShift releases
+func (l *lsOperator) GetSupportedSubscriptionVersions(openshiftVersion string) []string {
+	return operatorscommon.OpenshiftSubscriptionVersions(openshiftVersion)
+}
+
 // GetBundleLabels returns the bundle labels for the LSO operator
 func (l *lsOperator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
@@ -76,7 +76,7 @@ func (o *operator) GetDependenciesFeatureSupportID() []models.FeatureSupportLeve
 
 // GetClusterValidationIDs returns cluster validation IDs for the Operator
 func (o *operator) GetClusterValidationIDs() []string {
-	return []string{clusterValidationID}
+	return []string{clusterValidationID, operatorscommon.StorageCapacityValidationID}
 }
 
 // GetHostValidationID returns host validation ID for the Operator
@@ -111,7 +111,54 @@ func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (
 		}
 	}
 
-	return result, nil
+	capacityResult, err := o.validateCapacity(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, capacityResult), nil
+}
+
+// validateCapacity checks that the disks of the hosts that run workloads provide the usable capacity that the user
+// requires. The volumes are created in a thin pool that uses part of the disks.
+func (o *operator) validateCapacity(cluster *common.Cluster) (api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: operatorscommon.StorageCapacityValidationID,
+	}
+	properties, err := operatorscommon.ParseStorageCapacityProperties(cluster.MonitoredOperators, Operator.Name)
+	if err != nil {
+		return result, err
+	}
+	if properties.RequiredCapacityGiB <= 0 {
+		return result, nil
+	}
+
+	var capacityBytes int64
+	for _, host := range cluster.Hosts {
+		if !isWorkloadHost(cluster, host) {
+			continue
+		}
+		if host.Inventory == "" {
+			result.Status = api.Pending
+			result.Reasons = []string{"Missing Inventory in some of the hosts"}
+			return result, nil
+		}
+		inventory, err := common.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return result, fmt.Errorf("failed to get inventory from host %s: %w", host.ID, err)
+		}
+		capacityBytes += operatorscommon.EligibleDisksSizeBytes(inventory.Disks, host.InstallationDiskID, 0)
+	}
+
+	usableBytes := capacityBytes * thinPoolSizePercent / 100
+	return operatorscommon.ValidateStorageCapacity("Logical Volume Manager", usableBytes, properties.RequiredCapacityGiB), nil
+}
+
+// isWorkloadHost checks if the host runs workloads, and therefore provides its disks to Logical Volume Manager
+func isWorkloadHost(cluster *common.Cluster, host *models.Host) bool {
+	role := common.GetEffectiveRole(host)
+	return role == models.HostRoleWorker || (common.ShouldMastersBeSchedulable(&cluster.Cluster) && role != models.HostRoleArbiter)
 }
 
 // ValidateHost always return "valid" result
@@ -134,14 +181,13 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 	diskCount, _ := operatorscommon.NonInstallationDiskCount(inventory.Disks, host.InstallationDiskID, minDiskSizeGb)
 
 	role := common.GetEffectiveRole(host)
-	areSchedulable := common.ShouldMastersBeSchedulable(&cluster.Cluster)
 	minSizeMessage := ""
 	if minDiskSizeGb > 0 {
 		minSizeMessage = fmt.Sprintf(" of %dGB minimum", minDiskSizeGb)
 	}
 	message := fmt.Sprintf("Logical Volume Manager requires at least one non-installation HDD/SSD disk%s on the host", minSizeMessage)
 
-	if role == models.HostRoleWorker || (areSchedulable && role != models.HostRoleArbiter) {
+	if isWorkloadHost(cluster, host) {
 		if diskCount == 0 {
 			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, nil
 		}
@@ -159,9 +205,11 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 	return Manifests(cluster)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties: the usable capacity that the workloads require
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return models.OperatorProperties{
+		operatorscommon.RequiredCapacityProperty(),
+	}
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the LSO
@@ -228,6 +276,12 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDLVM
 }
 
+// GetSupportedSubscriptionVersions returns the versions of the operator that are supported by the OpenShift version,
+// as its releases follow the OpenShift releases
+func (o *operator) GetSupportedSubscriptionVersions(openshiftVersion string) []string {
+	return operatorscommon.OpenshiftSubscriptionVersions(openshiftVersion)
+}
+
 // GetBundleLabels returns the bundle labels for the LVM operator
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	// For SNO feature, include in openshift-ai bundle
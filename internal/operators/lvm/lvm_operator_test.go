@@ -103,21 +103,23 @@ var _ = Describe("Lvm Operator", func() {
 	)
 
 	Context("ValidateCluster", func() {
+		capacityResult := api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)}
+
 		table.DescribeTable("validate cluster when ", func(cluster *common.Cluster, expectedResult []api.ValidationResult) {
 			res, _ := operator.ValidateCluster(ctx, cluster)
 			Expect(res).Should(Equal(expectedResult))
 		},
 			table.Entry("Control Plane Count 3",
 				&common.Cluster{Cluster: models.Cluster{ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode, Hosts: []*models.Host{hostWithSufficientResources, hostWithSufficientResources}, OpenshiftVersion: LvmMinMultiNodeSupportVersion}},
-				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}},
+				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}, capacityResult},
 			),
 			table.Entry("Control Plane Count 3 with pre-release version",
 				&common.Cluster{Cluster: models.Cluster{ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode, Hosts: []*models.Host{hostWithSufficientResources, hostWithSufficientResources}, OpenshiftVersion: "4.15.0-rc0"}},
-				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}},
+				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}, capacityResult},
 			),
 			table.Entry("Control Plane Count 3 with higher than LvmMinMultiNodeSupportVersion",
 				&common.Cluster{Cluster: models.Cluster{ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode, Hosts: []*models.Host{hostWithSufficientResources, hostWithSufficientResources}, OpenshiftVersion: "4.15.7"}},
-				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}},
+				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}, capacityResult},
 			),
 			table.Entry("Control Plane Count 3 and Openshift version less than LvmMinMultiNodeSupportVersion",
 				&common.Cluster{Cluster: models.Cluster{ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode, Hosts: []*models.Host{hostWithSufficientResources}, OpenshiftVersion: "4.14.0"}},
@@ -129,9 +131,62 @@ var _ = Describe("Lvm Operator", func() {
 			),
 			table.Entry("Control Plane Count 1 and Openshift version more than minimal",
 				&common.Cluster{Cluster: models.Cluster{ControlPlaneCount: 1, Hosts: []*models.Host{hostWithSufficientResources}, OpenshiftVersion: LvmoMinOpenshiftVersion}},
-				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}},
+				[]api.ValidationResult{{Status: api.Success, ValidationId: operator.GetHostValidationID()}, capacityResult},
 			),
 		)
+
+		Context("storage capacity", func() {
+			newCluster := func(requiredCapacityGiB int64, hosts ...*models.Host) *common.Cluster {
+				return &common.Cluster{Cluster: models.Cluster{
+					ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode,
+					OpenshiftVersion:  LvmMinMultiNodeSupportVersion,
+					Hosts:             append([]*models.Host{masterNode, masterNode, masterNode}, hosts...),
+					MonitoredOperators: []*models.MonitoredOperator{{
+						Name:       Operator.Name,
+						Properties: fmt.Sprintf(`{"required_capacity_gib": %d}`, requiredCapacityGiB),
+					}},
+				}}
+			}
+			newWorker := func() *models.Host {
+				worker := *hostWithSufficientResources
+				worker.Role = models.HostRoleWorker
+				return &worker
+			}
+
+			It("succeeds when the workers provide the required capacity", func() {
+				res, err := operator.ValidateCluster(ctx, newCluster(60, newWorker(), newWorker()))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(2))
+				Expect(res[1].Status).To(Equal(api.Success))
+				Expect(res[1].Reasons).To(ConsistOf(
+					"Logical Volume Manager provides 67.06 GiB of usable capacity, which satisfies the required 60 GiB.",
+				))
+			})
+
+			It("reports the shortfall when the workers don't provide the required capacity", func() {
+				res, err := operator.ValidateCluster(ctx, newCluster(100, newWorker(), newWorker()))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[1]).To(Equal(api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons: []string{
+						"Logical Volume Manager provides 67.06 GiB of usable capacity, 32.94 GiB less than the required 100 GiB.",
+					},
+				}))
+			})
+
+			It("is pending when the inventory of a worker is missing", func() {
+				res, err := operator.ValidateCluster(ctx, newCluster(60, newWorker(), &models.Host{Role: models.HostRoleWorker}))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[1].Status).To(Equal(api.Pending))
+			})
+
+			It("doesn't check the capacity when it isn't required", func() {
+				res, err := operator.ValidateCluster(ctx, newCluster(0, &models.Host{Role: models.HostRoleWorker}))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[1]).To(Equal(capacityResult))
+			})
+		})
 	})
 	Context("GetHostRequirements", func() {
 		BeforeEach(func() {
@@ -142,7 +197,9 @@ var _ = Describe("Lvm Operator", func() {
 			infraEnvID = strfmt.UUID(uuid.New().String())
 			mockEvents = eventsapi.NewMockHandler(mockCtrl)
 			mockMetric = metrics.NewMockAPI(mockCtrl)
-			mockOperators = operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
+			var err error
+			mockOperators, err = operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
+			Expect(err).ToNot(HaveOccurred())
 			mockProviderRegistry = registry.NewMockProviderRegistry(mockCtrl)
 			mockProviderRegistry.EXPECT().IsHostSupported(testing.EqPlatformType(models.PlatformTypeBaremetal), gomock.Any()).Return(true, nil).AnyTimes()
 			mockVersions = versions.NewMockHandler(mockCtrl)
@@ -2,12 +2,17 @@ package lvm
 
 import (
 	"bytes"
+	"strconv"
 	"text/template"
 
 	"github.com/openshift/assisted-service/internal/common"
 )
 
-const defaultDeviceName = "vg1"
+const (
+	defaultDeviceName = "vg1"
+	// thinPoolSizePercent is the share of the volume group that the thin pool, where the volumes are created, uses
+	thinPoolSizePercent = 90
+)
 
 // Manifests returns manifests needed to deploy LVM
 func Manifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
@@ -84,6 +89,7 @@ func getLvmCluster() ([]byte, error) {
 	data := map[string]string{
 		"OPERATOR_NAMESPACE": Operator.Namespace,
 		"DEVICE_NAME":        defaultDeviceName,
+		"SIZE_PERCENT":       strconv.Itoa(thinPoolSizePercent),
 	}
 	return executeTemplate(data, "LvmCluster", LvmCluster)
 }
@@ -140,5 +146,5 @@ spec:
     - name: {{.DEVICE_NAME}}
       thinPoolConfig:
         name: thin-pool-1
-        sizePercent: 90
+        sizePercent: {{.SIZE_PERCENT}}
         overprovisionRatio: 10`
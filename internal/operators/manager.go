@@ -10,12 +10,14 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
 	"github.com/openshift/assisted-service/internal/operators/odf"
@@ -53,6 +55,7 @@ type Manager struct {
 	monitoredOperators map[string]*models.MonitoredOperator
 	manifestsAPI       manifestsapi.ManifestsAPI
 	objectHandler      s3wrapper.API
+	bundleDefinitions  []*BundleDefinition
 }
 
 type OperatorFeatureSupportID struct {
@@ -79,10 +82,16 @@ type API interface {
 	// GenerateManifests generates manifests for all enabled operators.
 	// Returns map assigning manifest content to its desired file name
 	GenerateManifests(ctx context.Context, cluster *common.Cluster) error
+	// GenerateOperatorManifests generates the manifests of an OLM operator of the cluster, without storing them.
+	// Returns map assigning openshift folder manifest content to its desired file name, and the custom manifests
+	GenerateOperatorManifests(cluster *common.Cluster, operator *models.MonitoredOperator) (map[string][]byte, []byte, error)
 	// AnyOLMOperatorEnabled checks whether any OLM operator has been enabled for the given cluster
 	AnyOLMOperatorEnabled(cluster *common.Cluster) bool
 	// ResolveDependencies amends the list of requested additional operators with any missing dependencies
 	ResolveDependencies(cluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error)
+	// ExplainDependencies resolves the dependencies of the requested operators, and explains the reasons, the conflicts
+	// and the host requirements of the resolved operators
+	ExplainDependencies(ctx context.Context, cluster *common.Cluster, operatorNames []string) (*models.OperatorsResolution, error)
 	// GetMonitoredOperatorsList returns the monitored operators available by the manager.
 	GetMonitoredOperatorsList() map[string]*models.MonitoredOperator
 	// GetOperatorByName the manager's supported operator object by name.
@@ -97,6 +106,12 @@ type API interface {
 	GetRequirementsBreakdownForHostInCluster(ctx context.Context, cluster *common.Cluster, host *models.Host) ([]*models.OperatorHostRequirements, error)
 	// GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
 	GetPreflightRequirementsBreakdownForCluster(ctx context.Context, cluster *common.Cluster) ([]*models.OperatorHardwareRequirements, error)
+	// EnsureOperatorProperties validates the properties of the operators that are added to the cluster, or whose
+	// properties are updated
+	EnsureOperatorProperties(cluster *common.Cluster, operators []*models.MonitoredOperator) error
+	// SetOperatorPropertiesDefaults fills the defaults of the properties that aren't set, in the operators that are
+	// added to the cluster or whose properties are updated
+	SetOperatorPropertiesDefaults(cluster *common.Cluster, operators []*models.MonitoredOperator) error
 	// EnsureOperatorPrerequisite Ensure that for the given operators has the base prerequisite for installation
 	EnsureOperatorPrerequisite(cluster *common.Cluster, openshiftVersion string, cpuArchitecture string, operators []*models.MonitoredOperator) error
 	// ListBundles returns the list of available bundles filtered by feature support
@@ -183,7 +198,7 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 
 		operator := mgr.olmOperators[clusterOperator.Name]
 		if operator != nil {
-			openshiftManifests, manifest, err := operator.GenerateManifests(cluster)
+			openshiftManifests, manifest, err := mgr.generateOperatorManifests(operator, clusterOperator, cluster)
 			if err != nil {
 				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
 				return err
@@ -232,6 +247,20 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 	return nil
 }
 
+// GenerateOperatorManifests generates the manifests of an OLM operator of the cluster, without storing them.
+// Returns map assigning openshift folder manifest content to its desired file name, and the custom manifests
+func (mgr *Manager) GenerateOperatorManifests(cluster *common.Cluster, clusterOperator *models.MonitoredOperator) (map[string][]byte, []byte, error) {
+	operator, ok := mgr.olmOperators[clusterOperator.Name]
+	if !ok {
+		return nil, nil, fmt.Errorf("operator %s isn't an OLM operator", clusterOperator.Name)
+	}
+	openshiftManifests, customManifests, err := mgr.generateOperatorManifests(operator, clusterOperator, cluster)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot generate %s manifests", clusterOperator.Name)
+	}
+	return openshiftManifests, customManifests, nil
+}
+
 // createControllerManifest create a file called custom_manifests.json, which is later obtained by the
 // assisted-installer-controller, which apply this manifest file after the OLM is deployed,
 // so user can provide here even CRs provisioned by the OLM.
@@ -427,7 +456,7 @@ func (mgr *Manager) ValidateHost(ctx context.Context, cluster *common.Cluster, h
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeSharedValidationResults(results, declarative.HostValidationID, noDeclarativeOperatorsReason), nil
 }
 
 // ValidateCluster validates cluster requirements
@@ -471,6 +500,10 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 	for opName := range pendingOperators {
 		operator := mgr.olmOperators[opName]
 		for _, validationID := range operator.GetClusterValidationIDs() {
+			// The storage operators share the capacity validation, which only the enabled ones report
+			if validationID == operatorscommon.StorageCapacityValidationID {
+				continue
+			}
 			result := api.ValidationResult{
 				Status:       api.Success,
 				ValidationId: validationID,
@@ -481,7 +514,40 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 			results = append(results, result)
 		}
 	}
-	return results, nil
+	results = mergeSharedValidationResults(results, declarative.ClusterValidationID, noDeclarativeOperatorsReason)
+	return mergeSharedValidationResults(results, operatorscommon.StorageCapacityValidationID, noStorageOperatorsReason), nil
+}
+
+const (
+	noDeclarativeOperatorsReason = "No declarative operators are configured"
+	noStorageOperatorsReason     = "No storage operators are configured"
+)
+
+// mergeSharedValidationResults merges the results of a validation that several operators share, like the declarative
+// operators or the storage operators, into one result, which fails if any of them fails. The result is reported even
+// when none of the operators is configured, as the state machines require it.
+func mergeSharedValidationResults(results []api.ValidationResult, validationID string, noOperatorsReason string) []api.ValidationResult {
+	merged := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: validationID,
+	}
+	found := false
+	ret := make([]api.ValidationResult, 0, len(results)+1)
+	for _, result := range results {
+		if result.ValidationId != validationID {
+			ret = append(ret, result)
+			continue
+		}
+		found = true
+		if result.Status == api.Failure || (result.Status == api.Pending && merged.Status == api.Success) {
+			merged.Status = result.Status
+		}
+		merged.Reasons = append(merged.Reasons, result.Reasons...)
+	}
+	if !found {
+		merged.Reasons = []string{noOperatorsReason}
+	}
+	return append(ret, merged)
 }
 
 func createDay2ValidationResult(operatorName, validationID string) api.ValidationResult {
@@ -503,15 +569,44 @@ func (mgr *Manager) GetSupportedOperators() []string {
 	return keys
 }
 
-// GetOperatorProperties provides description of properties of an operator
+// GetOperatorProperties provides description of properties of an operator, along with the JSON Schemas that their
+// values are validated against
 func (mgr *Manager) GetOperatorProperties(operatorName string) (models.OperatorProperties, error) {
-	if operator, ok := mgr.olmOperators[operatorName]; ok {
-		return operator.GetProperties(), nil
+	operator, ok := mgr.olmOperators[operatorName]
+	if !ok {
+		return nil, errors.Errorf("Operator %s not found", operatorName)
 	}
-	return nil, errors.Errorf("Operator %s not found", operatorName)
+	schema, err := operatorscommon.PropertiesSchema(operator.GetProperties())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid properties of operator %s", operatorName)
+	}
+	ret := make(models.OperatorProperties, 0, len(operator.GetProperties()))
+	for _, property := range operator.GetProperties() {
+		if property == nil {
+			continue
+		}
+		propertySchema := schema.Properties[property.Name]
+		content, err := json.Marshal(&propertySchema)
+		if err != nil {
+			return nil, err
+		}
+		withSchema := *property
+		if err = json.Unmarshal(content, &withSchema.Schema); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &withSchema)
+	}
+	return ret, nil
 }
 
 func (mgr *Manager) ResolveDependencies(cluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+	ret, _, err := mgr.resolveDependencies(cluster, operators)
+	return ret, err
+}
+
+// resolveDependencies adds the dependencies of the operators, and returns the names of the operators that depend on
+// each operator, as found while walking the dependencies
+func (mgr *Manager) resolveDependencies(cluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, map[string][]string, error) {
 	ret := make([]*models.MonitoredOperator, 0)
 	alreadyPresent := make([]string, 0)
 	currentDependencies := make(map[string]*models.MonitoredOperator)
@@ -530,16 +625,19 @@ func (mgr *Manager) ResolveDependencies(cluster *common.Cluster, operators []*mo
 	}
 
 	// Get dependent operators
-	allDependentOperators := mgr.getDependencies(cluster, ret)
+	allDependentOperators, requiredBy, err := mgr.getDependencies(cluster, ret)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for operatorName := range allDependentOperators {
+	for _, operatorName := range allDependentOperators {
 		if funk.Contains(alreadyPresent, operatorName) {
 			continue
 		}
 
 		operator, err := mgr.getDependency(operatorName, currentDependencies)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		operator.DependencyOnly = true
@@ -548,7 +646,7 @@ func (mgr *Manager) ResolveDependencies(cluster *common.Cluster, operators []*mo
 		alreadyPresent = append(alreadyPresent, operatorName)
 	}
 
-	return ret, nil
+	return ret, requiredBy, nil
 }
 
 func (mgr *Manager) getDependency(name string, definitions map[string]*models.MonitoredOperator) (*models.MonitoredOperator, error) {
@@ -559,37 +657,47 @@ func (mgr *Manager) getDependency(name string, definitions map[string]*models.Mo
 	return mgr.GetOperatorByName(name)
 }
 
-func (mgr *Manager) getDependencies(cluster *common.Cluster, operators []*models.MonitoredOperator) map[string]bool {
+// getDependencies walks the dependencies of the OLM operators breadth first. It returns the names of the operators
+// followed by the names of their dependencies, in the order they were found, and the edges that were walked, as the
+// names of the operators that depend on each operator.
+func (mgr *Manager) getDependencies(cluster *common.Cluster, operators []*models.MonitoredOperator) ([]string, map[string][]string, error) {
 	fifo := list.New()
 	visited := make(map[string]bool)
+	requiredBy := make(map[string][]string)
+	var names []string
 	for _, op := range operators {
-		if op.OperatorType != models.OperatorTypeOlm {
+		if op.OperatorType != models.OperatorTypeOlm || visited[op.Name] {
 			continue
 		}
-		mgr.log.Debugf("Attempting to resolve %s operator dependencies", op.Name)
-		deps := mgr.olmOperators[op.Name].GetDependencies(cluster)
-
 		visited[op.Name] = true
-		mgr.log.Debugf("Dependencies found for %s operator: %+v ", op.Name, deps)
-		for _, dep := range deps {
-			fifo.PushBack(dep)
-		}
+		names = append(names, op.Name)
+		fifo.PushBack(op.Name)
 	}
 	for fifo.Len() > 0 {
 		first := fifo.Front()
 		op := first.Value.(string)
+		fifo.Remove(first)
+		mgr.log.Debugf("Attempting to resolve %s operator dependencies", op)
 		deps := mgr.olmOperators[op].GetDependencies(cluster)
+		mgr.log.Debugf("Dependencies found for %s operator: %+v ", op, deps)
 
 		for _, dep := range deps {
-			if !visited[dep] {
-				fifo.PushBack(dep)
+			if _, ok := mgr.olmOperators[dep]; !ok {
+				return nil, nil, fmt.Errorf("dependency %s of operator %s isn't supported", dep, op)
 			}
+			if !funk.ContainsString(requiredBy[dep], op) {
+				requiredBy[dep] = append(requiredBy[dep], op)
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			names = append(names, dep)
+			fifo.PushBack(dep)
 		}
-		visited[op] = true
-		fifo.Remove(first)
 	}
 
-	return visited
+	return names, requiredBy, nil
 }
 
 func (mgr *Manager) GetMonitoredOperatorsList() map[string]*models.MonitoredOperator {
@@ -608,6 +716,8 @@ func (mgr *Manager) GetOperatorByName(operatorName string) (*models.MonitoredOpe
 		TimeoutSeconds:   operator.TimeoutSeconds,
 		Namespace:        operator.Namespace,
 		SubscriptionName: operator.SubscriptionName,
+		FailurePolicy:    operator.FailurePolicy,
+		MaxRetries:       operator.MaxRetries,
 	}, nil
 }
 
@@ -654,6 +764,79 @@ func (mgr *Manager) EnsureOperatorArchCapability(cluster *common.Cluster, cpuArc
 	return nil
 }
 
+// EnsureOperatorProperties validates the properties of the operators that are added to the cluster, or whose properties
+// are updated, against their schemas. The properties of operators that don't describe them aren't validated, and the
+// operators aren't modified: the defaults are set by SetOperatorPropertiesDefaults.
+func (mgr *Manager) EnsureOperatorProperties(cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	for _, monitoredOperator := range mgr.changedPropertiesOperators(cluster, operators) {
+		schema, err := mgr.propertiesSchema(monitoredOperator.Name)
+		if err != nil {
+			return err
+		}
+		if schema == nil {
+			continue
+		}
+		if err = operatorscommon.ValidateProperties(schema, monitoredOperator.Properties); err != nil {
+			return errors.Wrapf(err, "invalid properties of operator %s", monitoredOperator.Name)
+		}
+	}
+	return nil
+}
+
+// SetOperatorPropertiesDefaults fills the defaults of the properties that aren't set, in the operators that are added
+// to the cluster or whose properties are updated
+func (mgr *Manager) SetOperatorPropertiesDefaults(cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	for _, monitoredOperator := range mgr.changedPropertiesOperators(cluster, operators) {
+		schema, err := mgr.propertiesSchema(monitoredOperator.Name)
+		if err != nil {
+			return err
+		}
+		if schema == nil {
+			continue
+		}
+		properties, err := operatorscommon.PropertiesWithDefaults(schema, monitoredOperator.Properties)
+		if err != nil {
+			return errors.Wrapf(err, "invalid properties of operator %s", monitoredOperator.Name)
+		}
+		monitoredOperator.Properties = properties
+	}
+	return nil
+}
+
+// changedPropertiesOperators returns the OLM operators that aren't operators of the cluster yet, or whose properties
+// differ from the properties of the operator of the cluster. The properties of the operators that were recorded before
+// they were validated are kept as is.
+func (mgr *Manager) changedPropertiesOperators(cluster *common.Cluster, operators []*models.MonitoredOperator) []*models.MonitoredOperator {
+	var ret []*models.MonitoredOperator
+	for _, monitoredOperator := range operators {
+		if _, ok := mgr.olmOperators[monitoredOperator.Name]; !ok {
+			continue
+		}
+		if cluster != nil {
+			current := operatorscommon.GetOperator(cluster.MonitoredOperators, monitoredOperator.Name)
+			if current != nil && current.Properties == monitoredOperator.Properties {
+				continue
+			}
+		}
+		ret = append(ret, monitoredOperator)
+	}
+	return ret
+}
+
+// propertiesSchema returns the JSON Schema of the properties of an OLM operator, or nil when the operator doesn't
+// describe its properties and they are free-form
+func (mgr *Manager) propertiesSchema(operatorName string) (*spec.Schema, error) {
+	operator := mgr.olmOperators[operatorName]
+	if len(operator.GetProperties()) == 0 {
+		return nil, nil
+	}
+	schema, err := operatorscommon.PropertiesSchema(operator.GetProperties())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid properties of operator %s", operatorName)
+	}
+	return schema, nil
+}
+
 func EnsureLVMAndCNVDoNotClash(cluster *common.Cluster, openshiftVersion string, operators []*models.MonitoredOperator) error {
 	var cnvEnabled, lvmEnabled bool
 
@@ -689,7 +872,17 @@ func EnsureLVMAndCNVDoNotClash(cluster *common.Cluster, openshiftVersion string,
 }
 
 func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshiftVersion string, cpuArchitecture string, operators []*models.MonitoredOperator) error {
-	err := EnsureLVMAndCNVDoNotClash(cluster, openshiftVersion, operators)
+	err := mgr.EnsureSubscriptionOverrides(cluster, openshiftVersion, cpuArchitecture, operators)
+	if err != nil {
+		return err
+	}
+
+	err = mgr.EnsureFailurePolicies(operators)
+	if err != nil {
+		return err
+	}
+
+	err = EnsureLVMAndCNVDoNotClash(cluster, openshiftVersion, operators)
 	if err != nil {
 		return err
 	}
@@ -706,7 +899,7 @@ func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshif
 func (mgr *Manager) ListBundles(filters *featuresupport.SupportLevelFilters, featureIDs []models.FeatureSupportLevelID) []*models.Bundle {
 	var ret []*models.Bundle
 
-	for _, basicBundleDetails := range operatorscommon.Bundles {
+	for _, basicBundleDetails := range mgr.allBundles() {
 		// Get the bundle with operators based on feature IDs
 		completeBundleDetails, err := mgr.GetBundle(basicBundleDetails.ID, featureIDs)
 		if err != nil {
@@ -730,6 +923,12 @@ func (mgr *Manager) GetBundle(bundleID string, featureIDs []models.FeatureSuppor
 		return nil, fmt.Errorf("bundle '%s' is not supported", bundleID)
 	}
 
+	if definition, ok := mgr.lookupBundleDefinition(bundleID); ok {
+		bundle.Operators = operatorNames(definition.Operators)
+		bundle.OptionalOperators = operatorNames(definition.OptionalOperators)
+		return bundle, nil
+	}
+
 	for _, operator := range mgr.olmOperators {
 		operatorName := operator.GetName()
 
@@ -768,12 +967,24 @@ func (mgr *Manager) ExpandBundleOperators(bundleID string, optionalOperators []s
 		}
 	}
 
-	var result []*models.OperatorCreateParams
-	for _, opName := range bundle.Operators {
-		result = append(result, &models.OperatorCreateParams{Name: opName})
+	// Defined bundles may preset the properties, the subscriptions and the failure policies of their operators
+	presets := make(map[string]*models.OperatorCreateParams)
+	if definition, ok := mgr.lookupBundleDefinition(bundleID); ok {
+		for _, operator := range append(append([]*models.OperatorCreateParams{}, definition.Operators...), definition.OptionalOperators...) {
+			presets[operator.Name] = operator
+		}
 	}
-	for _, opName := range optionalOperators {
-		result = append(result, &models.OperatorCreateParams{Name: opName})
+
+	var result []*models.OperatorCreateParams
+	for _, opName := range append(append([]string{}, bundle.Operators...), optionalOperators...) {
+		operator := &models.OperatorCreateParams{Name: opName}
+		if preset, ok := presets[opName]; ok {
+			operator.Properties = preset.Properties
+			operator.Subscription = preset.Subscription
+			operator.FailurePolicy = preset.FailurePolicy
+			operator.MaxRetries = preset.MaxRetries
+		}
+		result = append(result, operator)
 	}
 
 	return result, nil
@@ -783,7 +994,7 @@ func (mgr *Manager) ExpandBundleOperators(bundleID string, optionalOperators []s
 // bundle and a boolean flag indicating if it was found. Note that the result does not contain the list of operators
 // that are part of the bundle.
 func (mgr *Manager) lookupBundle(bundleID string) (result *models.Bundle, ok bool) {
-	for _, bundle := range operatorscommon.Bundles {
+	for _, bundle := range mgr.allBundles() {
 		if bundle.ID == bundleID {
 			result = new(models.Bundle)
 			*result = *bundle
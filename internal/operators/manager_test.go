@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/clusterobservability"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/declarative"
 	"github.com/openshift/assisted-service/internal/operators/fenceagentsremediation"
 	"github.com/openshift/assisted-service/internal/operators/kubedescheduler"
 	"github.com/openshift/assisted-service/internal/operators/loki"
@@ -46,6 +47,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -144,7 +146,9 @@ var _ = BeforeEach(func() {
 	ctrl = gomock.NewController(GinkgoT())
 	manifestsAPI = manifestsapi.NewMockManifestsAPI(ctrl)
 	mockS3Api = s3wrapper.NewMockAPI(ctrl)
-	manager = operators.NewManager(log, manifestsAPI, operators.Options{}, mockS3Api)
+	var err error
+	manager, err = operators.NewManager(log, manifestsAPI, operators.Options{}, mockS3Api)
+	Expect(err).ToNot(HaveOccurred())
 	olmOperatorCount = len(manager.GetSupportedOperators())
 })
 
@@ -548,7 +552,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 2))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied), Reasons: []string{"odf is disabled"}},
@@ -578,6 +582,9 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", metallb.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", loki.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", openshiftlogging.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", sriov.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied), Reasons: []string{"No declarative operators are configured"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied), Reasons: []string{"No storage operators are configured"}},
 			))
 		})
 
@@ -590,7 +597,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 2))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied)},
 				api.ValidationResult{Status: api.Failure, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied),
@@ -621,6 +628,8 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", metallb.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLokiRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", loki.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftLoggingRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", openshiftlogging.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDSriovRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", sriov.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied)},
 			))
 		})
 
@@ -636,7 +645,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).NotTo(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 2))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{
 					Status:       api.Success,
@@ -648,6 +657,11 @@ var _ = Describe("Operators manager", func() {
 					ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied),
 					Reasons:      []string{"cnv validation is disabled when adding hosts to an existing cluster."},
 				},
+				api.ValidationResult{
+					Status:       api.Success,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"odf validation is disabled when adding hosts to an existing cluster."},
+				},
 			))
 
 			Expect(results).To(ContainElements(
@@ -655,6 +669,64 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDMceRequirementsSatisfied), Reasons: []string{"mce is disabled"}},
 			))
 		})
+
+		It("should merge the results of the declarative operators", func() {
+			declarativeOperator := func(name string) *api.MockOperator {
+				operator := mockOperatorBase(name)
+				operator.EXPECT().GetClusterValidationIDs().AnyTimes().Return([]string{declarative.ClusterValidationID})
+				return operator
+			}
+			enabledOperator := declarativeOperator("enabled")
+			enabledOperator.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).Return([]api.ValidationResult{
+				{Status: api.Failure, ValidationId: declarative.ClusterValidationID, Reasons: []string{"enabled is not supported"}},
+			}, nil)
+			disabledOperator := declarativeOperator("disabled")
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil, enabledOperator, disabledOperator)
+			cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "enabled", OperatorType: models.OperatorTypeOlm}}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]api.ValidationResult{
+				{Status: api.Failure, ValidationId: declarative.ClusterValidationID, Reasons: []string{"enabled is not supported", "disabled is disabled"}},
+				{Status: api.Success, ValidationId: operatorscommon.StorageCapacityValidationID, Reasons: []string{"No storage operators are configured"}},
+			}))
+		})
+
+		It("should merge the storage capacity results of the storage operators", func() {
+			storageOperator := func(name string, result api.ValidationResult) *api.MockOperator {
+				operator := mockOperatorBase(name)
+				operator.EXPECT().GetClusterValidationIDs().AnyTimes().Return([]string{name, operatorscommon.StorageCapacityValidationID})
+				operator.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
+					{Status: api.Success, ValidationId: name},
+					result,
+				}, nil)
+				return operator
+			}
+			first := storageOperator("first", api.ValidationResult{
+				Status: api.Success, ValidationId: operatorscommon.StorageCapacityValidationID, Reasons: []string{"first provides enough"},
+			})
+			second := storageOperator("second", api.ValidationResult{
+				Status: api.Failure, ValidationId: operatorscommon.StorageCapacityValidationID, Reasons: []string{"second is short"},
+			})
+			disabled := storageOperator("disabled", api.ValidationResult{})
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil, first, second, disabled)
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				{Name: "first", OperatorType: models.OperatorTypeOlm},
+				{Name: "second", OperatorType: models.OperatorTypeOlm},
+			}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(ConsistOf(
+				api.ValidationResult{Status: api.Success, ValidationId: "first"},
+				api.ValidationResult{Status: api.Success, ValidationId: "second"},
+				api.ValidationResult{Status: api.Success, ValidationId: "disabled", Reasons: []string{"disabled is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: declarative.ClusterValidationID, Reasons: []string{"No declarative operators are configured"}},
+				api.ValidationResult{Status: api.Failure, ValidationId: operatorscommon.StorageCapacityValidationID, Reasons: []string{"first provides enough", "second is short"}},
+			))
+		})
 	})
 
 	Context("ValidateHost", func() {
@@ -664,7 +736,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 1))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
@@ -695,6 +767,8 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", metallb.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLokiRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", loki.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", openshiftlogging.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", sriov.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDDeclarativeOperatorsRequirementsSatisfied), Reasons: []string{"No declarative operators are configured"}},
 			))
 		})
 
@@ -706,7 +780,7 @@ var _ = Describe("Operators manager", func() {
 
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 1))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
@@ -737,6 +811,7 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDMetallbRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", metallb.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLokiRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", loki.Operator.Name)}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOpenshiftLoggingRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", openshiftlogging.Operator.Name)}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDSriovRequirementsSatisfied), Reasons: []string{fmt.Sprintf("%s is disabled", sriov.Operator.Name)}},
 			))
 		})
 
@@ -845,7 +920,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 
 			Expect(err).NotTo(HaveOccurred())
-			Expect(results).To(HaveLen(olmOperatorCount))
+			Expect(results).To(HaveLen(olmOperatorCount + 1))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{
 					Status:       api.Success,
@@ -933,6 +1008,7 @@ var _ = Describe("Operators manager", func() {
 				oadp.Operator.Name,
 				loki.Operator.Name,
 				openshiftlogging.Operator.Name,
+				sriov.Operator.Name,
 			))
 		})
 
@@ -940,7 +1016,275 @@ var _ = Describe("Operators manager", func() {
 			properties, err := manager.GetOperatorProperties("odf")
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(properties).To(BeEquivalentTo(models.OperatorProperties{}))
+			names := make([]string, 0, len(properties))
+			for _, property := range properties {
+				names = append(names, property.Name)
+			}
+			Expect(names).To(ConsistOf(operatorscommon.RequiredCapacityPropertyName, operatorscommon.ReplicaCountPropertyName))
+		})
+
+		It("should provide the schemas of the properties of an operator", func() {
+			properties, err := manager.GetOperatorProperties("lvm")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(properties).To(HaveLen(1))
+			content, err := json.Marshal(properties[0].Schema)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(MatchJSON(`{
+				"type": "integer",
+				"description": "Usable storage capacity in GiB that the workloads require, not checked when not set"
+			}`))
+		})
+
+		It("should fail to provide the properties of an unknown operator", func() {
+			_, err := manager.GetOperatorProperties("unknown")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("EnsureOperatorProperties", func() {
+		var operator *api.MockOperator
+
+		BeforeEach(func() {
+			operator = mockOperatorBase("operator")
+			operator.EXPECT().GetProperties().AnyTimes().Return(models.OperatorProperties{
+				{Name: "replicas", DataType: models.OperatorPropertyDataTypeInteger, DefaultValue: "1"},
+			})
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil, operator)
+		})
+
+		It("should fill the defaults of the properties", func() {
+			monitoredOperators := []*models.MonitoredOperator{{Name: "operator"}}
+			Expect(manager.EnsureOperatorProperties(cluster, monitoredOperators)).To(Succeed())
+			Expect(monitoredOperators[0].Properties).To(BeEmpty())
+			Expect(manager.SetOperatorPropertiesDefaults(cluster, monitoredOperators)).To(Succeed())
+			Expect(monitoredOperators[0].Properties).To(MatchJSON(`{"replicas": 1}`))
+		})
+
+		It("should keep valid properties", func() {
+			monitoredOperators := []*models.MonitoredOperator{{Name: "operator", Properties: `{"replicas": 3}`}}
+			Expect(manager.EnsureOperatorProperties(cluster, monitoredOperators)).To(Succeed())
+			Expect(manager.SetOperatorPropertiesDefaults(cluster, monitoredOperators)).To(Succeed())
+			Expect(monitoredOperators[0].Properties).To(Equal(`{"replicas": 3}`))
+		})
+
+		It("should reject invalid properties", func() {
+			monitoredOperators := []*models.MonitoredOperator{{Name: "operator", Properties: `{"replica": 3}`}}
+			err := manager.EnsureOperatorProperties(cluster, monitoredOperators)
+			Expect(err).To(MatchError("invalid properties of operator operator: replica is a forbidden property"))
+		})
+
+		It("should keep the properties of the operators of the cluster", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "operator", Properties: "replicas=3"}}
+			monitoredOperators := []*models.MonitoredOperator{{Name: "operator", Properties: "replicas=3"}}
+			Expect(manager.EnsureOperatorProperties(cluster, monitoredOperators)).To(Succeed())
+			Expect(manager.SetOperatorPropertiesDefaults(cluster, monitoredOperators)).To(Succeed())
+			Expect(monitoredOperators[0].Properties).To(Equal("replicas=3"))
+		})
+
+		It("should validate the updated properties of the operators of the cluster", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "operator", Properties: "replicas=3"}}
+			monitoredOperators := []*models.MonitoredOperator{{Name: "operator", Properties: "replicas=4"}}
+			Expect(manager.EnsureOperatorProperties(cluster, monitoredOperators)).ToNot(Succeed())
+		})
+
+		It("should accept any properties of operators that don't describe them", func() {
+			freeForm := mockOperatorBase("free-form")
+			freeForm.EXPECT().GetProperties().AnyTimes().Return(models.OperatorProperties{})
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil, freeForm)
+			monitoredOperators := []*models.MonitoredOperator{{Name: "free-form", Properties: "key=value"}}
+			Expect(manager.EnsureOperatorProperties(cluster, monitoredOperators)).To(Succeed())
+			Expect(manager.SetOperatorPropertiesDefaults(cluster, monitoredOperators)).To(Succeed())
+			Expect(monitoredOperators[0].Properties).To(Equal("key=value"))
+		})
+	})
+
+	Context("Subscription overrides", func() {
+		findSubscription := func(manifests map[string][]byte, name string) map[string]interface{} {
+			for _, content := range manifests {
+				for _, document := range regexp.MustCompile(`(?m)^---[ \t]*\n`).Split(string(content), -1) {
+					var object map[string]interface{}
+					Expect(yaml.Unmarshal([]byte(document), &object)).To(Succeed())
+					if object["kind"] != "Subscription" {
+						continue
+					}
+					if metadata, ok := object["metadata"].(map[string]interface{}); ok && (name == "" || metadata["name"] == name) {
+						return object["spec"].(map[string]interface{})
+					}
+				}
+			}
+			return nil
+		}
+
+		It("should apply the overrides to the subscription of every OLM operator", func() {
+			overrides := &models.OperatorSubscription{
+				Channel:             "pinned",
+				StartingCsv:         "pinned.v1.2.3",
+				Source:              "mirrored-operators",
+				SourceNamespace:     "mirror",
+				InstallPlanApproval: models.OperatorSubscriptionInstallPlanApprovalManual,
+			}
+			for _, operator := range manager.GetSupportedOperatorsByType(models.OperatorTypeOlm) {
+				operator.Subscription = overrides
+				cluster.MonitoredOperators = []*models.MonitoredOperator{operator}
+
+				manifests, _, err := manager.GenerateOperatorManifests(cluster, operator)
+				Expect(err).ToNot(HaveOccurred(), operator.Name)
+				spec := findSubscription(manifests, operator.SubscriptionName)
+				Expect(spec).ToNot(BeNil(), operator.Name)
+				Expect(spec).To(HaveKeyWithValue("channel", "pinned"), operator.Name)
+				Expect(spec).To(HaveKeyWithValue("startingCSV", "pinned.v1.2.3"), operator.Name)
+				Expect(spec).To(HaveKeyWithValue("source", "mirrored-operators"), operator.Name)
+				Expect(spec).To(HaveKeyWithValue("sourceNamespace", "mirror"), operator.Name)
+				Expect(spec).To(HaveKeyWithValue("installPlanApproval", "Manual"), operator.Name)
+			}
+		})
+
+		It("should keep the fields that aren't overridden", func() {
+			operator, err := manager.GetOperatorByName(lso.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			cluster.MonitoredOperators = []*models.MonitoredOperator{operator}
+			manifests, _, err := manager.GenerateOperatorManifests(cluster, operator)
+			Expect(err).ToNot(HaveOccurred())
+			original := findSubscription(manifests, operator.SubscriptionName)
+
+			operator.Subscription = &models.OperatorSubscription{Channel: "pinned"}
+			manifests, _, err = manager.GenerateOperatorManifests(cluster, operator)
+			Expect(err).ToNot(HaveOccurred())
+			spec := findSubscription(manifests, operator.SubscriptionName)
+			Expect(spec["channel"]).To(Equal("pinned"))
+			Expect(spec["source"]).To(Equal(original["source"]))
+			Expect(spec["sourceNamespace"]).To(Equal(original["sourceNamespace"]))
+		})
+
+		It("should accept valid overrides", func() {
+			operator, err := manager.GetOperatorByName(lso.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			operator.Subscription = &models.OperatorSubscription{
+				Channel:     "stable-4.14",
+				StartingCsv: "local-storage-operator.v4.14.0-202401151553",
+				Source:      "redhat-operators",
+			}
+			Expect(manager.EnsureOperatorPrerequisite(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, []*models.MonitoredOperator{operator})).To(Succeed())
+		})
+
+		It("should reject the overrides of operators that aren't supported for the cluster", func() {
+			operator, err := manager.GetOperatorByName(odf.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			operator.Subscription = &models.OperatorSubscription{Channel: "stable-4.14"}
+			err = manager.EnsureSubscriptionOverrides(cluster, cluster.OpenshiftVersion, models.ClusterCPUArchitectureArm64, []*models.MonitoredOperator{operator})
+			Expect(err).To(MatchError(ContainSubstring("the operator isn't supported")))
+		})
+
+		It("should reject the overrides of operators that aren't OLM operators", func() {
+			monitoredOperators := []*models.MonitoredOperator{{
+				Name:         "console",
+				OperatorType: models.OperatorTypeBuiltin,
+				Subscription: &models.OperatorSubscription{Channel: "stable"},
+			}}
+			err := manager.EnsureSubscriptionOverrides(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, monitoredOperators)
+			Expect(err).To(MatchError(ContainSubstring("isn't an OLM operator")))
+		})
+
+		DescribeTable("should reject invalid overrides", func(overrides models.OperatorSubscription) {
+			operator, err := manager.GetOperatorByName(lso.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			operator.Subscription = &overrides
+			err = manager.EnsureSubscriptionOverrides(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, []*models.MonitoredOperator{operator})
+			Expect(err).To(MatchError(ContainSubstring("invalid subscription overrides of operator lso")))
+		},
+			Entry("channel", models.OperatorSubscription{Channel: "stable 4.14"}),
+			Entry("starting CSV without version", models.OperatorSubscription{StartingCsv: "local-storage-operator"}),
+			Entry("starting CSV with invalid version", models.OperatorSubscription{StartingCsv: "local-storage-operator.vlatest"}),
+			Entry("catalog source", models.OperatorSubscription{Source: "Red Hat Operators"}),
+			Entry("catalog source namespace", models.OperatorSubscription{Source: "redhat-operators", SourceNamespace: "openshift.marketplace"}),
+			Entry("catalog source namespace without source", models.OperatorSubscription{SourceNamespace: "openshift-marketplace"}),
+			Entry("install plan approval", models.OperatorSubscription{InstallPlanApproval: "Always"}),
+		)
+
+		DescribeTable("should reject overrides that don't match the operator", func(operatorName string, overrides models.OperatorSubscription, message string) {
+			operator, err := manager.GetOperatorByName(operatorName)
+			Expect(err).ToNot(HaveOccurred())
+			operator.Subscription = &overrides
+			err = manager.EnsureSubscriptionOverrides(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, []*models.MonitoredOperator{operator})
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+			Entry("starting CSV of another package", lso.Operator.Name,
+				models.OperatorSubscription{StartingCsv: "odf-operator.v4.14.0"}, "doesn't belong to package local-storage-operator"),
+			Entry("starting CSV of a package with the same prefix", lso.Operator.Name,
+				models.OperatorSubscription{StartingCsv: "local-storage.v4.14.0"}, "doesn't belong to package local-storage-operator"),
+			Entry("channel of another OpenShift version", lso.Operator.Name,
+				models.OperatorSubscription{Channel: "stable-4.16"}, "channel 'stable-4.16' isn't supported by OpenShift version 4.14.0"),
+			Entry("starting CSV of another OpenShift version", odf.Operator.Name,
+				models.OperatorSubscription{StartingCsv: "odf-operator.v4.12.3"}, "starting CSV 'odf-operator.v4.12.3' isn't supported by OpenShift version 4.14.0"),
+		)
+
+		It("should accept a channel and a starting CSV of a supported version", func() {
+			operator, err := manager.GetOperatorByName(odf.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			operator.Subscription = &models.OperatorSubscription{Channel: "stable-4.14", StartingCsv: "odf-operator.v4.14.6-rhodf"}
+			Expect(manager.EnsureSubscriptionOverrides(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, []*models.MonitoredOperator{operator})).To(Succeed())
+		})
+	})
+
+	Context("Failure policies", func() {
+		It("should accept valid failure policies", func() {
+			required, err := manager.GetOperatorByName(lso.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			required.FailurePolicy = models.OperatorFailurePolicyRequired
+			retry, err := manager.GetOperatorByName(odf.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			retry.FailurePolicy = models.OperatorFailurePolicyRetry
+			retry.MaxRetries = 5
+			Expect(manager.EnsureFailurePolicies([]*models.MonitoredOperator{required, retry})).To(Succeed())
+		})
+
+		It("should reject the failure policies of operators that aren't OLM operators", func() {
+			monitoredOperators := []*models.MonitoredOperator{{
+				Name:          "console",
+				OperatorType:  models.OperatorTypeBuiltin,
+				FailurePolicy: models.OperatorFailurePolicyBestEffort,
+			}}
+			err := manager.EnsureFailurePolicies(monitoredOperators)
+			Expect(err).To(MatchError(ContainSubstring("isn't an OLM operator")))
+		})
+
+		DescribeTable("should reject invalid failure policies", func(policy models.OperatorFailurePolicy, maxRetries int64, message string) {
+			operator, err := manager.GetOperatorByName(lso.Operator.Name)
+			Expect(err).ToNot(HaveOccurred())
+			operator.FailurePolicy = policy
+			operator.MaxRetries = maxRetries
+			err = manager.EnsureFailurePolicies([]*models.MonitoredOperator{operator})
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+			Entry("unknown policy", models.OperatorFailurePolicy("ignore"), int64(0), "invalid failure policy of operator lso"),
+			Entry("max retries without policy", models.OperatorFailurePolicy(""), int64(2), "max retries can only be set with the retry failure policy"),
+			Entry("max retries with required policy", models.OperatorFailurePolicyRequired, int64(2), "max retries can only be set with the retry failure policy"),
+			Entry("negative max retries", models.OperatorFailurePolicyRetry, int64(-1), "max retries must be positive"),
+		)
+
+		DescribeTable("should retry failed operators according to their failure policy", func(operator models.MonitoredOperator, expected bool) {
+			Expect(operators.ShouldRetry(&operator)).To(Equal(expected))
+		},
+			Entry("default policy", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm}, false),
+			Entry("required policy", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm, FailurePolicy: models.OperatorFailurePolicyRequired}, false),
+			Entry("retry policy with default max retries", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm, FailurePolicy: models.OperatorFailurePolicyRetry, Retries: 2}, true),
+			Entry("retry policy with exhausted default max retries", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm, FailurePolicy: models.OperatorFailurePolicyRetry, Retries: 3}, false),
+			Entry("retry policy with max retries", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm, FailurePolicy: models.OperatorFailurePolicyRetry, MaxRetries: 1}, true),
+			Entry("retry policy with exhausted max retries", models.MonitoredOperator{OperatorType: models.OperatorTypeOlm, FailurePolicy: models.OperatorFailurePolicyRetry, MaxRetries: 1, Retries: 1}, false),
+			Entry("builtin operator", models.MonitoredOperator{OperatorType: models.OperatorTypeBuiltin, FailurePolicy: models.OperatorFailurePolicyRetry}, false),
+		)
+
+		It("should keep the failure policy when only the max retries are set", func() {
+			operator := &models.MonitoredOperator{FailurePolicy: models.OperatorFailurePolicyRetry}
+			operators.SetFailurePolicy(operator, &models.OperatorCreateParams{MaxRetries: 5})
+			Expect(operator.FailurePolicy).To(Equal(models.OperatorFailurePolicyRetry))
+			Expect(operator.MaxRetries).To(BeEquivalentTo(5))
+
+			operators.SetFailurePolicy(operator, &models.OperatorCreateParams{FailurePolicy: models.OperatorFailurePolicyRequired})
+			Expect(operator.FailurePolicy).To(Equal(models.OperatorFailurePolicyRequired))
+			Expect(operator.MaxRetries).To(BeZero())
 		})
 	})
 
@@ -995,6 +1339,103 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("ExplainDependencies", func() {
+		BeforeEach(func() {
+			cluster.OpenshiftVersion = "4.16.0"
+			cluster.CPUArchitecture = models.ClusterCPUArchitectureX8664
+			cluster.ControlPlaneCount = 3
+			cluster.Platform = &models.Platform{Type: models.PlatformTypeBaremetal.Pointer()}
+		})
+
+		It("should explain why dependencies are added", func() {
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{odf.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Operators).To(Equal([]*models.ResolvedOperator{
+				{Name: odf.Operator.Name, Reason: "Requested"},
+				{Name: lso.Operator.Name, DependencyOnly: true, RequiredBy: []string{odf.Operator.Name}, Reason: "Required by odf"},
+			}))
+			Expect(resolution.Conflicts).To(BeEmpty())
+		})
+
+		It("should keep requested operators that other operators depend on", func() {
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{lso.Operator.Name, odf.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Operators).To(Equal([]*models.ResolvedOperator{
+				{Name: lso.Operator.Name, RequiredBy: []string{odf.Operator.Name}, Reason: "Requested, and required by odf"},
+				{Name: odf.Operator.Name, Reason: "Requested"},
+			}))
+		})
+
+		It("should explain the operators that ResolveDependencies returns", func() {
+			for _, operator := range manager.GetSupportedOperatorsByType(models.OperatorTypeOlm) {
+				resolved, err := manager.ResolveDependencies(cluster, []*models.MonitoredOperator{operator})
+				Expect(err).ToNot(HaveOccurred(), operator.Name)
+				resolution, err := manager.ExplainDependencies(ctx, cluster, []string{operator.Name})
+				Expect(err).ToNot(HaveOccurred(), operator.Name)
+				Expect(resolution.Operators).To(HaveLen(len(resolved)), operator.Name)
+				for i := range resolved {
+					Expect(resolution.Operators[i].Name).To(Equal(resolved[i].Name), operator.Name)
+					Expect(resolution.Operators[i].DependencyOnly).To(Equal(resolved[i].DependencyOnly), operator.Name)
+					if resolved[i].DependencyOnly {
+						Expect(resolution.Operators[i].RequiredBy).ToNot(BeEmpty(), operator.Name)
+					}
+				}
+			}
+		})
+
+		It("should fail for unknown operators", func() {
+			_, err := manager.ExplainDependencies(ctx, cluster, []string{"unknown"})
+			Expect(err).To(MatchError("operator unknown isn't supported"))
+		})
+
+		It("should report operators that clash with each other", func() {
+			cluster.OpenshiftVersion = "4.11.0"
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{lvm.Operator.Name, cnv.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Conflicts).To(ContainElement(&models.OperatorConflict{
+				Constraint: models.OperatorConflictConstraintOperators,
+				Operators:  []string{"lvm", "cnv"},
+				Message:    "Currently, you can not install Logical Volume Manager operator at the same time as Virtualization operator.",
+			}))
+		})
+
+		It("should report operators that the platform doesn't support", func() {
+			cluster.Platform = &models.Platform{Type: models.PlatformTypeNutanix.Pointer()}
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{cnv.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Conflicts).To(Equal([]*models.OperatorConflict{{
+				Constraint: models.OperatorConflictConstraintPlatform,
+				Operators:  []string{cnv.Operator.Name},
+				Message:    "OpenShift Virtualization is not available on platform nutanix",
+			}}))
+		})
+
+		It("should report operators that the CPU architecture doesn't support", func() {
+			cluster.CPUArchitecture = models.ClusterCPUArchitecturePpc64le
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{cnv.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Conflicts).To(Equal([]*models.OperatorConflict{{
+				Constraint: models.OperatorConflictConstraintCPUArchitecture,
+				Operators:  []string{cnv.Operator.Name},
+				Message:    "OpenShift Virtualization is not available when ppc64le CPU architecture is selected",
+			}}))
+		})
+
+		It("should add up the host requirements of the operators", func() {
+			resolution, err := manager.ExplainDependencies(ctx, cluster, []string{odf.Operator.Name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolution.Requirements).To(HaveLen(2))
+			var masterCPU, masterRAM int64
+			for _, requirements := range resolution.Requirements {
+				masterCPU += requirements.Requirements.Master.Quantitative.CPUCores
+				masterRAM += requirements.Requirements.Master.Quantitative.RAMMib
+			}
+			Expect(masterCPU).ToNot(BeZero())
+			Expect(resolution.TotalRequirements.Master.Quantitative.CPUCores).To(Equal(masterCPU))
+			Expect(resolution.TotalRequirements.Master.Quantitative.RAMMib).To(Equal(masterRAM))
+		})
+	})
+
 	Context("Preflight requirements", func() {
 		const (
 			operatorName1 = "operator-1"
@@ -1203,6 +1644,90 @@ var _ = Describe("Operators manager", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("not a valid optional operator"))
 		})
+
+		Context("defined bundles", func() {
+			var definitions []*operators.BundleDefinition
+
+			BeforeEach(func() {
+				var err error
+				definitions, err = operators.ParseBundleDefinitions(`[{
+					"id": "storage-heavy",
+					"title": "Storage heavy",
+					"description": "Local and software defined storage.",
+					"operators": [{"name": "odf", "properties": "{}"}, {"name": "lso", "subscription": {"channel": "stable"}}],
+					"optional_operators": [{"name": "nmstate"}]
+				}]`)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should be listed after the built-in bundles", func() {
+				Expect(manager.AddBundleDefinitions(definitions)).To(Succeed())
+				filter := &featuresupport.SupportLevelFilters{
+					OpenshiftVersion: "4.14.0",
+					CPUArchitecture:  swag.String(models.ClusterCPUArchitectureX8664),
+				}
+
+				bundles := manager.ListBundles(filter, nil)
+				Expect(bundles).To(HaveLen(3))
+				Expect(bundles[2]).To(Equal(&models.Bundle{
+					ID:                "storage-heavy",
+					Title:             "Storage heavy",
+					Description:       "Local and software defined storage.",
+					Operators:         []string{"lso", "odf"},
+					OptionalOperators: []string{"nmstate"},
+				}))
+			})
+
+			It("should be filtered by feature support", func() {
+				Expect(manager.AddBundleDefinitions(definitions)).To(Succeed())
+				filter := &featuresupport.SupportLevelFilters{
+					OpenshiftVersion: "4.14.0",
+					CPUArchitecture:  swag.String(models.ClusterCPUArchitectureArm64),
+				}
+
+				for _, bundle := range manager.ListBundles(filter, nil) {
+					Expect(bundle.ID).ToNot(Equal("storage-heavy"))
+				}
+			})
+
+			It("should be expanded with the preset properties and subscriptions", func() {
+				Expect(manager.AddBundleDefinitions(definitions)).To(Succeed())
+
+				ops, err := manager.ExpandBundleOperators("storage-heavy", []string{"nmstate"}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ops).To(Equal([]*models.OperatorCreateParams{
+					{Name: "lso", Subscription: &models.OperatorSubscription{Channel: "stable"}},
+					{Name: "odf", Properties: "{}"},
+					{Name: "nmstate"},
+				}))
+			})
+
+			It("should parse no definitions", func() {
+				definitions, err := operators.ParseBundleDefinitions("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(definitions).To(BeEmpty())
+			})
+
+			DescribeTable("should reject invalid definitions", func(definition string) {
+				definitions, err := operators.ParseBundleDefinitions("[" + definition + "]")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(manager.AddBundleDefinitions(definitions)).ToNot(Succeed())
+			},
+				Entry("invalid identifier", `{"id": "Storage", "title": "Storage", "operators": [{"name": "lso"}]}`),
+				Entry("built-in identifier", `{"id": "virtualization", "title": "Virtualization", "operators": [{"name": "lso"}]}`),
+				Entry("missing title", `{"id": "storage", "operators": [{"name": "lso"}]}`),
+				Entry("no operators", `{"id": "storage", "title": "Storage", "operators": []}`),
+				Entry("unknown operator", `{"id": "storage", "title": "Storage", "operators": [{"name": "unknown"}]}`),
+				Entry("duplicate operator", `{"id": "storage", "title": "Storage", "operators": [{"name": "lso"}], "optional_operators": [{"name": "lso"}]}`),
+				Entry("invalid properties", `{"id": "storage", "title": "Storage", "operators": [{"name": "odf", "properties": "{\"replica_count\": 5}"}]}`),
+				Entry("invalid subscription", `{"id": "storage", "title": "Storage", "operators": [{"name": "lso", "subscription": {"channel": "stable 4.14"}}]}`),
+			)
+
+			It("should reject definitions of the same bundle", func() {
+				Expect(manager.AddBundleDefinitions(definitions)).To(Succeed())
+				Expect(manager.AddBundleDefinitions(definitions)).ToNot(Succeed())
+			})
+		})
 	})
 })
 
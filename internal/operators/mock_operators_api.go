@@ -72,6 +72,20 @@ func (mr *MockAPIMockRecorder) EnsureOperatorPrerequisite(cluster, openshiftVers
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOperatorPrerequisite", reflect.TypeOf((*MockAPI)(nil).EnsureOperatorPrerequisite), cluster, openshiftVersion, cpuArchitecture, operators)
 }
 
+// EnsureOperatorProperties mocks base method.
+func (m *MockAPI) EnsureOperatorProperties(cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureOperatorProperties", cluster, operators)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureOperatorProperties indicates an expected call of EnsureOperatorProperties.
+func (mr *MockAPIMockRecorder) EnsureOperatorProperties(cluster, operators any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOperatorProperties", reflect.TypeOf((*MockAPI)(nil).EnsureOperatorProperties), cluster, operators)
+}
+
 // ExpandBundleOperators mocks base method.
 func (m *MockAPI) ExpandBundleOperators(bundleID string, optionalOperators []string, featureIDs []models.FeatureSupportLevelID) ([]*models.OperatorCreateParams, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +101,21 @@ func (mr *MockAPIMockRecorder) ExpandBundleOperators(bundleID, optionalOperators
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandBundleOperators", reflect.TypeOf((*MockAPI)(nil).ExpandBundleOperators), bundleID, optionalOperators, featureIDs)
 }
 
+// ExplainDependencies mocks base method.
+func (m *MockAPI) ExplainDependencies(ctx context.Context, cluster *common.Cluster, operatorNames []string) (*models.OperatorsResolution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainDependencies", ctx, cluster, operatorNames)
+	ret0, _ := ret[0].(*models.OperatorsResolution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDependencies indicates an expected call of ExplainDependencies.
+func (mr *MockAPIMockRecorder) ExplainDependencies(ctx, cluster, operatorNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDependencies", reflect.TypeOf((*MockAPI)(nil).ExplainDependencies), ctx, cluster, operatorNames)
+}
+
 // GenerateManifests mocks base method.
 func (m *MockAPI) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -101,6 +130,22 @@ func (mr *MockAPIMockRecorder) GenerateManifests(ctx, cluster any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateManifests", reflect.TypeOf((*MockAPI)(nil).GenerateManifests), ctx, cluster)
 }
 
+// GenerateOperatorManifests mocks base method.
+func (m *MockAPI) GenerateOperatorManifests(cluster *common.Cluster, operator *models.MonitoredOperator) (map[string][]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateOperatorManifests", cluster, operator)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateOperatorManifests indicates an expected call of GenerateOperatorManifests.
+func (mr *MockAPIMockRecorder) GenerateOperatorManifests(cluster, operator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateOperatorManifests", reflect.TypeOf((*MockAPI)(nil).GenerateOperatorManifests), cluster, operator)
+}
+
 // GetBundle mocks base method.
 func (m *MockAPI) GetBundle(bundleID string, featureIDs []models.FeatureSupportLevelID) (*models.Bundle, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +306,20 @@ func (mr *MockAPIMockRecorder) ResolveDependencies(cluster, operators any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDependencies", reflect.TypeOf((*MockAPI)(nil).ResolveDependencies), cluster, operators)
 }
 
+// SetOperatorPropertiesDefaults mocks base method.
+func (m *MockAPI) SetOperatorPropertiesDefaults(cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOperatorPropertiesDefaults", cluster, operators)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOperatorPropertiesDefaults indicates an expected call of SetOperatorPropertiesDefaults.
+func (mr *MockAPIMockRecorder) SetOperatorPropertiesDefaults(cluster, operators any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorPropertiesDefaults", reflect.TypeOf((*MockAPI)(nil).SetOperatorPropertiesDefaults), cluster, operators)
+}
+
 // ValidateCluster mocks base method.
 func (m *MockAPI) ValidateCluster(ctx context.Context, cluster *common.Cluster) ([]api.ValidationResult, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +144,12 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDNMSTATE
 }
 
+// GetSupportedSubscriptionVersions returns the versions of the operator that are supported by the OpenShift version,
+// as its releases follow the OpenShift releases
+func (o *operator) GetSupportedSubscriptionVersions(openshiftVersion string) []string {
+	return operatorscommon.OpenshiftSubscriptionVersions(openshiftVersion)
+}
+
 // GetBundleLabels returns the bundle labels for the operator
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	return []string(Operator.Bundles)
@@ -10,4 +10,5 @@ type Config struct {
 	ODFPerHostCPUStandardMode       int64 `envconfig:"ODF_PER_HOST_CPU_STANDARD_MODE" default:"8"`
 	ODFPerHostMemoryGiBStandardMode int64 `envconfig:"ODF_PER_HOST_MEMORY_GIB_STANDARD_MODE" default:"19"`
 	ODFMinDiskSizeGB                int64 `envconfig:"ODF_MIN_DISK_SIZE_GB" default:"25"`
+	ODFUsableCapacityPercent        int64 `envconfig:"ODF_USABLE_CAPACITY_PERCENT" default:"85"` // Ceph warns when it is 85% full
 }
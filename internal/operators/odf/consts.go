@@ -9,6 +9,8 @@ const (
 	standardMode odfDeploymentMode = "Standard" // at least 3 masters and 3 workers, workers will run ODF
 	unknown      odfDeploymentMode = "Unknown"  // none of the above, the mode is not determined yet
 
+	defaultReplicaCount = 3 // the replication of the Ceph pools when the replica count isn't set
+
 	clusterValidationID = string(models.ClusterValidationIDOdfRequirementsSatisfied)
 	hostValidationID    = string(models.HostValidationIDOdfRequirementsSatisfied)
 )
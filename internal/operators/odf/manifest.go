@@ -8,11 +8,12 @@ import (
 )
 
 type storageInfo struct {
-	ODFDisks int64
+	ODFDisks     int64
+	ReplicaCount int64
 }
 
-func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts int64) ([]byte, error) {
-	info := &storageInfo{ODFDisks: odfDiskCounts}
+func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts int64, replicaCount int64) ([]byte, error) {
+	info := &storageInfo{ODFDisks: odfDiskCounts, ReplicaCount: replicaCount}
 	tmpl, err := template.New("OcsStorageCluster").Parse(StorageClusterManifest)
 	if err != nil {
 		return nil, err
@@ -28,18 +29,20 @@ func generateStorageClusterManifest(StorageClusterManifest string, odfDiskCounts
 
 }
 
-func Manifests(mode odfDeploymentMode, numberOfDisks int64, openshiftVersion string) (map[string][]byte, []byte, error) {
+// Manifests generates the manifests of ODF. A non-zero replica count sets the replication of the block pool of the
+// StorageCluster, which otherwise keeps the Ceph default of 3 replicas.
+func Manifests(mode odfDeploymentMode, numberOfDisks int64, replicaCount int64, openshiftVersion string) (map[string][]byte, []byte, error) {
 	openshiftManifests := make(map[string][]byte)
 	var odfSC []byte
 	var err error
 
 	if mode == compactMode {
-		odfSC, err = generateStorageClusterManifest(ocsMinDeploySC, numberOfDisks)
+		odfSC, err = generateStorageClusterManifest(ocsMinDeploySC, numberOfDisks, replicaCount)
 		if err != nil {
 			return nil, nil, err
 		}
 	} else { // use the ODF CR with labelSelector to deploy ODF on only worker nodes
-		odfSC, err = generateStorageClusterManifest(ocsSc, numberOfDisks)
+		odfSC, err = generateStorageClusterManifest(ocsSc, numberOfDisks, replicaCount)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -203,6 +206,13 @@ spec:
         cpu: "1"
         memory: "4Gi"
   monDataDirHostPath: /var/lib/rook
+{{- if .ReplicaCount}}
+  managedResources:
+    cephBlockPools:
+      poolSpec:
+        replicated:
+          size: {{.ReplicaCount}}
+{{- end}}
   storageDeviceSets:
     - count: {{.ODFDisks}}
       dataPVCTemplate:
@@ -284,6 +294,13 @@ spec:
   manageNodes: false
   flexibleScaling: true
   monDataDirHostPath: /var/lib/rook
+{{- if .ReplicaCount}}
+  managedResources:
+    cephBlockPools:
+      poolSpec:
+        replicated:
+          size: {{.ReplicaCount}}
+{{- end}}
 
   storageDeviceSets:
 
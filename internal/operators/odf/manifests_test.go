@@ -1,6 +1,7 @@
 package odf
 
 import (
+	"fmt"
 	"strings"
 
 	. "github.com/onsi/ginkgo"
@@ -17,6 +18,15 @@ var _ = Describe("OCS manifest generation", func() {
 			StorageDeviceSets []struct {
 				Count int `yaml:"count"`
 			} `yaml:"storageDeviceSets"`
+			ManagedResources *struct {
+				CephBlockPools struct {
+					PoolSpec struct {
+						Replicated struct {
+							Size int `yaml:"size"`
+						} `yaml:"replicated"`
+					} `yaml:"poolSpec"`
+				} `yaml:"cephBlockPools"`
+			} `yaml:"managedResources"`
 		} `yaml:"spec"`
 	}
 
@@ -291,4 +301,33 @@ var _ = Describe("OCS manifest generation", func() {
 			Expect(string(manifest)).NotTo(ContainSubstring("kind: StorageSystem"))
 		})
 	})
+
+	Context("Replica count", func() {
+		storageCluster := func(replicaCount int64, mode odfDeploymentMode) StorageCluster {
+			_, manifest, err := Manifests(mode, 3, replicaCount, "4.18.0")
+			Expect(err).ShouldNot(HaveOccurred())
+			var result StorageCluster
+			for _, yamlDoc := range strings.Split(string(manifest), "\n---\n") {
+				if strings.Contains(yamlDoc, "kind: StorageCluster") {
+					Expect(yaml.Unmarshal([]byte(yamlDoc), &result)).To(Succeed())
+				}
+			}
+			Expect(result.Spec.StorageDeviceSets).To(HaveLen(1))
+			return result
+		}
+
+		for _, mode := range []odfDeploymentMode{compactMode, standardMode} {
+			mode := mode
+
+			It(fmt.Sprintf("should set the replication of the block pool in %s mode", mode), func() {
+				result := storageCluster(2, mode)
+				Expect(result.Spec.ManagedResources).ToNot(BeNil())
+				Expect(result.Spec.ManagedResources.CephBlockPools.PoolSpec.Replicated.Size).To(Equal(2))
+			})
+
+			It(fmt.Sprintf("should keep the default replication when the replica count isn't set in %s mode", mode), func() {
+				Expect(storageCluster(0, mode).Spec.ManagedResources).To(BeNil())
+			})
+		}
+	})
 })
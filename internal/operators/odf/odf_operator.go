@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -90,7 +91,7 @@ func (o *operator) GetDependenciesFeatureSupportID() []models.FeatureSupportLeve
 
 // GetClusterValidationIDs returns cluster validation IDs for the Operator
 func (o *operator) GetClusterValidationIDs() []string {
-	return []string{clusterValidationID}
+	return []string{clusterValidationID, operatorscommon.StorageCapacityValidationID}
 }
 
 // GetHostValidationID returns host validation ID for the Operator
@@ -110,7 +111,12 @@ func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (
 		result[0].Reasons = []string{message}
 	}
 
-	return result, nil
+	capacityResult, err := o.validateCapacity(&cluster.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, capacityResult), nil
 }
 
 func (o *operator) StorageClassName() string {
@@ -220,13 +226,28 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 		return nil, nil, err
 	}
 
+	properties, err := operatorscommon.ParseStorageCapacityProperties(cluster.MonitoredOperators, Operator.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	o.log.Info("No. of ODF eligible disks in cluster ", cluster.ID, " are ", odfClusterResources.numberOfDisks)
-	return Manifests(mode, odfClusterResources.numberOfDisks, cluster.OpenshiftVersion)
+	return Manifests(mode, odfClusterResources.numberOfDisks, properties.ReplicaCount, cluster.OpenshiftVersion)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties: the usable capacity that the workloads require and
+// the number of replicas of the data in the block pool of the StorageCluster
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return models.OperatorProperties{
+		operatorscommon.RequiredCapacityProperty(),
+		{
+			Name:         operatorscommon.ReplicaCountPropertyName,
+			DataType:     models.OperatorPropertyDataTypeInteger,
+			Description:  "Number of replicas of the data, each one on a different host",
+			Options:      []string{"2", "3"},
+			DefaultValue: strconv.Itoa(defaultReplicaCount),
+		},
+	}
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the ODF Operator
@@ -324,6 +345,12 @@ func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDODF
 }
 
+// GetSupportedSubscriptionVersions returns the versions of the operator that are supported by the OpenShift version,
+// as its releases follow the OpenShift releases
+func (o *operator) GetSupportedSubscriptionVersions(openshiftVersion string) []string {
+	return operatorscommon.OpenshiftSubscriptionVersions(openshiftVersion)
+}
+
 // GetBundleLabels returns the bundle labels for the ODF operator
 func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
 	// For SNO feature, exclude from openshift-ai bundle
@@ -965,12 +965,17 @@ var _ = Describe("Odf Operator", func() {
 	})
 
 	Context("ValidateCluster", func() {
+		capacityNotRequired := api.ValidationResult{
+			Status:       api.Success,
+			ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+		}
+
 		table.DescribeTable(
 			"unknown mode scenario: validateCluster when",
 
 			func(cluster *common.Cluster, expectedResult []api.ValidationResult) {
 				result, _ := operator.ValidateCluster(ctx, cluster)
-				Expect(result).To(Equal(expectedResult))
+				Expect(result).To(Equal(append(expectedResult, capacityNotRequired)))
 			},
 
 			table.Entry("there is a single master",
@@ -1045,7 +1050,7 @@ var _ = Describe("Odf Operator", func() {
 
 			func(cluster *common.Cluster, expectedResult []api.ValidationResult) {
 				result, _ := operator.ValidateCluster(ctx, cluster)
-				Expect(result).To(Equal(expectedResult))
+				Expect(result).To(Equal(append(expectedResult, capacityNotRequired)))
 			},
 
 			table.Entry("there are 2 masters and 1 auto-assign",
@@ -1187,7 +1192,7 @@ var _ = Describe("Odf Operator", func() {
 
 			func(cluster *common.Cluster, expectedResult []api.ValidationResult) {
 				result, _ := operator.ValidateCluster(ctx, cluster)
-				Expect(result).To(Equal(expectedResult))
+				Expect(result).To(Equal(append(expectedResult, capacityNotRequired)))
 			},
 
 			// Will fail host validation
@@ -1389,5 +1394,96 @@ var _ = Describe("Odf Operator", func() {
 				}},
 			),
 		)
+
+		table.DescribeTable(
+			"storage capacity: validateCluster when",
+
+			func(properties string, workers []*models.Host, expectedResult api.ValidationResult) {
+				hosts := append([]*models.Host{masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk}, workers...)
+				cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: hosts,
+					MonitoredOperators: []*models.MonitoredOperator{{Name: Operator.Name, Properties: properties}},
+				}}
+				result, err := operator.ValidateCluster(ctx, cluster)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(HaveLen(2))
+				Expect(result[1]).To(Equal(expectedResult))
+			},
+
+			table.Entry("the capacity isn't required",
+				`{"replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithThreeDisk},
+				capacityNotRequired,
+			),
+
+			table.Entry("the workers provide the required capacity",
+				`{"required_capacity_gib": 60, "replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithThreeDisk},
+				api.ValidationResult{
+					Status:       api.Success,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"ODF with 3 replicas provides 63.33 GiB of usable capacity, which satisfies the required 60 GiB."},
+				},
+			),
+
+			table.Entry("the workers don't provide the required capacity",
+				`{"required_capacity_gib": 100, "replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithThreeDisk},
+				api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"ODF with 3 replicas provides 63.33 GiB of usable capacity, 36.67 GiB less than the required 100 GiB."},
+				},
+			),
+
+			table.Entry("fewer replicas don't provide the required capacity",
+				`{"required_capacity_gib": 100, "replica_count": 2}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithThreeDisk},
+				api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"ODF with 2 replicas provides 94.99 GiB of usable capacity, 5.01 GiB less than the required 100 GiB."},
+				},
+			),
+
+			table.Entry("the replica count isn't set",
+				`{"required_capacity_gib": 60}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithThreeDisk},
+				api.ValidationResult{
+					Status:       api.Success,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"ODF with 3 replicas provides 63.33 GiB of usable capacity, which satisfies the required 60 GiB."},
+				},
+			),
+
+			table.Entry("there are fewer workers with eligible disks than replicas",
+				`{"required_capacity_gib": 60, "replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithNoDisk},
+				api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"ODF requires 3 hosts with eligible disks to place 3 replicas of the data, but there are 2."},
+				},
+			),
+
+			table.Entry("one of the workers is missing inventory",
+				`{"required_capacity_gib": 60, "replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk, workerWithThreeDisk, workerWithNoInventory},
+				api.ValidationResult{
+					Status:       api.Pending,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"Missing Inventory in some of the hosts"},
+				},
+			),
+
+			table.Entry("the hosts that will run ODF aren't known",
+				`{"required_capacity_gib": 60, "replica_count": 3}`,
+				[]*models.Host{workerWithThreeDisk},
+				api.ValidationResult{
+					Status:       api.Pending,
+					ValidationId: string(models.ClusterValidationIDStorageCapacityRequirementsSatisfied),
+					Reasons:      []string{"The usable capacity of ODF can't be computed until the hosts that will run ODF are known."},
+				},
+			),
+		)
 	})
 })
@@ -7,6 +7,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -14,6 +15,7 @@ type odfClusterResourcesInfo struct {
 	numberOfDisks    int64 //number of Valid disks in the cluster
 	hostsWithDisks   int64 //number of hosts with Valid disk in the cluster
 	missingInventory bool  //checks for the missing inventory
+	capacityBytes    int64 //raw capacity of the Valid disks in the cluster
 }
 
 func (o *operator) validateRequirements(cluster *models.Cluster) (api.ValidationStatus, string) {
@@ -100,11 +102,64 @@ func (o *operator) computeNodeResourceUtil(
 	if diskCount > 0 {
 		odfClusterResources.numberOfDisks += diskCount
 		odfClusterResources.hostsWithDisks++
+		odfClusterResources.capacityBytes += operatorscommon.EligibleDisksSizeBytes(inventory.Disks, host.InstallationDiskID, o.getMinDiskSizeGB(0))
 	}
 
 	return status, nil
 }
 
+// validateCapacity checks that ODF provides the usable capacity that the user requires. Every replica of the data is
+// placed on a different host, and ODF keeps part of the raw capacity free for rebalancing and recovery.
+func (o *operator) validateCapacity(cluster *models.Cluster) (api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: operatorscommon.StorageCapacityValidationID,
+	}
+	properties, err := operatorscommon.ParseStorageCapacityProperties(cluster.MonitoredOperators, Operator.Name)
+	if err != nil {
+		return result, err
+	}
+	if properties.RequiredCapacityGiB <= 0 {
+		return result, nil
+	}
+	replicas := properties.ReplicaCount
+	if replicas <= 0 {
+		replicas = defaultReplicaCount
+	}
+
+	mode := getODFDeploymentMode(cluster, o.config.ODFNumMinimumHosts)
+	if mode == unknown {
+		result.Status = api.Pending
+		result.Reasons = []string{"The usable capacity of ODF can't be computed until the hosts that will run ODF are known."}
+		return result, nil
+	}
+
+	odfClusterResources := &odfClusterResourcesInfo{}
+	status, err := o.computeResourcesAllNodes(cluster, odfClusterResources, mode)
+	if err != nil {
+		if odfClusterResources.missingInventory {
+			result.Status = api.Pending
+		} else {
+			result.Status = api.Failure
+		}
+		result.Reasons = []string{status}
+		return result, nil
+	}
+
+	if odfClusterResources.hostsWithDisks < replicas {
+		result.Status = api.Failure
+		result.Reasons = []string{
+			fmt.Sprintf("ODF requires %d hosts with eligible disks to place %d replicas of the data, but there are %d.",
+				replicas, replicas, odfClusterResources.hostsWithDisks),
+		}
+		return result, nil
+	}
+
+	usableBytes := odfClusterResources.capacityBytes / replicas * o.config.ODFUsableCapacityPercent / 100
+	return operatorscommon.ValidateStorageCapacity(fmt.Sprintf("ODF with %d replicas", replicas), usableBytes,
+		properties.RequiredCapacityGiB), nil
+}
+
 // used to validate resource requirements for ODF
 func (o *operator) canODFBeDeployed(
 	odfClusterResources *odfClusterResourcesInfo,
@@ -0,0 +1,503 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultControlPlaneCount = 3
+	defaultMaxWorkers        = 10
+
+	// The number of layouts grows with the number of profiles and of workers, so they are capped, as they are in the
+	// API, for the callers that don't go through its validation
+	maxControlPlaneCount = 5
+	maxWorkersCap        = 100
+	maxHostProfiles      = 10
+
+	// hardwareSource is the source of the issues about the CPU cores, the RAM and the disk of the hosts
+	hardwareSource = "hardware"
+)
+
+// API plans the hosts that clusters need to run sets of operators
+//
+//go:generate mockgen --build_flags=--mod=mod -package=planner -destination=mock_planner_api.go . API
+type API interface {
+	// PlanCapacity finds the smallest layout of hosts, among the host profiles, that satisfies the requirements of
+	// OpenShift and of the operators
+	PlanCapacity(ctx context.Context, params *models.CapacityPlanParams) (*models.CapacityPlan, error)
+}
+
+// Planner evaluates layouts of hosts against the same host requirements and operator validations as the hosts of
+// real clusters, without storing anything. The hosts of a layout are built from the host profiles, and they are
+// added to a cluster that only exists during the planning.
+type Planner struct {
+	log          logrus.FieldLogger
+	operatorsAPI operators.API
+	hwValidator  hardware.Validator
+}
+
+// NewPlanner creates a new capacity planner
+func NewPlanner(log logrus.FieldLogger, operatorsAPI operators.API, hwValidator hardware.Validator) *Planner {
+	return &Planner{
+		log:          log,
+		operatorsAPI: operatorsAPI,
+		hwValidator:  hwValidator,
+	}
+}
+
+// layout is a candidate set of hosts. All the control plane hosts have the same profile, and so do the workers.
+// Profiles are referenced by their index in the profiles sorted by size.
+type layout struct {
+	profiles          []*models.HostProfile
+	controlPlane      int
+	controlPlaneCount int64
+	worker            int
+	workerCount       int64
+}
+
+func (l layout) String() string {
+	ret := fmt.Sprintf("%d x %s control plane", l.controlPlaneCount, swag.StringValue(l.profiles[l.controlPlane].Name))
+	if l.workerCount > 0 {
+		ret += fmt.Sprintf(", %d x %s workers", l.workerCount, swag.StringValue(l.profiles[l.worker].Name))
+	}
+	return ret
+}
+
+func (p *Planner) PlanCapacity(ctx context.Context, params *models.CapacityPlanParams) (*models.CapacityPlan, error) {
+	log := logutil.FromContext(ctx, p.log)
+
+	cluster, err := p.newCluster(params)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := sortProfiles(params.HostProfiles)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	maxWorkers := swag.Int64Value(params.MaxWorkers)
+	if params.MaxWorkers == nil {
+		maxWorkers = defaultMaxWorkers
+	}
+	if maxWorkers < 0 || maxWorkers > maxWorkersCap {
+		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("max workers must be between 0 and %d", maxWorkersCap))
+	}
+
+	plan := &models.CapacityPlan{
+		Operators:           make([]string, 0, len(cluster.MonitoredOperators)),
+		BindingRequirements: make([]*models.CapacityPlanIssue, 0),
+		Issues:              make([]*models.CapacityPlanIssue, 0),
+	}
+	for _, operator := range cluster.MonitoredOperators {
+		plan.Operators = append(plan.Operators, operator.Name)
+	}
+
+	// Layouts are tried from the fewest hosts to the most, and from the smallest profiles to the largest, so the first
+	// viable layout is the smallest one
+	for workerCount := int64(0); workerCount <= maxWorkers; workerCount++ {
+		for controlPlane := range profiles {
+			workerProfiles := len(profiles)
+			if workerCount == 0 {
+				workerProfiles = 1
+			}
+			for worker := 0; worker < workerProfiles; worker++ {
+				candidate := layout{
+					profiles:          profiles,
+					controlPlane:      controlPlane,
+					controlPlaneCount: cluster.ControlPlaneCount,
+					worker:            worker,
+					workerCount:       workerCount,
+				}
+				issues, err := p.validateLayout(ctx, cluster, candidate)
+				if err != nil {
+					return nil, err
+				}
+				if len(issues) > 0 {
+					continue
+				}
+				log.Infof("Layout %s satisfies the requirements of operators %v", candidate, plan.Operators)
+				plan.Feasible = true
+				plan.ControlPlane = &models.CapacityPlanHosts{
+					HostProfile: swag.StringValue(profiles[controlPlane].Name),
+					Count:       candidate.controlPlaneCount,
+				}
+				if workerCount > 0 {
+					plan.Workers = &models.CapacityPlanHosts{
+						HostProfile: swag.StringValue(profiles[worker].Name),
+						Count:       workerCount,
+					}
+				}
+				plan.BindingRequirements, err = p.bindingRequirements(ctx, cluster, candidate)
+				if err != nil {
+					return nil, err
+				}
+				return plan, nil
+			}
+		}
+	}
+
+	largest := layout{
+		profiles:          profiles,
+		controlPlane:      len(profiles) - 1,
+		controlPlaneCount: cluster.ControlPlaneCount,
+		worker:            len(profiles) - 1,
+		workerCount:       maxWorkers,
+	}
+	plan.Issues, err = p.validateLayout(ctx, cluster, largest)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// newCluster creates the cluster that the layouts are evaluated in, with the requested operators and their
+// dependencies
+func (p *Planner) newCluster(params *models.CapacityPlanParams) (*common.Cluster, error) {
+	openshiftVersion := swag.StringValue(params.OpenshiftVersion)
+	if _, err := version.NewVersion(openshiftVersion); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("invalid openshift version: %w", err))
+	}
+	cpuArchitecture := swag.StringValue(params.CPUArchitecture)
+	if cpuArchitecture == "" {
+		cpuArchitecture = common.DefaultCPUArchitecture
+	}
+	supported, err := featuresupport.IsArchitectureSupported(cpuArchitecture, openshiftVersion)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if !supported {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			fmt.Errorf("cpu architecture %s is not supported for openshift version %s", cpuArchitecture, openshiftVersion))
+	}
+	controlPlaneCount := params.ControlPlaneCount
+	if controlPlaneCount == 0 {
+		controlPlaneCount = defaultControlPlaneCount
+	}
+	if controlPlaneCount < 1 || controlPlaneCount > maxControlPlaneCount {
+		return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("control plane count must be between 1 and %d", maxControlPlaneCount))
+	}
+
+	clusterID := strfmt.UUID(uuid.New().String())
+	cluster := &common.Cluster{
+		Cluster: models.Cluster{
+			ID:                   &clusterID,
+			OpenshiftVersion:     openshiftVersion,
+			CPUArchitecture:      cpuArchitecture,
+			ControlPlaneCount:    controlPlaneCount,
+			HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+			Platform:             &models.Platform{Type: models.PlatformTypeBaremetal.Pointer()},
+		},
+	}
+	if controlPlaneCount == 1 {
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+	}
+
+	requested := make([]*models.MonitoredOperator, 0, len(params.OlmOperators))
+	for _, param := range params.OlmOperators {
+		if param == nil {
+			continue
+		}
+		operator, err := p.operatorsAPI.GetOperatorByName(param.Name)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		if operator.OperatorType != models.OperatorTypeOlm {
+			return nil, common.NewApiError(http.StatusBadRequest, fmt.Errorf("operator %s isn't an OLM operator", param.Name))
+		}
+		operator.Properties = param.Properties
+		operator.Subscription = param.Subscription
+		requested = append(requested, operator)
+	}
+	resolved, err := p.operatorsAPI.ResolveDependencies(cluster, requested)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = p.operatorsAPI.EnsureOperatorProperties(cluster, resolved); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = p.operatorsAPI.EnsureOperatorPrerequisite(cluster, openshiftVersion, cpuArchitecture, resolved); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = p.operatorsAPI.SetOperatorPropertiesDefaults(cluster, resolved); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	cluster.MonitoredOperators = resolved
+	return cluster, nil
+}
+
+// sortProfiles validates the host profiles, and sorts them from the smallest to the largest
+func sortProfiles(profiles []*models.HostProfile) ([]*models.HostProfile, error) {
+	if len(profiles) > maxHostProfiles {
+		return nil, fmt.Errorf("at most %d host profiles can be planned with", maxHostProfiles)
+	}
+	names := make(map[string]bool)
+	ret := make([]*models.HostProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		if profile == nil {
+			continue
+		}
+		name := swag.StringValue(profile.Name)
+		if name == "" {
+			return nil, errors.New("host profiles must have a name")
+		}
+		if names[name] {
+			return nil, fmt.Errorf("host profile %s is defined more than once", name)
+		}
+		names[name] = true
+		if swag.Int64Value(profile.CPUCores) <= 0 || swag.Int64Value(profile.RAMMib) <= 0 {
+			return nil, fmt.Errorf("host profile %s must have CPU cores and RAM", name)
+		}
+		ret = append(ret, profile)
+	}
+	if len(ret) == 0 {
+		return nil, errors.New("at least one host profile is required")
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if *ret[i].CPUCores != *ret[j].CPUCores {
+			return *ret[i].CPUCores < *ret[j].CPUCores
+		}
+		if *ret[i].RAMMib != *ret[j].RAMMib {
+			return *ret[i].RAMMib < *ret[j].RAMMib
+		}
+		return totalDiskSizeGb(ret[i]) < totalDiskSizeGb(ret[j])
+	})
+	return ret, nil
+}
+
+func totalDiskSizeGb(profile *models.HostProfile) int64 {
+	var ret int64
+	for _, disk := range profile.Disks {
+		ret += swag.Int64Value(disk.SizeGb)
+	}
+	return ret
+}
+
+// bindingRequirements returns the issues of the layouts that are one step smaller than the viable layout: with one
+// worker less, or with the next smaller profile for the control plane or for the workers. These layouts were tried
+// before the viable one, so they all have issues, and their issues are the requirements that size the layout.
+func (p *Planner) bindingRequirements(ctx context.Context, cluster *common.Cluster, viable layout) ([]*models.CapacityPlanIssue, error) {
+	var smaller []layout
+	if viable.workerCount > 0 {
+		candidate := viable
+		candidate.workerCount--
+		if candidate.workerCount == 0 {
+			candidate.worker = 0
+		}
+		smaller = append(smaller, candidate)
+	}
+	if viable.controlPlane > 0 {
+		candidate := viable
+		candidate.controlPlane--
+		smaller = append(smaller, candidate)
+	}
+	if viable.workerCount > 0 && viable.worker > 0 {
+		candidate := viable
+		candidate.worker--
+		smaller = append(smaller, candidate)
+	}
+
+	ret := make([]*models.CapacityPlanIssue, 0)
+	for _, candidate := range smaller {
+		issues, err := p.validateLayout(ctx, cluster, candidate)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, issues...)
+	}
+	return ret, nil
+}
+
+// validateLayout returns the requirements that the hosts of the layout don't satisfy. As the hosts of a role are
+// identical, only the first host of each role is validated.
+func (p *Planner) validateLayout(ctx context.Context, cluster *common.Cluster, candidate layout) ([]*models.CapacityPlanIssue, error) {
+	layoutCluster := *cluster
+	layoutCluster.Hosts = newHosts(cluster, candidate)
+	layoutCluster.SchedulableMastersForcedTrue = swag.Bool(common.ShouldMastersBeSchedulable(&layoutCluster.Cluster))
+
+	issues := make([]*models.CapacityPlanIssue, 0)
+	validated := make(map[models.HostRole]bool)
+	for _, h := range layoutCluster.Hosts {
+		if validated[h.Role] {
+			continue
+		}
+		validated[h.Role] = true
+		profile := candidate.profiles[candidate.controlPlane]
+		if h.Role == models.HostRoleWorker {
+			profile = candidate.profiles[candidate.worker]
+		}
+		hostIssues, err := p.validateHost(ctx, &layoutCluster, h, profile)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, hostIssues...)
+	}
+
+	results, err := p.operatorsAPI.ValidateCluster(ctx, &layoutCluster)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, failedValidations(results, "")...)
+
+	for _, issue := range issues {
+		issue.Layout = candidate.String()
+	}
+	return issues, nil
+}
+
+// validateHost compares the profile of the host with the requirements of OpenShift and of the operators for the
+// host, and runs the host validations of the operators
+func (p *Planner) validateHost(ctx context.Context, cluster *common.Cluster, h *models.Host, profile *models.HostProfile) ([]*models.CapacityPlanIssue, error) {
+	requirements, err := p.hwValidator.GetClusterHostRequirements(ctx, cluster, h)
+	if err != nil {
+		return nil, err
+	}
+	total := requirements.Total
+	name := swag.StringValue(profile.Name)
+
+	issues := make([]*models.CapacityPlanIssue, 0)
+	newIssue := func(format string, args ...interface{}) {
+		issues = append(issues, &models.CapacityPlanIssue{
+			Role:    h.Role,
+			Source:  hardwareSource,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	if cpuCores := swag.Int64Value(profile.CPUCores); cpuCores < total.CPUCores {
+		newIssue("%s hosts require %d CPU cores (%s), profile %s has %d", h.Role, total.CPUCores,
+			breakdown(requirements, func(details *models.ClusterHostRequirementsDetails) int64 { return details.CPUCores }), name, cpuCores)
+	}
+	ramMib := swag.Int64Value(profile.RAMMib)
+	if ramMib < total.RAMMib-host.HostMemoryRequirementToleranceMiB {
+		newIssue("%s hosts require %d MiB of RAM (%s), profile %s has %d", h.Role, total.RAMMib,
+			breakdown(requirements, func(details *models.ClusterHostRequirementsDetails) int64 { return details.RAMMib }), name, ramMib)
+	}
+	if len(profile.Disks) == 0 {
+		newIssue("%s hosts require an installation disk, profile %s has no disks", h.Role, name)
+	} else if diskSizeGb := swag.Int64Value(profile.Disks[0].SizeGb); diskSizeGb < total.DiskSizeGb {
+		newIssue("%s hosts require an installation disk of %d GB, the first disk of profile %s has %d GB", h.Role, total.DiskSizeGb, name, diskSizeGb)
+	}
+
+	results, err := p.operatorsAPI.ValidateHost(ctx, cluster, h)
+	if err != nil {
+		return nil, err
+	}
+	return append(issues, failedValidations(results, h.Role)...), nil
+}
+
+// breakdown describes what a quantity of a host requirement is made of, for example "ocp 4, odf 6"
+func breakdown(requirements *models.ClusterHostRequirements, quantity func(*models.ClusterHostRequirementsDetails) int64) string {
+	var parts []string
+	if requirements.Ocp != nil {
+		parts = append(parts, fmt.Sprintf("ocp %d", quantity(requirements.Ocp)))
+	}
+	for _, operator := range requirements.Operators {
+		if operator.Requirements == nil {
+			continue
+		}
+		if value := quantity(operator.Requirements); value > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", operator.OperatorName, value))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func failedValidations(results []api.ValidationResult, role models.HostRole) []*models.CapacityPlanIssue {
+	issues := make([]*models.CapacityPlanIssue, 0)
+	for _, result := range results {
+		if result.Status != api.Failure {
+			continue
+		}
+		issues = append(issues, &models.CapacityPlanIssue{
+			Role:    role,
+			Source:  result.ValidationId,
+			Message: strings.Join(result.Reasons, " "),
+		})
+	}
+	return issues
+}
+
+func newHosts(cluster *common.Cluster, candidate layout) []*models.Host {
+	hosts := make([]*models.Host, 0, candidate.controlPlaneCount+candidate.workerCount)
+	for i := int64(0); i < candidate.controlPlaneCount; i++ {
+		hosts = append(hosts, newHost(cluster, candidate.profiles[candidate.controlPlane], models.HostRoleMaster, i))
+	}
+	for i := int64(0); i < candidate.workerCount; i++ {
+		hosts = append(hosts, newHost(cluster, candidate.profiles[candidate.worker], models.HostRoleWorker, i))
+	}
+	return hosts
+}
+
+// newHost creates a host with the inventory of a host profile. The first disk of the profile is the installation
+// disk.
+func newHost(cluster *common.Cluster, profile *models.HostProfile, role models.HostRole, index int64) *models.Host {
+	hostID := strfmt.UUID(uuid.New().String())
+	hostname := fmt.Sprintf("%s-%d", role, index)
+	inventory := &models.Inventory{
+		Hostname: hostname,
+		CPU: &models.CPU{
+			Count:        swag.Int64Value(profile.CPUCores),
+			Architecture: cluster.CPUArchitecture,
+		},
+		Memory: &models.Memory{
+			PhysicalBytes: conversions.MibToBytes(swag.Int64Value(profile.RAMMib)),
+			UsableBytes:   conversions.MibToBytes(swag.Int64Value(profile.RAMMib)),
+		},
+		Gpus: profile.Gpus,
+	}
+	for i, disk := range profile.Disks {
+		driveType := disk.DriveType
+		if driveType == "" {
+			driveType = models.DriveTypeSSD
+		}
+		name := fmt.Sprintf("sd%c", 'a'+i)
+		inventory.Disks = append(inventory.Disks, &models.Disk{
+			ID:        fmt.Sprintf("/dev/disk/by-id/%s", name),
+			Name:      name,
+			Path:      fmt.Sprintf("/dev/%s", name),
+			ByID:      fmt.Sprintf("/dev/disk/by-id/%s", name),
+			SizeBytes: conversions.GbToBytes(swag.Int64Value(disk.SizeGb)),
+			DriveType: driveType,
+			InstallationEligibility: models.DiskInstallationEligibility{
+				Eligible: i == 0,
+			},
+		})
+	}
+	// The inventory only has basic types, marshalling it can't fail
+	inventoryJSON, _ := common.MarshalInventory(inventory)
+
+	h := &models.Host{
+		ID:                &hostID,
+		ClusterID:         cluster.ID,
+		InfraEnvID:        *cluster.ID,
+		Kind:              swag.String(models.HostKindHost),
+		Status:            swag.String(models.HostStatusKnown),
+		Role:              role,
+		SuggestedRole:     role,
+		RequestedHostname: hostname,
+		Inventory:         inventoryJSON,
+	}
+	if len(inventory.Disks) > 0 {
+		h.InstallationDiskID = inventory.Disks[0].ID
+		h.InstallationDiskPath = inventory.Disks[0].Path
+	}
+	return h
+}
@@ -0,0 +1,13 @@
+package planner
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlanner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Capacity planner Suite")
+}
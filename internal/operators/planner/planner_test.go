@@ -0,0 +1,159 @@
+package planner_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/odf"
+	"github.com/openshift/assisted-service/internal/operators/planner"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Planner", func() {
+	var (
+		ctx      = context.Background()
+		log      = logrus.New()
+		capacity *planner.Planner
+		params   *models.CapacityPlanParams
+	)
+
+	newProfile := func(name string, cpuCores int64, ramMib int64, disksGb ...int64) *models.HostProfile {
+		profile := &models.HostProfile{Name: swag.String(name), CPUCores: swag.Int64(cpuCores), RAMMib: swag.Int64(ramMib)}
+		for _, diskGb := range disksGb {
+			profile.Disks = append(profile.Disks, &models.HostProfileDisk{SizeGb: swag.Int64(diskGb)})
+		}
+		return profile
+	}
+
+	BeforeEach(func() {
+		requirements := hardware.NewVersionedRequirementsDecoderFromMap(map[string]models.VersionedHostRequirements{
+			"default": {
+				Version: "default",
+				MasterRequirements: &models.VersionedClusterHostRequirementsDetails{
+					CPUCores:   swag.Int64(4),
+					RAMMib:     swag.Int64(16384),
+					DiskSizeGb: swag.Int64(100),
+				},
+				WorkerRequirements: &models.VersionedClusterHostRequirementsDetails{
+					CPUCores:   swag.Int64(2),
+					RAMMib:     swag.Int64(8192),
+					DiskSizeGb: swag.Int64(100),
+				},
+				SNORequirements: &models.VersionedClusterHostRequirementsDetails{
+					CPUCores:   swag.Int64(8),
+					RAMMib:     swag.Int64(16384),
+					DiskSizeGb: swag.Int64(100),
+				},
+			},
+		})
+		operatorsManager, err := operators.NewManager(log, nil, operators.Options{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		hwValidator := hardware.NewValidator(log, hardware.ValidatorCfg{VersionedRequirements: requirements}, operatorsManager, nil)
+		capacity = planner.NewPlanner(log, operatorsManager, hwValidator)
+		params = &models.CapacityPlanParams{
+			OpenshiftVersion: swag.String("4.16.0"),
+			MaxWorkers:       swag.Int64(3),
+		}
+	})
+
+	It("should plan the smallest profile that satisfies the requirements of OpenShift", func() {
+		params.HostProfiles = []*models.HostProfile{
+			newProfile("large", 32, 131072, 500),
+			newProfile("small", 2, 8192, 120),
+			newProfile("medium", 8, 32768, 120),
+		}
+
+		plan, err := capacity.PlanCapacity(ctx, params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Feasible).To(BeTrue())
+		Expect(plan.ControlPlane).To(Equal(&models.CapacityPlanHosts{HostProfile: "medium", Count: 3}))
+		Expect(plan.Workers).To(BeNil())
+		Expect(plan.BindingRequirements).ToNot(BeEmpty())
+		for _, issue := range plan.BindingRequirements {
+			Expect(issue.Layout).To(Equal("3 x small control plane"))
+			Expect(issue.Role).To(Equal(models.HostRoleMaster))
+			Expect(issue.Source).To(Equal("hardware"))
+		}
+		Expect(plan.BindingRequirements[0].Message).To(Equal("master hosts require 4 CPU cores (ocp 4), profile small has 2"))
+	})
+
+	It("should add the requirements of the operators and of their dependencies", func() {
+		params.OlmOperators = []*models.OperatorCreateParams{{Name: odf.Operator.Name}}
+		params.HostProfiles = []*models.HostProfile{
+			newProfile("medium", 8, 32768, 120, 500),
+			newProfile("large", 32, 131072, 120, 500),
+		}
+
+		plan, err := capacity.PlanCapacity(ctx, params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Operators).To(ConsistOf(odf.Operator.Name, lso.Operator.Name))
+		Expect(plan.Feasible).To(BeTrue())
+		Expect(plan.ControlPlane).To(Equal(&models.CapacityPlanHosts{HostProfile: "large", Count: 3}))
+		Expect(plan.BindingRequirements).ToNot(BeEmpty())
+		Expect(plan.BindingRequirements[0].Message).To(ContainSubstring("odf"))
+	})
+
+	It("should report the issues of the largest layout when no layout is viable", func() {
+		params.OlmOperators = []*models.OperatorCreateParams{{Name: odf.Operator.Name}}
+		params.HostProfiles = []*models.HostProfile{
+			newProfile("single-disk", 32, 131072, 500),
+		}
+
+		plan, err := capacity.PlanCapacity(ctx, params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Feasible).To(BeFalse())
+		Expect(plan.ControlPlane).To(BeNil())
+		Expect(plan.Issues).ToNot(BeEmpty())
+		Expect(plan.Issues[0].Layout).To(Equal("3 x single-disk control plane, 3 x single-disk workers"))
+	})
+
+	It("should reject unknown operators", func() {
+		params.OlmOperators = []*models.OperatorCreateParams{{Name: "unknown"}}
+		params.HostProfiles = []*models.HostProfile{newProfile("medium", 8, 32768, 120)}
+
+		_, err := capacity.PlanCapacity(ctx, params)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("should reject host profiles with the same name", func() {
+		params.HostProfiles = []*models.HostProfile{
+			newProfile("medium", 8, 32768, 120),
+			newProfile("medium", 16, 65536, 120),
+		}
+
+		_, err := capacity.PlanCapacity(ctx, params)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		Expect(err.Error()).To(ContainSubstring("host profile medium is defined more than once"))
+	})
+
+	DescribeTable("should reject plans that are too large",
+		func(update func(), message string) {
+			params.HostProfiles = []*models.HostProfile{newProfile("medium", 8, 32768, 120)}
+			update()
+
+			_, err := capacity.PlanCapacity(ctx, params)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("workers", func() { params.MaxWorkers = swag.Int64(101) }, "max workers must be between 0 and 100"),
+		Entry("control plane nodes", func() { params.ControlPlaneCount = 6 }, "control plane count must be between 1 and 5"),
+		Entry("host profiles", func() {
+			for i := 0; i < 10; i++ {
+				params.HostProfiles = append(params.HostProfiles, newProfile(fmt.Sprintf("profile-%d", i), 8, 32768, 120))
+			}
+		}, "at most 10 host profiles"),
+	)
+})
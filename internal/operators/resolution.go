@@ -0,0 +1,173 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+// ExplainDependencies resolves the dependencies of the requested operators for the cluster, like ResolveDependencies
+// does, and explains why each operator is part of the result, which constraints of the cluster the operators violate,
+// and what the operators require from the hosts
+func (mgr *Manager) ExplainDependencies(ctx context.Context, cluster *common.Cluster, operatorNames []string) (*models.OperatorsResolution, error) {
+	names, requiredBy, err := mgr.explainDependencies(cluster, operatorNames)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := &models.OperatorsResolution{
+		Operators: make([]*models.ResolvedOperator, 0, len(names)),
+		Conflicts: make([]*models.OperatorConflict, 0),
+	}
+	for _, name := range names {
+		resolution.Operators = append(resolution.Operators, newResolvedOperator(name, funk.ContainsString(operatorNames, name), requiredBy[name]))
+	}
+
+	resolution.Conflicts, err = mgr.getConflicts(cluster, names)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution.Requirements = make([]*models.OperatorHardwareRequirements, 0, len(names))
+	for _, name := range names {
+		resolution.Requirements = append(resolution.Requirements, mgr.olmOperators[name].GetPreflightRequirements(ctx, cluster))
+	}
+	resolution.TotalRequirements = totalizeOperatorsRequirements(resolution.Requirements)
+
+	return resolution, nil
+}
+
+// explainDependencies resolves the dependencies of the requested operators with resolveDependencies. It returns the
+// names of the requested operators followed by the names of their dependencies, in the order they were found, and the
+// names of the operators that depend on each operator.
+func (mgr *Manager) explainDependencies(cluster *common.Cluster, operatorNames []string) ([]string, map[string][]string, error) {
+	requested := make([]*models.MonitoredOperator, 0, len(operatorNames))
+	for _, name := range operatorNames {
+		if _, ok := mgr.olmOperators[name]; !ok {
+			return nil, nil, fmt.Errorf("operator %s isn't supported", name)
+		}
+		operator, err := mgr.GetOperatorByName(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		requested = append(requested, operator)
+	}
+	resolved, requiredBy, err := mgr.resolveDependencies(cluster, requested)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, len(resolved))
+	for _, operator := range resolved {
+		if !funk.ContainsString(names, operator.Name) {
+			names = append(names, operator.Name)
+		}
+	}
+	return names, requiredBy, nil
+}
+
+func newResolvedOperator(name string, requested bool, requiredBy []string) *models.ResolvedOperator {
+	var reason string
+	switch {
+	case requested && len(requiredBy) > 0:
+		reason = fmt.Sprintf("Requested, and required by %s", strings.Join(requiredBy, ", "))
+	case requested:
+		reason = "Requested"
+	default:
+		reason = fmt.Sprintf("Required by %s", strings.Join(requiredBy, ", "))
+	}
+	return &models.ResolvedOperator{
+		Name:           name,
+		DependencyOnly: !requested,
+		RequiredBy:     requiredBy,
+		Reason:         reason,
+	}
+}
+
+// getConflicts checks the operators against the constraints of the cluster that EnsureOperatorPrerequisite and the
+// feature support validations enforce, and returns a conflict for each constraint that the operators violate
+func (mgr *Manager) getConflicts(cluster *common.Cluster, names []string) ([]*models.OperatorConflict, error) {
+	conflicts := make([]*models.OperatorConflict, 0)
+
+	var platformFeatureID models.FeatureSupportLevelID
+	if cluster.Platform != nil && cluster.Platform.Type != nil {
+		var externalPlatformName *string
+		if cluster.Platform.External != nil {
+			externalPlatformName = cluster.Platform.External.PlatformName
+		}
+		var err error
+		platformFeatureID, err = featuresupport.GetPlatformFeatureID(*cluster.Platform.Type, externalPlatformName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	monitoredOperators := make([]*models.MonitoredOperator, 0, len(names))
+	for _, name := range names {
+		operator := mgr.olmOperators[name]
+		featureID := operator.GetFeatureSupportID()
+		if !isOperatorCompatibleWithArchitecture(cluster, cluster.CPUArchitecture, operator) {
+			conflicts = append(conflicts, &models.OperatorConflict{
+				Constraint: models.OperatorConflictConstraintCPUArchitecture,
+				Operators:  []string{name},
+				Message:    fmt.Sprintf("%s is not available when %s CPU architecture is selected", operator.GetFullName(), cluster.CPUArchitecture),
+			})
+		} else if !featuresupport.IsFeatureAvailable(featureID, cluster.OpenshiftVersion, &cluster.CPUArchitecture) {
+			conflicts = append(conflicts, &models.OperatorConflict{
+				Constraint: models.OperatorConflictConstraintOpenshiftVersion,
+				Operators:  []string{name},
+				Message:    fmt.Sprintf("%s is not available for OpenShift version %s", operator.GetFullName(), cluster.OpenshiftVersion),
+			})
+		}
+		if platformFeatureID != "" && !featuresupport.IsFeatureCompatibleWithOther(cluster.OpenshiftVersion, platformFeatureID, []models.FeatureSupportLevelID{featureID}) {
+			conflicts = append(conflicts, &models.OperatorConflict{
+				Constraint: models.OperatorConflictConstraintPlatform,
+				Operators:  []string{name},
+				Message:    fmt.Sprintf("%s is not available on platform %s", operator.GetFullName(), *cluster.Platform.Type),
+			})
+		}
+		monitoredOperators = append(monitoredOperators, &models.MonitoredOperator{Name: name})
+	}
+
+	if err := EnsureLVMAndCNVDoNotClash(cluster, cluster.OpenshiftVersion, monitoredOperators); err != nil {
+		conflicts = append(conflicts, &models.OperatorConflict{
+			Constraint: models.OperatorConflictConstraintOperators,
+			Operators:  []string{"lvm", "cnv"},
+			Message:    err.Error(),
+		})
+	}
+
+	return conflicts, nil
+}
+
+// totalizeOperatorsRequirements adds up the CPU cores and the RAM that the operators require from each type of host,
+// and keeps the largest disk size
+func totalizeOperatorsRequirements(requirements []*models.OperatorHardwareRequirements) *models.HostTypeHardwareRequirementsWrapper {
+	total := &models.HostTypeHardwareRequirementsWrapper{
+		Master: &models.HostTypeHardwareRequirements{Quantitative: &models.ClusterHostRequirementsDetails{}},
+		Worker: &models.HostTypeHardwareRequirements{Quantitative: &models.ClusterHostRequirementsDetails{}},
+	}
+	for _, operatorRequirements := range requirements {
+		if operatorRequirements == nil || operatorRequirements.Requirements == nil {
+			continue
+		}
+		addHostTypeRequirements(total.Master.Quantitative, operatorRequirements.Requirements.Master)
+		addHostTypeRequirements(total.Worker.Quantitative, operatorRequirements.Requirements.Worker)
+	}
+	return total
+}
+
+func addHostTypeRequirements(total *models.ClusterHostRequirementsDetails, requirements *models.HostTypeHardwareRequirements) {
+	if requirements == nil || requirements.Quantitative == nil {
+		return
+	}
+	total.CPUCores += requirements.Quantitative.CPUCores
+	total.RAMMib += requirements.Quantitative.RAMMib
+	if requirements.Quantitative.DiskSizeGb > total.DiskSizeGb {
+		total.DiskSizeGb = requirements.Quantitative.DiskSizeGb
+	}
+}
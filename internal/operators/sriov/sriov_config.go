@@ -0,0 +1,32 @@
+package sriov
+
+const (
+	// SriovMinOpenshiftVersion is the minimum OpenShift version that supports the SR-IOV Network Operator
+	SriovMinOpenshiftVersion = "4.12.0"
+
+	// Operator metadata
+	Name             = "sriov"
+	FullName         = "SR-IOV Network Operator"
+	Namespace        = "openshift-sriov-network-operator"
+	SubscriptionName = "sriov-network-operator"
+
+	// NodeLabel is the node label of the hosts that will run SR-IOV workloads. Only the hosts that have it, with the
+	// `true` value, are required to have an SR-IOV capable network interface.
+	NodeLabel = "feature.node.kubernetes.io/network-sriov.capable"
+)
+
+type Config struct {
+	// RequireNIC indicates if the hosts labeled to run SR-IOV workloads must have a supported SR-IOV capable network
+	// interface. It can be disabled for environments where the interfaces aren't reported accurately.
+	RequireNIC bool `envconfig:"SRIOV_REQUIRE_NIC" default:"true"`
+
+	// SupportedNICs is a comma separated list of the PCI vendor and device identifiers, in `vendor:device` format,
+	// of the network interfaces supported by the SR-IOV Network Operator. By default it contains the Intel,
+	// Mellanox, Broadcom and QLogic interfaces listed in the supported devices of the operator.
+	SupportedNICs []string `envconfig:"SRIOV_SUPPORTED_NICS" default:"8086:158a,8086:158b,8086:1572,8086:1583,8086:1589,8086:1563,8086:1591,8086:1592,8086:1593,8086:159b,15b3:1013,15b3:1015,15b3:1017,15b3:1019,15b3:101b,15b3:101d,15b3:101f,15b3:1021,15b3:a2d6,14e4:16d7,14e4:1750,1077:1654"`
+
+	// EnableInjector and EnableOperatorWebhook are the values of the corresponding fields of the default
+	// SriovOperatorConfig.
+	EnableInjector        bool `envconfig:"SRIOV_ENABLE_INJECTOR" default:"true"`
+	EnableOperatorWebhook bool `envconfig:"SRIOV_ENABLE_OPERATOR_WEBHOOK" default:"true"`
+}
@@ -0,0 +1,64 @@
+package sriov
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("SR-IOV manifest generation", func() {
+	var (
+		cluster  *common.Cluster
+		operator *operator
+	)
+
+	BeforeEach(func() {
+		cluster = &common.Cluster{
+			Cluster: models.Cluster{
+				OpenshiftVersion: "4.16.0",
+			},
+		}
+		operator = NewSriovOperator(common.GetTestLog())
+	})
+
+	It("Generates the required manifests", func() {
+		manifests, _, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(3))
+		Expect(manifests).To(HaveKey("50_sriov_namespace.yaml"))
+		Expect(manifests).To(HaveKey("50_sriov_subscription.yaml"))
+		Expect(manifests).To(HaveKey("50_sriov_operatorgroup.yaml"))
+	})
+
+	It("Generates valid YAML", func() {
+		openShiftManifests, customManifest, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		for _, openShiftManifest := range openShiftManifests {
+			var object any
+			err = yaml.Unmarshal(openShiftManifest, &object)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		var object any
+		err = yaml.Unmarshal(customManifest, &object)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Generates the default operator configuration", func() {
+		operator.config.EnableOperatorWebhook = false
+		_, customManifest, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		var config map[string]any
+		err = yaml.Unmarshal(customManifest, &config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config["kind"]).To(Equal("SriovOperatorConfig"))
+		metadata := config["metadata"].(map[string]any)
+		Expect(metadata["name"]).To(Equal("default"))
+		Expect(metadata["namespace"]).To(Equal(Namespace))
+		spec := config["spec"].(map[string]any)
+		Expect(spec["enableInjector"]).To(BeTrue())
+		Expect(spec["enableOperatorWebhook"]).To(BeFalse())
+	})
+})
@@ -0,0 +1,231 @@
+package sriov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/templating"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	clusterValidationID = string(models.ClusterValidationIDSriovRequirementsSatisfied)
+	hostValidationID    = string(models.HostValidationIDSriovRequirementsSatisfied)
+)
+
+var Operator = models.MonitoredOperator{
+	Name:             Name,
+	OperatorType:     models.OperatorTypeOlm,
+	Namespace:        Namespace,
+	SubscriptionName: SubscriptionName,
+	TimeoutSeconds:   30 * 60,
+}
+
+// operator is an SR-IOV Network Operator OLM plugin; it implements api.Operator
+type operator struct {
+	log       logrus.FieldLogger
+	config    *Config
+	templates *template.Template
+}
+
+// NewSriovOperator creates new SR-IOV Network Operator
+func NewSriovOperator(log logrus.FieldLogger) *operator {
+	config := &Config{}
+	err := envconfig.Process(common.EnvConfigPrefix, config)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	templates, err := templating.LoadTemplates(templatesRoot)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	return &operator{
+		log:       log.WithField("operator", Name),
+		config:    config,
+		templates: templates,
+	}
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return Name
+}
+
+// GetFullName reports the full name of the specified Operator
+func (o *operator) GetFullName() string {
+	return FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(cluster *common.Cluster) []string {
+	return []string{}
+}
+
+func (o *operator) GetDependenciesFeatureSupportID() []models.FeatureSupportLevelID {
+	return []models.FeatureSupportLevelID{}
+}
+
+// GetClusterValidationIDs returns cluster validation IDs for the Operator
+func (o *operator) GetClusterValidationIDs() []string {
+	return []string{clusterValidationID}
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return hostValidationID
+}
+
+// ValidateCluster checks if the cluster satisfies the requirements to install the operator
+func (o *operator) ValidateCluster(ctx context.Context, cluster *common.Cluster) ([]api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: clusterValidationID,
+	}
+
+	if ok, _ := common.BaseVersionLessThan(SriovMinOpenshiftVersion, cluster.OpenshiftVersion); ok {
+		result.Status = api.Failure
+		result.Reasons = []string{
+			fmt.Sprintf("%s is only supported for OpenShift versions %s and above", FullName, SriovMinOpenshiftVersion),
+		}
+		return []api.ValidationResult{result}, nil
+	}
+
+	return []api.ValidationResult{result}, nil
+}
+
+// ValidateHost checks that the hosts labeled to run SR-IOV workloads have at least one network interface that the
+// operator can configure
+func (o *operator) ValidateHost(
+	ctx context.Context,
+	cluster *common.Cluster,
+	host *models.Host,
+	hostRequirements *models.ClusterHostRequirementsDetails,
+) (api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: o.GetHostValidationID(),
+	}
+	if !o.config.RequireNIC || !isSriovHost(host) {
+		return result, nil
+	}
+
+	if host.Inventory == "" {
+		result.Status = api.Pending
+		result.Reasons = []string{
+			"Missing inventory in the host",
+		}
+		return result, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		result.Status = api.Pending
+		result.Reasons = []string{
+			"Failed to get inventory from host",
+		}
+		return result, nil
+	}
+
+	for _, iface := range inventory.Interfaces {
+		if o.isSupportedNIC(iface) {
+			return result, nil
+		}
+	}
+	result.Status = api.Failure
+	result.Reasons = []string{
+		fmt.Sprintf("%s requires at least one SR-IOV capable network interface on the hosts labeled with %s=true, "+
+			"but there is none.", FullName, NodeLabel),
+	}
+	return result, nil
+}
+
+// isSriovHost checks if the host is labeled to run SR-IOV workloads, and therefore needs an SR-IOV capable network
+// interface
+func isSriovHost(host *models.Host) bool {
+	if host.NodeLabels == "" {
+		return false
+	}
+	nodeLabels := make(map[string]string)
+	if err := json.Unmarshal([]byte(host.NodeLabels), &nodeLabels); err != nil {
+		return false
+	}
+	return nodeLabels[NodeLabel] == "true"
+}
+
+// isSupportedNIC checks the PCI identifiers of the interface with the supported ones. The inventory doesn't report the
+// driver of the interface or how many virtual functions it supports, so they can't be checked.
+func (o *operator) isSupportedNIC(iface *models.Interface) bool {
+	id := pciID(iface)
+	for _, supportedNIC := range o.config.SupportedNICs {
+		if strings.EqualFold(id, supportedNIC) {
+			return true
+		}
+	}
+	return false
+}
+
+// pciID returns the PCI vendor and device identifiers of the interface in `vendor:device` format. The inventory
+// reports them in hexadecimal, optionally with a `0x` prefix.
+func pciID(iface *models.Interface) string {
+	return fmt.Sprintf("%s:%s", normalizePCIID(iface.Vendor), normalizePCIID(iface.Product))
+}
+
+func normalizePCIID(id string) string {
+	return strings.TrimPrefix(strings.ToLower(id), "0x")
+}
+
+// GetProperties provides description of operator properties
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the SR-IOV Network Operator
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &Operator
+}
+
+// GetHostRequirements provides the requirements that the host needs to satisfy
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster,
+	host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	preflightRequirements := o.GetPreflightRequirements(ctx, cluster)
+
+	return preflightRequirements.Requirements.Worker.Quantitative, nil
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context context.Context, cluster *common.Cluster) *models.OperatorHardwareRequirements {
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: o.GetDependencies(cluster),
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: &models.HostTypeHardwareRequirements{
+				Qualitative: []string{
+					"At least one SR-IOV capable network interface when the control plane nodes run workloads",
+				},
+				Quantitative: &models.ClusterHostRequirementsDetails{},
+			},
+			Worker: &models.HostTypeHardwareRequirements{
+				Qualitative: []string{
+					"At least one SR-IOV capable network interface",
+				},
+				Quantitative: &models.ClusterHostRequirementsDetails{},
+			},
+		},
+	}
+}
+
+// GetFeatureSupportID returns the operator unique feature-support ID
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDSRIOV
+}
+
+// GetBundleLabels returns the list of bundles names associated with the operator
+func (o *operator) GetBundleLabels(featureIDs []models.FeatureSupportLevelID) []string {
+	return []string(Operator.Bundles)
+}
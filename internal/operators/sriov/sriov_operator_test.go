@@ -0,0 +1,189 @@
+package sriov
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("SR-IOV Network Operator", func() {
+	var (
+		ctx      context.Context
+		operator *operator
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		operator = NewSriovOperator(common.GetTestLog())
+	})
+
+	newHost := func(role models.HostRole, interfaces ...*models.Interface) *models.Host {
+		data, err := json.Marshal(&models.Inventory{Interfaces: interfaces})
+		Expect(err).ToNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		return &models.Host{
+			ID:        &id,
+			Role:      role,
+			Inventory: string(data),
+		}
+	}
+
+	labelHost := func(host *models.Host, value string) *models.Host {
+		data, err := json.Marshal(map[string]string{NodeLabel: value})
+		Expect(err).ToNot(HaveOccurred())
+		host.NodeLabels = string(data)
+		return host
+	}
+
+	newCluster := func(hosts ...*models.Host) *common.Cluster {
+		return &common.Cluster{
+			Cluster: models.Cluster{
+				OpenshiftVersion:  "4.16.0",
+				ControlPlaneCount: 3,
+				Hosts:             hosts,
+			},
+		}
+	}
+
+	intelE810 := func() *models.Interface {
+		return &models.Interface{
+			Name:    "ens1f0",
+			Vendor:  "0x8086",
+			Product: "0x1592",
+		}
+	}
+
+	virtio := &models.Interface{
+		Name:    "eth0",
+		Vendor:  "0x1af4",
+		Product: "0x0001",
+	}
+
+	Context("GetName", func() {
+		It("Returns the operator name", func() {
+			Expect(operator.GetName()).To(Equal("sriov"))
+		})
+	})
+
+	Context("GetFeatureSupportID", func() {
+		It("Returns the SR-IOV feature ID", func() {
+			Expect(operator.GetFeatureSupportID()).To(Equal(models.FeatureSupportLevelIDSRIOV))
+		})
+	})
+
+	DescribeTable(
+		"Validate labeled hosts",
+		func(iface *models.Interface, expected api.ValidationResult) {
+			masters := []*models.Host{
+				newHost(models.HostRoleMaster, virtio),
+				newHost(models.HostRoleMaster, virtio),
+				newHost(models.HostRoleMaster, virtio),
+			}
+			worker := labelHost(newHost(models.HostRoleWorker, virtio, iface), "true")
+			cluster := newCluster(append(masters, worker, newHost(models.HostRoleWorker, intelE810()))...)
+			actual, err := operator.ValidateHost(ctx, cluster, worker, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(expected))
+		},
+		Entry(
+			"Supported interface",
+			intelE810(),
+			api.ValidationResult{
+				Status:       api.Success,
+				ValidationId: hostValidationID,
+			},
+		),
+		Entry(
+			"Supported interface with upper case identifiers",
+			&models.Interface{Name: "ens1f0", Vendor: "0x15B3", Product: "0x101D"},
+			api.ValidationResult{
+				Status:       api.Success,
+				ValidationId: hostValidationID,
+			},
+		),
+		Entry(
+			"Unsupported interface",
+			&models.Interface{Name: "ens1f0", Vendor: "0x10ec", Product: "0x8168"},
+			api.ValidationResult{
+				Status:       api.Failure,
+				ValidationId: hostValidationID,
+				Reasons: []string{
+					"SR-IOV Network Operator requires at least one SR-IOV capable network interface on the hosts " +
+						"labeled with feature.node.kubernetes.io/network-sriov.capable=true, but there is none.",
+				},
+			},
+		),
+	)
+
+	Context("ValidateHost", func() {
+		It("Ignores hosts that aren't labeled", func() {
+			worker := newHost(models.HostRoleWorker, virtio)
+			result, err := operator.ValidateHost(ctx, newCluster(worker), worker, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("Ignores hosts whose label isn't true", func() {
+			worker := labelHost(newHost(models.HostRoleWorker, virtio), "false")
+			result, err := operator.ValidateHost(ctx, newCluster(worker), worker, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
+		It("Validates labeled control plane hosts", func() {
+			master := labelHost(newHost(models.HostRoleMaster, virtio), "true")
+			cluster := newCluster(master)
+			cluster.ControlPlaneCount = 1
+			result, err := operator.ValidateHost(ctx, cluster, master, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+		})
+
+		It("Is pending when the inventory of a labeled host is missing", func() {
+			worker := labelHost(&models.Host{Role: models.HostRoleWorker}, "true")
+			result, err := operator.ValidateHost(ctx, newCluster(worker), worker, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Pending))
+		})
+
+		It("Doesn't require an interface when the requirement is disabled", func() {
+			operator.config.RequireNIC = false
+			worker := labelHost(newHost(models.HostRoleWorker, virtio), "true")
+			result, err := operator.ValidateHost(ctx, newCluster(worker), worker, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+	})
+
+	Context("ValidateCluster", func() {
+		It("Succeeds for supported OpenShift versions", func() {
+			cluster := newCluster(
+				newHost(models.HostRoleMaster, virtio),
+				newHost(models.HostRoleWorker, virtio),
+			)
+			results, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Status).To(Equal(api.Success))
+		})
+
+		It("Fails for unsupported OpenShift versions", func() {
+			cluster := newCluster(newHost(models.HostRoleWorker, intelE810()))
+			cluster.OpenshiftVersion = "4.11.0"
+			results, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results[0].Status).To(Equal(api.Failure))
+			Expect(results[0].Reasons).To(ConsistOf(
+				"SR-IOV Network Operator is only supported for OpenShift versions 4.12.0 and above",
+			))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package sriov
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSriovOperator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SR-IOV Network Operator Suite")
+}
@@ -0,0 +1,280 @@
+package operators
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	subscriptionAPIVersion = "operators.coreos.com/v1alpha1"
+	subscriptionKind       = "Subscription"
+)
+
+var (
+	channelRegex     = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9._]*[a-zA-Z0-9])?$`)
+	resourceRegex    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+	namespaceRegex   = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	startingCSVRegex = regexp.MustCompile(`^([a-z0-9](?:[-a-z0-9.]*[a-z0-9])?)\.v(.+)$`)
+	// channelVersionRegex matches the version of the channels that are bound to a version, like stable-4.14
+	channelVersionRegex = regexp.MustCompile(`[-.]v?(\d+\.\d+)$`)
+	documentSplitter    = regexp.MustCompile(`(?m)^---[ \t]*\n`)
+)
+
+// EnsureSubscriptionOverrides validates the overrides of the subscriptions of the operators. Only OLM operators that
+// are available for the OpenShift version and the CPU architecture can be overridden, the starting CSV must belong to
+// the package of the operator, and the channel and the starting CSV must be supported versions of the operator when
+// these are known.
+func (mgr *Manager) EnsureSubscriptionOverrides(cluster *common.Cluster, openshiftVersion string, cpuArchitecture string, operators []*models.MonitoredOperator) error {
+	for _, monitoredOperator := range operators {
+		overrides := monitoredOperator.Subscription
+		if isEmptySubscriptionOverrides(overrides) {
+			continue
+		}
+		operator, ok := mgr.olmOperators[monitoredOperator.Name]
+		if !ok {
+			return fmt.Errorf("the subscription of operator %s can't be overridden, it isn't an OLM operator", monitoredOperator.Name)
+		}
+		if err := validateSubscriptionOverrides(overrides); err != nil {
+			return errors.Wrapf(err, "invalid subscription overrides of operator %s", monitoredOperator.Name)
+		}
+		if !featuresupport.IsFeatureAvailable(operator.GetFeatureSupportID(), openshiftVersion, &cpuArchitecture) {
+			return fmt.Errorf("the subscription of operator %s can't be overridden, the operator isn't supported for OpenShift version %s and CPU architecture %s",
+				monitoredOperator.Name, openshiftVersion, cpuArchitecture)
+		}
+		if err := mgr.validateSubscriptionPackage(operator, monitoredOperator, cluster, openshiftVersion, cpuArchitecture); err != nil {
+			return errors.Wrapf(err, "invalid subscription overrides of operator %s", monitoredOperator.Name)
+		}
+		if versioned, ok := operator.(api.VersionedSubscriptionOperator); ok {
+			if err := validateSubscriptionVersions(overrides, versioned.GetSupportedSubscriptionVersions(openshiftVersion), openshiftVersion); err != nil {
+				return errors.Wrapf(err, "invalid subscription overrides of operator %s", monitoredOperator.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSubscriptionPackage checks that the starting CSV belongs to the package that the subscription of the
+// operator installs. The package is taken from the subscription that the operator generates for the cluster, as it
+// may depend on the cluster.
+func (mgr *Manager) validateSubscriptionPackage(operator api.Operator, monitoredOperator *models.MonitoredOperator, cluster *common.Cluster,
+	openshiftVersion string, cpuArchitecture string) error {
+	if monitoredOperator.Subscription.StartingCsv == "" {
+		return nil
+	}
+	var clusterCopy common.Cluster
+	if cluster != nil {
+		clusterCopy = *cluster
+	}
+	clusterCopy.OpenshiftVersion = openshiftVersion
+	clusterCopy.CPUArchitecture = cpuArchitecture
+	openshiftManifests, _, err := operator.GenerateManifests(&clusterCopy)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate the subscription of the operator")
+	}
+	subscriptionName := monitoredOperator.SubscriptionName
+	if subscriptionName == "" {
+		subscriptionName = operator.GetMonitoredOperator().SubscriptionName
+	}
+	var packageName string
+	for _, content := range openshiftManifests {
+		spec, err := findSubscriptionSpec(content, subscriptionName)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse the subscription of the operator")
+		}
+		if name, ok := spec["name"].(string); ok {
+			packageName = name
+			break
+		}
+	}
+	if packageName == "" {
+		return errors.New("the package of the subscription of the operator isn't known")
+	}
+	csvPackage := startingCSVRegex.FindStringSubmatch(monitoredOperator.Subscription.StartingCsv)[1]
+	if csvPackage != packageName && !strings.HasPrefix(csvPackage, packageName+"-") {
+		return fmt.Errorf("starting CSV '%s' doesn't belong to package %s", monitoredOperator.Subscription.StartingCsv, packageName)
+	}
+	return nil
+}
+
+// validateSubscriptionVersions checks the version of the channel, when it has one, like stable-4.14, and the version
+// of the starting CSV against the supported versions of the operator
+func validateSubscriptionVersions(overrides *models.OperatorSubscription, supportedVersions []string, openshiftVersion string) error {
+	if len(supportedVersions) == 0 {
+		return nil
+	}
+	if matches := channelVersionRegex.FindStringSubmatch(overrides.Channel); matches != nil && !funk.ContainsString(supportedVersions, matches[1]) {
+		return fmt.Errorf("channel '%s' isn't supported by OpenShift version %s, the supported versions are %s",
+			overrides.Channel, openshiftVersion, strings.Join(supportedVersions, ", "))
+	}
+	if overrides.StartingCsv != "" {
+		csvVersion := version.Must(version.NewSemver(startingCSVRegex.FindStringSubmatch(overrides.StartingCsv)[2]))
+		if !funk.ContainsString(supportedVersions, fmt.Sprintf("%d.%d", csvVersion.Segments()[0], csvVersion.Segments()[1])) {
+			return fmt.Errorf("starting CSV '%s' isn't supported by OpenShift version %s, the supported versions are %s",
+				overrides.StartingCsv, openshiftVersion, strings.Join(supportedVersions, ", "))
+		}
+	}
+	return nil
+}
+
+func validateSubscriptionOverrides(overrides *models.OperatorSubscription) error {
+	if overrides.Channel != "" && !channelRegex.MatchString(overrides.Channel) {
+		return fmt.Errorf("channel '%s' must consist of alphanumeric characters, '-', '_' or '.'", overrides.Channel)
+	}
+	if overrides.StartingCsv != "" {
+		matches := startingCSVRegex.FindStringSubmatch(overrides.StartingCsv)
+		if matches == nil {
+			return fmt.Errorf("starting CSV '%s' must be the name of the package followed by '.v' and the version", overrides.StartingCsv)
+		}
+		if _, err := version.NewSemver(matches[2]); err != nil {
+			return fmt.Errorf("starting CSV '%s' has an invalid version '%s'", overrides.StartingCsv, matches[2])
+		}
+	}
+	if overrides.Source != "" && !resourceRegex.MatchString(overrides.Source) {
+		return fmt.Errorf("catalog source '%s' is not a valid resource name", overrides.Source)
+	}
+	if overrides.SourceNamespace != "" {
+		if overrides.Source == "" {
+			return errors.New("catalog source namespace requires the catalog source")
+		}
+		if !namespaceRegex.MatchString(overrides.SourceNamespace) {
+			return fmt.Errorf("catalog source namespace '%s' is not a valid namespace name", overrides.SourceNamespace)
+		}
+	}
+	if overrides.InstallPlanApproval != "" &&
+		overrides.InstallPlanApproval != models.OperatorSubscriptionInstallPlanApprovalAutomatic &&
+		overrides.InstallPlanApproval != models.OperatorSubscriptionInstallPlanApprovalManual {
+		return fmt.Errorf("install plan approval '%s' must be %s or %s", overrides.InstallPlanApproval,
+			models.OperatorSubscriptionInstallPlanApprovalAutomatic, models.OperatorSubscriptionInstallPlanApprovalManual)
+	}
+	return nil
+}
+
+func isEmptySubscriptionOverrides(overrides *models.OperatorSubscription) bool {
+	return overrides == nil || *overrides == models.OperatorSubscription{}
+}
+
+// generateOperatorManifests generates the manifests of an operator, and applies the subscription overrides of the
+// cluster to the subscription of the operator
+func (mgr *Manager) generateOperatorManifests(operator api.Operator, clusterOperator *models.MonitoredOperator, cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	openshiftManifests, customManifests, err := operator.GenerateManifests(cluster)
+	if err != nil || isEmptySubscriptionOverrides(clusterOperator.Subscription) {
+		return openshiftManifests, customManifests, err
+	}
+
+	subscriptionName := clusterOperator.SubscriptionName
+	if subscriptionName == "" {
+		subscriptionName = operator.GetMonitoredOperator().SubscriptionName
+	}
+	found := false
+	for name, content := range openshiftManifests {
+		var patched bool
+		content, patched, err = applySubscriptionOverrides(content, subscriptionName, clusterOperator.Subscription)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to override the subscription of operator %s in manifest %s", clusterOperator.Name, name)
+		}
+		if patched {
+			openshiftManifests[name] = content
+			found = true
+		}
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("subscription of operator %s isn't in its manifests", clusterOperator.Name)
+	}
+	return openshiftManifests, customManifests, nil
+}
+
+// applySubscriptionOverrides applies the overrides to the subscription with the given name, if the manifest contains
+// it. Operators whose subscription name depends on the cluster, like LVM, have no name, and then the overrides are
+// applied to their only subscription. Manifests may contain several documents; the ones that aren't the subscription
+// are kept as is.
+func applySubscriptionOverrides(content []byte, subscriptionName string, overrides *models.OperatorSubscription) ([]byte, bool, error) {
+	documents := documentSplitter.Split(string(content), -1)
+	patched := false
+	for i, document := range documents {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		var object map[string]interface{}
+		if err := k8syaml.Unmarshal([]byte(document), &object); err != nil {
+			return nil, false, err
+		}
+		if object["apiVersion"] != subscriptionAPIVersion || object["kind"] != subscriptionKind {
+			continue
+		}
+		metadata, _ := object["metadata"].(map[string]interface{})
+		if metadata == nil || (subscriptionName != "" && metadata["name"] != subscriptionName) {
+			continue
+		}
+
+		spec, _ := object["spec"].(map[string]interface{})
+		if spec == nil {
+			spec = map[string]interface{}{}
+			object["spec"] = spec
+		}
+		setIfNotEmpty(spec, "channel", overrides.Channel)
+		setIfNotEmpty(spec, "startingCSV", overrides.StartingCsv)
+		setIfNotEmpty(spec, "source", overrides.Source)
+		setIfNotEmpty(spec, "sourceNamespace", overrides.SourceNamespace)
+		setIfNotEmpty(spec, "installPlanApproval", overrides.InstallPlanApproval)
+
+		patchedDocument, err := k8syaml.Marshal(object)
+		if err != nil {
+			return nil, false, err
+		}
+		documents[i] = string(patchedDocument)
+		patched = true
+	}
+	if !patched {
+		return content, false, nil
+	}
+
+	var buffer bytes.Buffer
+	for i, document := range documents {
+		if i > 0 {
+			buffer.WriteString("---\n")
+		}
+		buffer.WriteString(document)
+	}
+	return buffer.Bytes(), true, nil
+}
+
+// findSubscriptionSpec returns the spec of the subscription with the given name, or of the only subscription when the
+// name is empty, if the manifest contains it
+func findSubscriptionSpec(content []byte, subscriptionName string) (map[string]interface{}, error) {
+	for _, document := range documentSplitter.Split(string(content), -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		var object map[string]interface{}
+		if err := k8syaml.Unmarshal([]byte(document), &object); err != nil {
+			return nil, err
+		}
+		if object["apiVersion"] != subscriptionAPIVersion || object["kind"] != subscriptionKind {
+			continue
+		}
+		metadata, _ := object["metadata"].(map[string]interface{})
+		if metadata == nil || (subscriptionName != "" && metadata["name"] != subscriptionName) {
+			continue
+		}
+		spec, _ := object["spec"].(map[string]interface{})
+		return spec, nil
+	}
+	return nil, nil
+}
+
+func setIfNotEmpty(spec map[string]interface{}, key string, value string) {
+	if value != "" {
+		spec[key] = value
+	}
+}
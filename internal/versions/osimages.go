@@ -55,7 +55,7 @@ func validateOSImage(osImage *models.OsImage) error {
 		return errors.Errorf("osImage version '%s' CPU architecture is missing", *osImage.OpenshiftVersion)
 	}
 	if err := osImage.Validate(strfmt.Default); err != nil {
-		return errors.Wrap(err, fmt.Sprintf("osImage version '%s' CPU architecture is not valid", *osImage.OpenshiftVersion))
+		return errors.Wrap(err, fmt.Sprintf("osImage version '%s' is not valid", *osImage.OpenshiftVersion))
 	}
 
 	return nil
@@ -75,6 +75,21 @@ var _ = Describe("NewOSImages", func() {
 		_, err := NewOSImages(osImages, imageServiceEnabled)
 		Expect(err).Should(HaveOccurred())
 	})
+
+	It("should fail when a boot artifact digest is not valid", func() {
+		osImages := models.OsImages{
+			{
+				OpenshiftVersion: swag.String("4.14"),
+				Version:          swag.String("4.14.213113"),
+				URL:              swag.String("foobar-4.14"),
+				CPUArchitecture:  swag.String(common.X86CPUArchitecture),
+				KernelSha256:     "not-a-digest",
+			},
+		}
+
+		_, err := NewOSImages(osImages, imageServiceEnabled)
+		Expect(err).Should(HaveOccurred())
+	})
 })
 
 var _ = Describe("GetOsImage", func() {
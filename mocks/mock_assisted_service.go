@@ -196,6 +196,20 @@ func (mr *MockInstallerAPIMockRecorder) ListClusterHosts(ctx, params any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterHosts", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusterHosts), ctx, params)
 }
 
+// ListDiscoveryCustomizationRevisions mocks base method.
+func (m *MockInstallerAPI) ListDiscoveryCustomizationRevisions(ctx context.Context, params installer.ListDiscoveryCustomizationRevisionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscoveryCustomizationRevisions", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListDiscoveryCustomizationRevisions indicates an expected call of ListDiscoveryCustomizationRevisions.
+func (mr *MockInstallerAPIMockRecorder) ListDiscoveryCustomizationRevisions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveryCustomizationRevisions", reflect.TypeOf((*MockInstallerAPI)(nil).ListDiscoveryCustomizationRevisions), ctx, params)
+}
+
 // ListInfraEnvs mocks base method.
 func (m *MockInstallerAPI) ListInfraEnvs(ctx context.Context, params installer.ListInfraEnvsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -518,6 +532,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetHostIgnition(ctx, params any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostIgnition), ctx, params)
 }
 
+// V2GetHostIgnitionPreview mocks base method.
+func (m *MockInstallerAPI) V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetHostIgnitionPreview", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetHostIgnitionPreview indicates an expected call of V2GetHostIgnitionPreview.
+func (mr *MockInstallerAPIMockRecorder) V2GetHostIgnitionPreview(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostIgnitionPreview", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostIgnitionPreview), ctx, params)
+}
+
 // V2GetIgnoredValidations mocks base method.
 func (m *MockInstallerAPI) V2GetIgnoredValidations(ctx context.Context, params installer.V2GetIgnoredValidationsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -532,6 +560,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetIgnoredValidations(ctx, params any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetIgnoredValidations", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetIgnoredValidations), ctx, params)
 }
 
+// V2GetInfraEnvIgnitionPreview mocks base method.
+func (m *MockInstallerAPI) V2GetInfraEnvIgnitionPreview(ctx context.Context, params installer.V2GetInfraEnvIgnitionPreviewParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetInfraEnvIgnitionPreview", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetInfraEnvIgnitionPreview indicates an expected call of V2GetInfraEnvIgnitionPreview.
+func (mr *MockInstallerAPIMockRecorder) V2GetInfraEnvIgnitionPreview(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetInfraEnvIgnitionPreview", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetInfraEnvIgnitionPreview), ctx, params)
+}
+
 // V2GetNextSteps mocks base method.
 func (m *MockInstallerAPI) V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -644,6 +686,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListClusters(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusters), ctx, params)
 }
 
+// V2ListDownloadAuditRecords mocks base method.
+func (m *MockInstallerAPI) V2ListDownloadAuditRecords(ctx context.Context, params installer.V2ListDownloadAuditRecordsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListDownloadAuditRecords", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListDownloadAuditRecords indicates an expected call of V2ListDownloadAuditRecords.
+func (mr *MockInstallerAPIMockRecorder) V2ListDownloadAuditRecords(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListDownloadAuditRecords", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListDownloadAuditRecords), ctx, params)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -714,6 +770,20 @@ func (mr *MockInstallerAPIMockRecorder) V2RegisterHost(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2RegisterHost), ctx, params)
 }
 
+// V2RenderClusterManifests mocks base method.
+func (m *MockInstallerAPI) V2RenderClusterManifests(ctx context.Context, params installer.V2RenderClusterManifestsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RenderClusterManifests", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RenderClusterManifests indicates an expected call of V2RenderClusterManifests.
+func (mr *MockInstallerAPIMockRecorder) V2RenderClusterManifests(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RenderClusterManifests", reflect.TypeOf((*MockInstallerAPI)(nil).V2RenderClusterManifests), ctx, params)
+}
+
 // V2ResetCluster mocks base method.
 func (m *MockInstallerAPI) V2ResetCluster(ctx context.Context, params installer.V2ResetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -937,3 +1007,17 @@ func (mr *MockInstallerAPIMockRecorder) V2UploadLogs(ctx, params any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).V2UploadLogs), ctx, params)
 }
+
+// V2VerifyHostIgnition mocks base method.
+func (m *MockInstallerAPI) V2VerifyHostIgnition(ctx context.Context, params installer.V2VerifyHostIgnitionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2VerifyHostIgnition", ctx, params)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2VerifyHostIgnition indicates an expected call of V2VerifyHostIgnition.
+func (mr *MockInstallerAPIMockRecorder) V2VerifyHostIgnition(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2VerifyHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).V2VerifyHostIgnition), ctx, params)
+}
@@ -176,6 +176,15 @@ const (
 
 	// ClusterValidationIDOpenshiftLoggingRequirementsSatisfied captures enum value "openshift-logging-requirements-satisfied"
 	ClusterValidationIDOpenshiftLoggingRequirementsSatisfied ClusterValidationID = "openshift-logging-requirements-satisfied"
+
+	// ClusterValidationIDSriovRequirementsSatisfied captures enum value "sriov-requirements-satisfied"
+	ClusterValidationIDSriovRequirementsSatisfied ClusterValidationID = "sriov-requirements-satisfied"
+
+	// ClusterValidationIDStorageCapacityRequirementsSatisfied captures enum value "storage-capacity-requirements-satisfied"
+	ClusterValidationIDStorageCapacityRequirementsSatisfied ClusterValidationID = "storage-capacity-requirements-satisfied"
+
+	// ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied captures enum value "declarative-operators-requirements-satisfied"
+	ClusterValidationIDDeclarativeOperatorsRequirementsSatisfied ClusterValidationID = "declarative-operators-requirements-satisfied"
 )
 
 // for schema
@@ -183,7 +192,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","osc-requirements-satisfied","network-type-valid","custom-manifests-requirements-satisfied","platform-requirements-satisfied","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","openshift-ai-gpu-requirements-satisfied","authorino-requirements-satisfied","nmstate-requirements-satisfied","amd-gpu-requirements-satisfied","kmm-requirements-satisfied","node-healthcheck-requirements-satisfied","self-node-remediation-requirements-satisfied","fence-agents-remediation-requirements-satisfied","node-maintenance-requirements-satisfied","kube-descheduler-requirements-satisfied","cluster-observability-requirements-satisfied","numa-resources-requirements-satisfied","oadp-requirements-satisfied","metallb-requirements-satisfied","loki-requirements-satisfied","openshift-logging-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","osc-requirements-satisfied","network-type-valid","custom-manifests-requirements-satisfied","platform-requirements-satisfied","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","openshift-ai-gpu-requirements-satisfied","authorino-requirements-satisfied","nmstate-requirements-satisfied","amd-gpu-requirements-satisfied","kmm-requirements-satisfied","node-healthcheck-requirements-satisfied","self-node-remediation-requirements-satisfied","fence-agents-remediation-requirements-satisfied","node-maintenance-requirements-satisfied","kube-descheduler-requirements-satisfied","cluster-observability-requirements-satisfied","numa-resources-requirements-satisfied","oadp-requirements-satisfied","metallb-requirements-satisfied","loki-requirements-satisfied","openshift-logging-requirements-satisfied","sriov-requirements-satisfied","storage-capacity-requirements-satisfied","declarative-operators-requirements-satisfied"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
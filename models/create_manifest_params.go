@@ -20,7 +20,7 @@ import (
 // swagger:model create-manifest-params
 type CreateManifestParams struct {
 
-	// base64 encoded manifest content.
+	// base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.
 	// Required: true
 	Content *string `json:"content"`
 
@@ -197,6 +197,9 @@ const (
 
 	// FeatureSupportLevelIDOPENSHIFTLOGGING captures enum value "OPENSHIFT_LOGGING"
 	FeatureSupportLevelIDOPENSHIFTLOGGING FeatureSupportLevelID = "OPENSHIFT_LOGGING"
+
+	// FeatureSupportLevelIDSRIOV captures enum value "SRIOV"
+	FeatureSupportLevelIDSRIOV FeatureSupportLevelID = "SRIOV"
 )
 
 // for schema
@@ -204,7 +207,7 @@ var featureSupportLevelIdEnum []interface{}
 
 func init() {
 	var res []FeatureSupportLevelID
-	if err := json.Unmarshal([]byte(`["SNO","TNA","TNF","VIP_AUTO_ALLOC","CUSTOM_MANIFEST","SINGLE_NODE_EXPANSION","LVM","ODF","LSO","CNV","MCE","MTV","OSC","NUTANIX_INTEGRATION","BAREMETAL_PLATFORM","NONE_PLATFORM","VSPHERE_INTEGRATION","DUAL_STACK_VIPS","CLUSTER_MANAGED_NETWORKING","USER_MANAGED_NETWORKING","MINIMAL_ISO","FULL_ISO","EXTERNAL_PLATFORM_OCI","DUAL_STACK","PLATFORM_MANAGED_NETWORKING","EXTERNAL_PLATFORM","OVN_NETWORK_TYPE","SDN_NETWORK_TYPE","CILIUM_NETWORK_TYPE","CALICO_NETWORK_TYPE","CISCO_ACI_NETWORK_TYPE","NONE_NETWORK_TYPE","NODE_FEATURE_DISCOVERY","NVIDIA_GPU","PIPELINES","SERVICEMESH","SERVERLESS","OPENSHIFT_AI","NON_STANDARD_HA_CONTROL_PLANE","AUTHORINO","USER_MANAGED_LOAD_BALANCER","NMSTATE","AMD_GPU","KMM","NODE_HEALTHCHECK","SELF_NODE_REMEDIATION","FENCE_AGENTS_REMEDIATION","NODE_MAINTENANCE","KUBE_DESCHEDULER","CLUSTER_OBSERVABILITY","NUMA_RESOURCES","OADP","METALLB","DUAL_STACK_PRIMARY_IPV6","LOKI","OPENSHIFT_LOGGING"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["SNO","TNA","TNF","VIP_AUTO_ALLOC","CUSTOM_MANIFEST","SINGLE_NODE_EXPANSION","LVM","ODF","LSO","CNV","MCE","MTV","OSC","NUTANIX_INTEGRATION","BAREMETAL_PLATFORM","NONE_PLATFORM","VSPHERE_INTEGRATION","DUAL_STACK_VIPS","CLUSTER_MANAGED_NETWORKING","USER_MANAGED_NETWORKING","MINIMAL_ISO","FULL_ISO","EXTERNAL_PLATFORM_OCI","DUAL_STACK","PLATFORM_MANAGED_NETWORKING","EXTERNAL_PLATFORM","OVN_NETWORK_TYPE","SDN_NETWORK_TYPE","CILIUM_NETWORK_TYPE","CALICO_NETWORK_TYPE","CISCO_ACI_NETWORK_TYPE","NONE_NETWORK_TYPE","NODE_FEATURE_DISCOVERY","NVIDIA_GPU","PIPELINES","SERVICEMESH","SERVERLESS","OPENSHIFT_AI","NON_STANDARD_HA_CONTROL_PLANE","AUTHORINO","USER_MANAGED_LOAD_BALANCER","NMSTATE","AMD_GPU","KMM","NODE_HEALTHCHECK","SELF_NODE_REMEDIATION","FENCE_AGENTS_REMEDIATION","NODE_MAINTENANCE","KUBE_DESCHEDULER","CLUSTER_OBSERVABILITY","NUMA_RESOURCES","OADP","METALLB","DUAL_STACK_PRIMARY_IPV6","LOKI","OPENSHIFT_LOGGING","SRIOV"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -83,7 +83,11 @@ type Host struct {
 
 	// Json formatted string containing the user overrides for the host's pointer ignition
 	// Example: {\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}
-	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text"`
+	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text;serializer:encrypted"`
+
+	// The Butane config that ignition_config_overrides was translated from, if the overrides were supplied as a Butane config.
+	// Read Only: true
+	IgnitionConfigOverridesSource string `json:"ignition_config_overrides_source,omitempty" gorm:"type:text;serializer:encrypted"`
 
 	// True if the token to fetch the ignition from ignition_endpoint_url is set.
 	IgnitionEndpointTokenSet bool `json:"ignition_endpoint_token_set,omitempty"`
@@ -765,6 +769,10 @@ func (m *Host) validateUpdatedAt(formats strfmt.Registry) error {
 func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateIgnitionConfigOverridesSource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLogsInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -791,6 +799,15 @@ func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 	return nil
 }
 
+func (m *Host) contextValidateIgnitionConfigOverridesSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "ignition_config_overrides_source", "body", string(m.IgnitionConfigOverridesSource)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) contextValidateLogsInfo(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.LogsInfo.ContextValidate(ctx, formats); err != nil {
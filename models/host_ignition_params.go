@@ -17,7 +17,7 @@ import (
 // swagger:model host-ignition-params
 type HostIgnitionParams struct {
 
-	// config
+	// Json formatted string containing the user overrides for the host's pointer ignition, or a Butane config of the openshift or fcos variant that is translated to it.
 	Config string `json:"config,omitempty"`
 }
 
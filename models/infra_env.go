@@ -43,6 +43,13 @@ type InfraEnv struct {
 	// Format: date-time
 	CreatedAt *timeext.Time `json:"created_at" gorm:"type:timestamp with time zone"`
 
+	// JSON formatted discovery-customization that is rendered into the discovery ignition.
+	DiscoveryCustomization *string `json:"discovery_customization,omitempty" gorm:"type:text;serializer:encrypted"`
+
+	// The revision of the discovery customization, incremented whenever it changes. Zero if the infra-env was never customized.
+	// Minimum: 0
+	DiscoveryCustomizationRevision int64 `json:"discovery_customization_revision,omitempty"`
+
 	// download url
 	DownloadURL string `json:"download_url,omitempty"`
 
@@ -66,7 +73,11 @@ type InfraEnv struct {
 	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
 
 	// Json formatted string containing the user overrides for the initial ignition config.
-	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
+	IgnitionConfigOverride string `json:"ignition_config_override,omitempty" gorm:"serializer:encrypted"`
+
+	// The Butane config that ignition_config_override was translated from, if the override was supplied as a Butane config.
+	// Read Only: true
+	IgnitionConfigOverrideSource string `json:"ignition_config_override_source,omitempty" gorm:"serializer:encrypted"`
 
 	// JSON formatted string array representing the discovery image kernel arguments.
 	KernelArguments *string `json:"kernel_arguments,omitempty" gorm:"type:text"`
@@ -145,6 +156,10 @@ func (m *InfraEnv) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiscoveryCustomizationRevision(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateExpiresAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -271,6 +286,18 @@ func (m *InfraEnv) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InfraEnv) validateDiscoveryCustomizationRevision(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiscoveryCustomizationRevision) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("discovery_customization_revision", "body", m.DiscoveryCustomizationRevision, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *InfraEnv) validateExpiresAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.ExpiresAt) { // not required
 		return nil
@@ -450,6 +477,10 @@ func (m *InfraEnv) validateUpdatedAt(formats strfmt.Registry) error {
 func (m *InfraEnv) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateIgnitionConfigOverrideSource(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -464,6 +495,15 @@ func (m *InfraEnv) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnv) contextValidateIgnitionConfigOverrideSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "ignition_config_override_source", "body", string(m.IgnitionConfigOverrideSource)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *InfraEnv) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Proxy != nil {
@@ -39,7 +39,10 @@ type InfraEnvCreateParams struct {
 	// Enum: [x86_64 aarch64 arm64 ppc64le s390x]
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
-	// JSON formatted string containing the user overrides for the initial ignition config.
+	// discovery customization
+	DiscoveryCustomization *DiscoveryCustomization `json:"discovery_customization,omitempty"`
+
+	// JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
 	// image type
@@ -99,6 +102,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiscoveryCustomization(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -212,6 +219,25 @@ func (m *InfraEnvCreateParams) validateCPUArchitecture(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateDiscoveryCustomization(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiscoveryCustomization) { // not required
+		return nil
+	}
+
+	if m.DiscoveryCustomization != nil {
+		if err := m.DiscoveryCustomization.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discovery_customization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discovery_customization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -337,6 +363,10 @@ func (m *InfraEnvCreateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateDiscoveryCustomization(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -359,6 +389,22 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateDiscoveryCustomization(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DiscoveryCustomization != nil {
+		if err := m.DiscoveryCustomization.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discovery_customization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discovery_customization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
@@ -27,7 +27,10 @@ type InfraEnvUpdateParams struct {
 	// Max Length: 65535
 	AdditionalTrustBundle *string `json:"additional_trust_bundle,omitempty"`
 
-	// JSON formatted string containing the user overrides for the initial ignition config.
+	// discovery customization
+	DiscoveryCustomization *DiscoveryCustomization `json:"discovery_customization,omitempty"`
+
+	// JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
 	// image type
@@ -74,6 +77,10 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiscoveryCustomization(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateImageType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -116,6 +123,25 @@ func (m *InfraEnvUpdateParams) validateAdditionalTrustBundle(formats strfmt.Regi
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateDiscoveryCustomization(formats strfmt.Registry) error {
+	if swag.IsZero(m.DiscoveryCustomization) { // not required
+		return nil
+	}
+
+	if m.DiscoveryCustomization != nil {
+		if err := m.DiscoveryCustomization.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discovery_customization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discovery_customization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateImageType(formats strfmt.Registry) error {
 	if swag.IsZero(m.ImageType) { // not required
 		return nil
@@ -223,6 +249,10 @@ func (m *InfraEnvUpdateParams) validateStaticNetworkConfig(formats strfmt.Regist
 func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateDiscoveryCustomization(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateImageType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -245,6 +275,22 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateDiscoveryCustomization(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DiscoveryCustomization != nil {
+		if err := m.DiscoveryCustomization.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("discovery_customization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("discovery_customization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateImageType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.ImageType.ContextValidate(ctx, formats); err != nil {
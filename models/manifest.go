@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -27,6 +28,9 @@ type Manifest struct {
 	// Enum: [manifests openshift]
 	Folder string `json:"folder,omitempty"`
 
+	// The issues that were found in the manifest when it was last checked against the other manifests of the cluster.
+	LintIssues []*ManifestLintIssue `json:"lint_issues"`
+
 	// Describes whether manifest is sourced from a user or created by the system.
 	// Enum: [user system]
 	ManifestSource string `json:"manifest_source,omitempty"`
@@ -40,6 +44,10 @@ func (m *Manifest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLintIssues(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateManifestSource(formats); err != nil {
 		res = append(res, err)
 	}
@@ -92,6 +100,32 @@ func (m *Manifest) validateFolder(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Manifest) validateLintIssues(formats strfmt.Registry) error {
+	if swag.IsZero(m.LintIssues) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LintIssues); i++ {
+		if swag.IsZero(m.LintIssues[i]) { // not required
+			continue
+		}
+
+		if m.LintIssues[i] != nil {
+			if err := m.LintIssues[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lint_issues" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lint_issues" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var manifestTypeManifestSourcePropEnum []interface{}
 
 func init() {
@@ -134,8 +168,37 @@ func (m *Manifest) validateManifestSource(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this manifest based on context it is used
+// ContextValidate validate this manifest based on the context it is used
 func (m *Manifest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLintIssues(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Manifest) contextValidateLintIssues(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.LintIssues); i++ {
+
+		if m.LintIssues[i] != nil {
+			if err := m.LintIssues[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lint_issues" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lint_issues" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
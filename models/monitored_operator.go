@@ -27,9 +27,18 @@ type MonitoredOperator struct {
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primaryKey"`
 
+	// Whether the operator was installed after the cluster was installed. The service, instead of the installer, applies its manifests and monitors it.
+	Day2 bool `json:"day2,omitempty"`
+
 	// Whether the operator can't be installed without being required by another operator.
 	DependencyOnly bool `json:"dependency_only,omitempty"`
 
+	// failure policy
+	FailurePolicy OperatorFailurePolicy `json:"failure_policy,omitempty"`
+
+	// Number of times the subscription of the operator is reapplied when it fails and its failure policy is retry. 3 when not set.
+	MaxRetries int64 `json:"max_retries,omitempty"`
+
 	// Unique name of the operator.
 	Name string `json:"name,omitempty" gorm:"primaryKey"`
 
@@ -39,9 +48,15 @@ type MonitoredOperator struct {
 	// operator type
 	OperatorType OperatorType `json:"operator_type,omitempty"`
 
-	// Blob of operator-dependent parameters that are required for installation.
+	// JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
 
+	// Number of times the subscription of the operator was reapplied after it failed.
+	Retries int64 `json:"retries,omitempty"`
+
+	// Whether another attempt of the failed operator was recorded, and its subscription wasn't reapplied yet.
+	RetryPending bool `json:"retry_pending,omitempty"`
+
 	// IDs of the bundles this operator was selected through. Empty for standalone selections. An operator can belong to multiple bundles.
 	SourceBundles pq.StringArray `json:"source_bundles" gorm:"type:text[]"`
 
@@ -55,6 +70,9 @@ type MonitoredOperator struct {
 	// Format: date-time
 	StatusUpdatedAt strfmt.DateTime `json:"status_updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// subscription
+	Subscription *OperatorSubscription `json:"subscription,omitempty" gorm:"embedded;embeddedPrefix:subscription_"`
+
 	// The name of the subscription of the operator.
 	SubscriptionName string `json:"subscription_name,omitempty"`
 
@@ -73,6 +91,10 @@ func (m *MonitoredOperator) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFailurePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOperatorType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -85,6 +107,10 @@ func (m *MonitoredOperator) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSubscription(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -103,6 +129,23 @@ func (m *MonitoredOperator) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *MonitoredOperator) validateFailurePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailurePolicy) { // not required
+		return nil
+	}
+
+	if err := m.FailurePolicy.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("failure_policy")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("failure_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) validateOperatorType(formats strfmt.Registry) error {
 	if swag.IsZero(m.OperatorType) { // not required
 		return nil
@@ -149,10 +192,33 @@ func (m *MonitoredOperator) validateStatusUpdatedAt(formats strfmt.Registry) err
 	return nil
 }
 
+func (m *MonitoredOperator) validateSubscription(formats strfmt.Registry) error {
+	if swag.IsZero(m.Subscription) { // not required
+		return nil
+	}
+
+	if m.Subscription != nil {
+		if err := m.Subscription.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("subscription")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("subscription")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this monitored operator based on the context it is used
 func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFailurePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOperatorType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -161,12 +227,30 @@ func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSubscription(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *MonitoredOperator) contextValidateFailurePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.FailurePolicy.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("failure_policy")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("failure_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) contextValidateOperatorType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.OperatorType.ContextValidate(ctx, formats); err != nil {
@@ -195,6 +279,22 @@ func (m *MonitoredOperator) contextValidateStatus(ctx context.Context, formats s
 	return nil
 }
 
+func (m *MonitoredOperator) contextValidateSubscription(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Subscription != nil {
+		if err := m.Subscription.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("subscription")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("subscription")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *MonitoredOperator) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -8,8 +8,10 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // OperatorCreateParams operator create params
@@ -17,20 +19,143 @@ import (
 // swagger:model operator-create-params
 type OperatorCreateParams struct {
 
+	// failure policy
+	FailurePolicy OperatorFailurePolicy `json:"failure_policy,omitempty"`
+
+	// Number of times the subscription of the operator is reapplied when it fails. Only valid with the retry failure policy, 3 when not set.
+	// Maximum: 10
+	// Minimum: 1
+	MaxRetries int64 `json:"max_retries,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
-	// Blob of operator-dependent parameters that are required for installation.
+	// JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
+
+	// subscription
+	Subscription *OperatorSubscription `json:"subscription,omitempty" gorm:"embedded;embeddedPrefix:subscription_"`
 }
 
 // Validate validates this operator create params
 func (m *OperatorCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFailurePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxRetries(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubscription(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) validateFailurePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailurePolicy) { // not required
+		return nil
+	}
+
+	if err := m.FailurePolicy.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("failure_policy")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("failure_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *OperatorCreateParams) validateMaxRetries(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxRetries) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_retries", "body", m.MaxRetries, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("max_retries", "body", m.MaxRetries, 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OperatorCreateParams) validateSubscription(formats strfmt.Registry) error {
+	if swag.IsZero(m.Subscription) { // not required
+		return nil
+	}
+
+	if m.Subscription != nil {
+		if err := m.Subscription.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("subscription")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("subscription")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this operator create params based on context it is used
+// ContextValidate validate this operator create params based on the context it is used
 func (m *OperatorCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFailurePolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSubscription(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) contextValidateFailurePolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.FailurePolicy.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("failure_policy")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("failure_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *OperatorCreateParams) contextValidateSubscription(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Subscription != nil {
+		if err := m.Subscription.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("subscription")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("subscription")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -38,6 +38,9 @@ type OperatorProperty struct {
 
 	// Values to select from
 	Options []string `json:"options"`
+
+	// JSON Schema (draft 4) of the value of the property, that the value is validated against. Values of mandatory properties must be set, and properties that aren't listed aren't allowed.
+	Schema map[string]interface{} `json:"schema,omitempty"`
 }
 
 // Validate validates this operator property
@@ -25,11 +25,22 @@ type OsImage struct {
 	// Enum: [x86_64 aarch64 arm64 ppc64le s390x]
 	CPUArchitecture *string `json:"cpu_architecture" gorm:"default:'x86_64'"`
 
+	// The expected SHA-256 digest of the kernel of the OS image, as served by the image service.
+	// Pattern: ^[a-f0-9]{64}$
+	KernelSha256 string `json:"kernel_sha256,omitempty"`
+
+	// The URL of a detached signature of the kernel of the OS image, which iPXE verifies the kernel with before booting it.
+	KernelSignatureURL string `json:"kernel_signature_url,omitempty"`
+
 	// Version of the operating system image
 	// Example: 4.12
 	// Required: true
 	OpenshiftVersion *string `json:"openshift_version"`
 
+	// The expected SHA-256 digest of the rootfs of the OS image, as served by the image service.
+	// Pattern: ^[a-f0-9]{64}$
+	RootfsSha256 string `json:"rootfs_sha256,omitempty"`
+
 	// The base OS image used for the discovery iso.
 	// Required: true
 	URL *string `json:"url"`
@@ -47,10 +58,18 @@ func (m *OsImage) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateKernelSha256(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOpenshiftVersion(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootfsSha256(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateURL(formats); err != nil {
 		res = append(res, err)
 	}
@@ -117,6 +136,18 @@ func (m *OsImage) validateCPUArchitecture(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *OsImage) validateKernelSha256(formats strfmt.Registry) error {
+	if swag.IsZero(m.KernelSha256) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("kernel_sha256", "body", m.KernelSha256, `^[a-f0-9]{64}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *OsImage) validateOpenshiftVersion(formats strfmt.Registry) error {
 
 	if err := validate.Required("openshift_version", "body", m.OpenshiftVersion); err != nil {
@@ -126,6 +157,18 @@ func (m *OsImage) validateOpenshiftVersion(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *OsImage) validateRootfsSha256(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootfsSha256) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("rootfs_sha256", "body", m.RootfsSha256, `^[a-f0-9]{64}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *OsImage) validateURL(formats strfmt.Registry) error {
 
 	if err := validate.Required("url", "body", m.URL); err != nil {
@@ -30,7 +30,7 @@ type UpdateManifestParams struct {
 	// Enum: [manifests openshift]
 	Folder string `json:"folder"`
 
-	// The new base64 encoded manifest content.
+	// The new base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.
 	UpdatedContent *string `json:"updated_content,omitempty"`
 
 	// The new file name for the manifest.
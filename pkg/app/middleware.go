@@ -19,6 +19,7 @@ import (
 const (
 	ipxeScriptQueryKey   = "file_name"
 	ipxeScriptQueryValue = "ipxe-script"
+	grubConfigQueryValue = "grub-config"
 )
 
 var ipxeScriptPattern = regexp.MustCompile(fmt.Sprintf(`^%s/v2/infra-envs/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/downloads/files`, client.DefaultBasePath))
@@ -111,7 +112,7 @@ func WithIPXEScriptMiddleware(next http.Handler) http.Handler {
 				http.NotFound(w, r)
 				return
 			}
-			if queryValue := r.URL.Query().Get(ipxeScriptQueryKey); queryValue != ipxeScriptQueryValue {
+			if queryValue := r.URL.Query().Get(ipxeScriptQueryKey); queryValue != ipxeScriptQueryValue && queryValue != grubConfigQueryValue {
 				// Invalid file name requested
 				http.NotFound(w, r)
 				return
@@ -134,6 +134,9 @@ var _ = Describe("WithInitrdViaHTTPMiddleware", func() {
 		respStatus := doRequestWithPath("/api/assisted-install/v2/infra-envs/a7acfb01-d89f-40c8-82d7-02b20cf00173/downloads/files", map[string]string{"file_name": "ipxe-script"}, false)
 		Expect(respStatus).To(Equal(200))
 
+		respStatus = doRequestWithPath("/api/assisted-install/v2/infra-envs/a7acfb01-d89f-40c8-82d7-02b20cf00173/downloads/files", map[string]string{"file_name": "grub-config"}, false)
+		Expect(respStatus).To(Equal(200))
+
 		respStatus = doRequestWithPath("/api/assisted-install/v2/infra-envs/a7acfb01-d89f-40c8-82d7-02b20cf00173/downloads/files", map[string]string{"file_name": "some-other-file"}, false)
 		Expect(respStatus).To(Equal(404))
 
@@ -345,3 +345,27 @@ func (f fakeInventory) V2UpdateClusterUISettings(ctx context.Context, params ins
 func (f fakeInventory) V2UpdateClusterFinalizingProgress(ctx context.Context, params installer.V2UpdateClusterFinalizingProgressParams) middleware.Responder {
 	return installer.NewV2UpdateClusterFinalizingProgressOK()
 }
+
+func (f fakeInventory) V2RenderClusterManifests(ctx context.Context, params installer.V2RenderClusterManifestsParams) middleware.Responder {
+	return installer.NewV2RenderClusterManifestsOK()
+}
+
+func (f fakeInventory) V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder {
+	return installer.NewV2GetHostIgnitionPreviewOK()
+}
+
+func (f fakeInventory) V2GetInfraEnvIgnitionPreview(ctx context.Context, params installer.V2GetInfraEnvIgnitionPreviewParams) middleware.Responder {
+	return installer.NewV2GetInfraEnvIgnitionPreviewOK()
+}
+
+func (f fakeInventory) V2ListDownloadAuditRecords(ctx context.Context, params installer.V2ListDownloadAuditRecordsParams) middleware.Responder {
+	return installer.NewV2ListDownloadAuditRecordsOK()
+}
+
+func (f fakeInventory) ListDiscoveryCustomizationRevisions(ctx context.Context, params installer.ListDiscoveryCustomizationRevisionsParams) middleware.Responder {
+	return installer.NewListDiscoveryCustomizationRevisionsOK()
+}
+
+func (f fakeInventory) V2VerifyHostIgnition(ctx context.Context, params installer.V2VerifyHostIgnitionParams) middleware.Responder {
+	return installer.NewV2VerifyHostIgnitionOK()
+}
@@ -2,6 +2,7 @@ package context
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"runtime"
 	"strings"
@@ -18,6 +19,9 @@ const (
 	InfraEnvId = "infra_env_id"
 )
 
+// SourceIP is the context key of the address the request was sent from
+const SourceIP = "source_ip"
+
 func GetParam(ctx context.Context, key string) string {
 	val := ctx.Value(contextKey(key))
 	if val == nil {
@@ -73,10 +77,63 @@ func goid() string {
 	return idField
 }
 
+// sourceIP returns the client address of the request. X-Forwarded-For can be
+// set by the client, so it is only followed from the right, through the hops
+// added by the trusted proxies, up to the first address that isn't one of them.
+func sourceIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	var forwarded []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0 && isTrustedProxy(addr, trustedProxies); i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		addr = hop
+	}
+	return addr
+}
+
+func isTrustedProxy(addr string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTrustedProxies parses a comma separated list of the CIDRs of the
+// proxies that the service trusts to set X-Forwarded-For
+func ParseTrustedProxies(cidrs string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(cidrs, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
 // openapi middleware handler that takes matched params from the route and put them
 // on the context for further use (mainly by logs). parameters are prefixed to avoid
 // conflicts (for example: cluster_id --> PARAM$cluster_id)
-func ContextHandler() func(http.Handler) http.Handler {
+func ContextHandler(trustedProxies ...*net.IPNet) func(http.Handler) http.Handler {
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -88,8 +145,9 @@ func ContextHandler() func(http.Handler) http.Handler {
 				for _, p := range mr.Params {
 					ctx = context.WithValue(ctx, contextKey(p.Name), p.Value)
 				}
-				r = r.WithContext(ctx)
 			}
+			ctx = context.WithValue(ctx, contextKey(SourceIP), sourceIP(r, trustedProxies))
+			r = r.WithContext(ctx)
 
 			//pass control to the next handler in the chain
 			next.ServeHTTP(w, r)
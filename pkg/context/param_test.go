@@ -0,0 +1,68 @@
+package context
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestContext(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Context tests Suite")
+}
+
+var _ = Describe("ParseTrustedProxies", func() {
+	It("parses a comma separated list of CIDRs", func() {
+		networks, err := ParseTrustedProxies(" 10.0.0.0/8, ,fd00::/8,")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networks).To(HaveLen(2))
+		Expect(networks[0].String()).To(Equal("10.0.0.0/8"))
+		Expect(networks[1].String()).To(Equal("fd00::/8"))
+	})
+
+	It("returns no networks for an empty list", func() {
+		networks, err := ParseTrustedProxies("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networks).To(BeEmpty())
+	})
+
+	It("rejects an invalid CIDR", func() {
+		_, err := ParseTrustedProxies("10.0.0.0/8,10.0.0.1")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("sourceIP", func() {
+	var trustedProxies []*net.IPNet
+
+	BeforeEach(func() {
+		var err error
+		trustedProxies, err = ParseTrustedProxies("10.0.0.0/8")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("returns the client address",
+		func(remoteAddr string, forwardedFor []string, expected string) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = remoteAddr
+			for _, header := range forwardedFor {
+				r.Header.Add("X-Forwarded-For", header)
+			}
+			Expect(sourceIP(r, trustedProxies)).To(Equal(expected))
+		},
+		Entry("without X-Forwarded-For", "192.0.2.1:1234", nil, "192.0.2.1"),
+		Entry("without a port", "192.0.2.1", nil, "192.0.2.1"),
+		Entry("X-Forwarded-For of a peer that isn't trusted", "192.0.2.1:1234", []string{"198.51.100.1"}, "192.0.2.1"),
+		Entry("X-Forwarded-For of a trusted proxy", "10.0.0.1:1234", []string{"198.51.100.1"}, "198.51.100.1"),
+		Entry("through trusted proxies", "10.0.0.1:1234", []string{"198.51.100.1, 10.0.0.2"}, "198.51.100.1"),
+		Entry("through several headers", "10.0.0.1:1234", []string{"198.51.100.1", "10.0.0.2"}, "198.51.100.1"),
+		Entry("addresses set by the client", "10.0.0.1:1234", []string{"203.0.113.1, 198.51.100.1"}, "198.51.100.1"),
+		Entry("an invalid hop", "10.0.0.1:1234", []string{"198.51.100.1, unknown"}, "10.0.0.1"),
+		Entry("only trusted proxies", "10.0.0.1:1234", []string{"10.0.0.2"}, "10.0.0.2"),
+	)
+})
@@ -13,12 +13,14 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 //go:generate mockgen --build_flags=--mod=mod -package generator -destination mock_install_config.go . InstallConfigGenerator
 type InstallConfigGenerator interface {
 	GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string, forceInsecurePolicyJson bool) error
+	RenderManifests(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string, forceInsecurePolicyJson bool, generatedManifests map[string][]byte) (*ignition.RenderedManifests, error)
 }
 
 type Config struct {
@@ -100,3 +102,30 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 
 	return nil
 }
+
+// RenderManifests creates the installer manifests of the cluster in a scratch directory and applies the cluster
+// manifests to them, without generating ignition files or uploading anything
+func (k *installGenerator) RenderManifests(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string,
+	forceInsecurePolicyJson bool, generatedManifests map[string][]byte) (*ignition.RenderedManifests, error) {
+	log := logutil.FromContext(ctx, k.log)
+	if k.Config.DummyIgnition {
+		return nil, errors.New("rendering manifests is not supported when dummy ignition is enabled")
+	}
+	err := os.MkdirAll(k.workDir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	renderWorkDir, err := os.MkdirTemp(k.workDir, cluster.ID.String()+".render.")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if removeError := os.RemoveAll(renderWorkDir); removeError != nil {
+			log.WithError(removeError).Error("Failed to clean up rendered manifests directory")
+		}
+	}()
+
+	renderer := ignition.NewManifestsRenderer(renderWorkDir, &cluster, releaseImage, k.Config.ReleaseImageMirror,
+		k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.providerRegistry, installerReleaseImageOverride, k.Config.ClusterTLSCertOverrideDir, k.manifestApi, k.installerCache)
+	return renderer.RenderManifests(ctx, cfg, forceInsecurePolicyJson, generatedManifests)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	common "github.com/openshift/assisted-service/internal/common"
+	ignition "github.com/openshift/assisted-service/internal/ignition"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,3 +55,18 @@ func (mr *MockInstallConfigGeneratorMockRecorder) GenerateInstallConfig(ctx, clu
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateInstallConfig", reflect.TypeOf((*MockInstallConfigGenerator)(nil).GenerateInstallConfig), ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, forceInsecurePolicyJson)
 }
+
+// RenderManifests mocks base method.
+func (m *MockInstallConfigGenerator) RenderManifests(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string, forceInsecurePolicyJson bool, generatedManifests map[string][]byte) (*ignition.RenderedManifests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifests", ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, forceInsecurePolicyJson, generatedManifests)
+	ret0, _ := ret[0].(*ignition.RenderedManifests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockInstallConfigGeneratorMockRecorder) RenderManifests(ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, forceInsecurePolicyJson, generatedManifests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockInstallConfigGenerator)(nil).RenderManifests), ctx, cluster, cfg, releaseImage, installerReleaseImageOverride, forceInsecurePolicyJson, generatedManifests)
+}
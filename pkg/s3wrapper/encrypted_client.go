@@ -0,0 +1,277 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// sensitiveObjectNames are the objects that hold credentials or pull secrets
+var sensitiveObjectNames = map[string]bool{
+	"kubeconfig":           true,
+	"kubeconfig-noingress": true,
+	"kubeadmin-password":   true,
+	"install-config.yaml":  true,
+}
+
+// EncryptedDownloadPath is served by EncryptedClient.DownloadMiddleware. It
+// replaces the object store presigned URLs of sensitive objects, since the
+// object store would serve the ciphertext.
+var EncryptedDownloadPath = client.DefaultBasePath + "/v2/encrypted-downloads"
+
+const encryptedDownloadTokenKey = "token"
+
+// encryptedDownload is sealed with the keyring into the token of an
+// encrypted download URL, so it can't be forged or modified
+type encryptedDownload struct {
+	ObjectName       string `json:"object_name"`
+	DownloadFilename string `json:"download_filename"`
+	Expiration       int64  `json:"expiration"`
+}
+
+// IsSensitiveObject returns true for objects that are encrypted at rest
+func IsSensitiveObject(objectName string) bool {
+	base := path.Base(objectName)
+	return sensitiveObjectNames[base] || strings.HasSuffix(base, ".ign")
+}
+
+// EncryptedClient encrypts sensitive objects before they are uploaded and
+// decrypts them when they are downloaded. Objects that were uploaded before
+// encryption was enabled are downloaded as they are.
+type EncryptedClient struct {
+	API
+	log            logrus.FieldLogger
+	keyring        *encryption.Keyring
+	serviceBaseURL string
+}
+
+var _ API = &EncryptedClient{}
+
+func NewEncryptedClient(api API, keyring *encryption.Keyring, serviceBaseURL string, log logrus.FieldLogger) *EncryptedClient {
+	return &EncryptedClient{API: api, keyring: keyring, serviceBaseURL: serviceBaseURL, log: log}
+}
+
+func (c *EncryptedClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.UploadWithMetadata(ctx, data, objectName, nil)
+}
+
+func (c *EncryptedClient) UploadWithMetadata(ctx context.Context, data []byte, objectName string, metadata map[string]string) error {
+	if !IsSensitiveObject(objectName) {
+		return c.API.UploadWithMetadata(ctx, data, objectName, metadata)
+	}
+	encrypted, err := c.keyring.Encrypt(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encrypt %s", objectName)
+	}
+	return c.API.UploadWithMetadata(ctx, encrypted, objectName, metadata)
+}
+
+func (c *EncryptedClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.UploadStreamWithMetadata(ctx, reader, objectName, nil)
+}
+
+func (c *EncryptedClient) UploadStreamWithMetadata(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	if !IsSensitiveObject(objectName) {
+		return c.API.UploadStreamWithMetadata(ctx, reader, objectName, metadata)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", objectName)
+	}
+	return c.UploadWithMetadata(ctx, data, objectName, metadata)
+}
+
+func (c *EncryptedClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return c.UploadFileWithMetadata(ctx, filePath, objectName, nil)
+}
+
+func (c *EncryptedClient) UploadFileWithMetadata(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	if !IsSensitiveObject(objectName) {
+		return c.API.UploadFileWithMetadata(ctx, filePath, objectName, metadata)
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", filePath)
+	}
+	return c.UploadWithMetadata(ctx, data, objectName, metadata)
+}
+
+func (c *EncryptedClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	if !IsSensitiveObject(objectName) {
+		return c.API.Download(ctx, objectName)
+	}
+	data, err := c.download(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (c *EncryptedClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	if !IsSensitiveObject(objectName) {
+		return c.API.GetObjectSizeBytes(ctx, objectName)
+	}
+	data, err := c.download(ctx, objectName)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// GeneratePresignedDownloadURL returns a URL of the service for sensitive
+// objects, which decrypts them before they are served
+func (c *EncryptedClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	if !IsSensitiveObject(objectName) {
+		return c.API.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
+	}
+	payload, err := json.Marshal(encryptedDownload{
+		ObjectName:       objectName,
+		DownloadFilename: downloadFilename,
+		Expiration:       time.Now().Add(duration).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	token, err := c.keyring.Encrypt(payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create a download token for %s", objectName)
+	}
+	query := url.Values{encryptedDownloadTokenKey: []string{base64.RawURLEncoding.EncodeToString(token)}}
+	return fmt.Sprintf("%s%s?%s", strings.TrimSuffix(c.serviceBaseURL, "/"), EncryptedDownloadPath, query.Encode()), nil
+}
+
+// DownloadMiddleware serves the URLs returned by GeneratePresignedDownloadURL
+// for sensitive objects. The token of the URL is the only authorization, the
+// same as for the presigned URLs of the object store.
+func (c *EncryptedClient) DownloadMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != EncryptedDownloadPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		download, err := c.parseDownloadToken(r.URL.Query().Get(encryptedDownloadTokenKey))
+		if err != nil {
+			c.log.WithError(err).Warn("Rejected an encrypted object download")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		data, err := c.download(r.Context(), download.ObjectName)
+		if err != nil {
+			c.log.WithError(err).Errorf("Failed to download %s", download.ObjectName)
+			var notFound common.NotFound
+			if errors.As(err, &notFound) {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment;filename=%s", download.DownloadFilename))
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		_, _ = w.Write(data)
+	})
+}
+
+func (c *EncryptedClient) parseDownloadToken(token string) (*encryptedDownload, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !encryption.IsEncrypted(sealed) {
+		return nil, errors.New("malformed download token")
+	}
+	payload, err := c.keyring.Decrypt(sealed)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid download token")
+	}
+	var download encryptedDownload
+	if err = json.Unmarshal(payload, &download); err != nil {
+		return nil, errors.Wrap(err, "invalid download token")
+	}
+	if time.Now().Unix() > download.Expiration {
+		return nil, errors.Errorf("the download token of %s expired", download.ObjectName)
+	}
+	return &download, nil
+}
+
+func (c *EncryptedClient) download(ctx context.Context, objectName string) ([]byte, error) {
+	reader, _, err := c.API.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", objectName)
+	}
+	plaintext, err := c.keyring.Decrypt(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt %s", objectName)
+	}
+	return plaintext, nil
+}
+
+// ReencryptObjects encrypts the sensitive objects of the given clusters that
+// are stored in plaintext or encrypted with a key other than the active one.
+// Only the top level of the directory of every cluster is checked, as that is
+// where the sensitive objects are stored.
+func (c *EncryptedClient) ReencryptObjects(ctx context.Context, clusterIDs []string) (int, error) {
+	var objects []string
+	for _, clusterID := range clusterIDs {
+		prefix := clusterID + "/"
+		clusterObjects, err := c.API.ListObjectsByPrefix(ctx, prefix)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to list the objects of cluster %s", clusterID)
+		}
+		for _, objectName := range clusterObjects {
+			if !strings.Contains(strings.TrimPrefix(objectName, prefix), "/") {
+				objects = append(objects, objectName)
+			}
+		}
+	}
+	count := 0
+	for _, objectName := range objects {
+		if !IsSensitiveObject(objectName) {
+			continue
+		}
+		reader, _, err := c.API.Download(ctx, objectName)
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to download %s", objectName)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to read %s", objectName)
+		}
+		if !c.keyring.NeedsRotation(data) {
+			continue
+		}
+		plaintext, err := c.keyring.Decrypt(data)
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to decrypt %s", objectName)
+		}
+		if err = c.Upload(ctx, plaintext, objectName); err != nil {
+			return count, errors.Wrapf(err, "failed to upload %s", objectName)
+		}
+		c.log.Infof("Re-encrypted object %s", objectName)
+		count++
+	}
+	return count, nil
+}
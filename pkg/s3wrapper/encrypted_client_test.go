@@ -0,0 +1,182 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/encryption"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("EncryptedClient", func() {
+	var (
+		ctx     = context.Background()
+		ctrl    *gomock.Controller
+		mockAPI *MockAPI
+		keyring *encryption.Keyring
+		client  *EncryptedClient
+	)
+
+	newKeyring := func(id string, b byte) *encryption.Keyring {
+		k, err := encryption.NewKeyring(map[string][]byte{id: bytes.Repeat([]byte{b}, 32)}, "")
+		Expect(err).ToNot(HaveOccurred())
+		return k
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAPI = NewMockAPI(ctrl)
+		keyring = newKeyring("key", 1)
+		client = NewEncryptedClient(mockAPI, keyring, "https://service.example.com/", logrus.New())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	downloadReturns := func(objectName string, data []byte) {
+		mockAPI.EXPECT().Download(ctx, objectName).Return(io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil)
+	}
+
+	It("identifies sensitive objects", func() {
+		Expect(IsSensitiveObject("cluster-id/kubeconfig")).To(BeTrue())
+		Expect(IsSensitiveObject("cluster-id/kubeadmin-password")).To(BeTrue())
+		Expect(IsSensitiveObject("cluster-id/install-config.yaml")).To(BeTrue())
+		Expect(IsSensitiveObject("cluster-id/worker.ign")).To(BeTrue())
+		Expect(IsSensitiveObject("cluster-id/logs/cluster_logs.tar")).To(BeFalse())
+		Expect(IsSensitiveObject("cluster-id/manifests/openshift/kubeconfig.yaml")).To(BeFalse())
+	})
+
+	It("encrypts sensitive objects on upload", func() {
+		mockAPI.EXPECT().UploadWithMetadata(ctx, gomock.Any(), "cluster-id/kubeadmin-password", gomock.Nil()).
+			DoAndReturn(func(_ context.Context, data []byte, _ string, _ map[string]string) error {
+				Expect(encryption.IsEncrypted(data)).To(BeTrue())
+				plaintext, err := keyring.Decrypt(data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(plaintext)).To(Equal("password"))
+				return nil
+			})
+		Expect(client.UploadStream(ctx, bytes.NewReader([]byte("password")), "cluster-id/kubeadmin-password")).To(Succeed())
+	})
+
+	It("uploads other objects as they are", func() {
+		mockAPI.EXPECT().UploadWithMetadata(ctx, []byte("logs"), "cluster-id/logs", gomock.Nil()).Return(nil)
+		Expect(client.Upload(ctx, []byte("logs"), "cluster-id/logs")).To(Succeed())
+	})
+
+	It("decrypts sensitive objects on download", func() {
+		encrypted, err := keyring.Encrypt([]byte("kubeconfig content"))
+		Expect(err).ToNot(HaveOccurred())
+		downloadReturns("cluster-id/kubeconfig", encrypted)
+
+		reader, size, err := client.Download(ctx, "cluster-id/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("kubeconfig content"))
+		Expect(size).To(Equal(int64(len(data))))
+	})
+
+	It("downloads sensitive objects that were stored before encryption was enabled", func() {
+		downloadReturns("cluster-id/kubeconfig", []byte("kubeconfig content"))
+
+		reader, _, err := client.Download(ctx, "cluster-id/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("kubeconfig content"))
+	})
+
+	Context("presigned download URLs", func() {
+		serve := func(url string) *httptest.ResponseRecorder {
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			})
+			recorder := httptest.NewRecorder()
+			client.DownloadMiddleware(next).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+			return recorder
+		}
+
+		It("presigns other objects with the object store", func() {
+			mockAPI.EXPECT().GeneratePresignedDownloadURL(ctx, "cluster-id/logs", "logs", time.Minute).Return("url", nil)
+			url, err := client.GeneratePresignedDownloadURL(ctx, "cluster-id/logs", "logs", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal("url"))
+		})
+
+		It("serves sensitive objects decrypted through the service", func() {
+			url, err := client.GeneratePresignedDownloadURL(ctx, "cluster-id/kubeconfig", "kubeconfig", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(HavePrefix("https://service.example.com" + EncryptedDownloadPath + "?token="))
+			Expect(url).ToNot(ContainSubstring("cluster-id"))
+
+			encrypted, err := keyring.Encrypt([]byte("kubeconfig content"))
+			Expect(err).ToNot(HaveOccurred())
+			mockAPI.EXPECT().Download(gomock.Any(), "cluster-id/kubeconfig").
+				Return(io.NopCloser(bytes.NewReader(encrypted)), int64(len(encrypted)), nil)
+			response := serve(url)
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(Equal("kubeconfig content"))
+			Expect(response.Header().Get("Content-Disposition")).To(Equal("attachment;filename=kubeconfig"))
+		})
+
+		It("rejects expired and forged tokens", func() {
+			url, err := client.GeneratePresignedDownloadURL(ctx, "cluster-id/kubeconfig", "kubeconfig", -time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serve(url).Code).To(Equal(http.StatusUnauthorized))
+
+			forged, err := newKeyring("key", 2).Encrypt([]byte(`{"object_name":"cluster-id/kubeconfig","expiration":9999999999}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serve(EncryptedDownloadPath + "?token=" + base64.RawURLEncoding.EncodeToString(forged)).Code).
+				To(Equal(http.StatusUnauthorized))
+			Expect(serve(EncryptedDownloadPath + "?token=garbage").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("passes other requests through", func() {
+			Expect(serve("/api/assisted-install/v2/clusters").Code).To(Equal(http.StatusTeapot))
+		})
+	})
+
+	It("re-encrypts objects that are not encrypted with the active key", func() {
+		current, err := keyring.Encrypt([]byte("current"))
+		Expect(err).ToNot(HaveOccurred())
+		previous, err := newKeyring("previous", 2).Encrypt([]byte("previous"))
+		Expect(err).ToNot(HaveOccurred())
+		keyring, err = encryption.NewKeyring(map[string][]byte{
+			"key":      bytes.Repeat([]byte{1}, 32),
+			"previous": bytes.Repeat([]byte{2}, 32),
+		}, "key")
+		Expect(err).ToNot(HaveOccurred())
+		client = NewEncryptedClient(mockAPI, keyring, "https://service.example.com/", logrus.New())
+
+		mockAPI.EXPECT().ListObjectsByPrefix(ctx, "a/").Return([]string{
+			"a/kubeconfig", "a/worker.ign", "a/kubeadmin-password", "a/logs", "a/manifests/openshift/worker.ign",
+		}, nil)
+		mockAPI.EXPECT().ListObjectsByPrefix(ctx, "b/").Return(nil, nil)
+		downloadReturns("a/kubeconfig", current)
+		downloadReturns("a/worker.ign", previous)
+		downloadReturns("a/kubeadmin-password", []byte("plaintext"))
+		uploaded := map[string]string{}
+		mockAPI.EXPECT().UploadWithMetadata(ctx, gomock.Any(), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, data []byte, objectName string, _ map[string]string) error {
+				Expect(keyring.NeedsRotation(data)).To(BeFalse())
+				plaintext, err := keyring.Decrypt(data)
+				Expect(err).ToNot(HaveOccurred())
+				uploaded[objectName] = string(plaintext)
+				return nil
+			}).Times(2)
+
+		count, err := client.ReencryptObjects(ctx, []string{"a", "b"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+		Expect(uploaded).To(Equal(map[string]string{"a/worker.ign": "previous", "a/kubeadmin-password": "plaintext"}))
+	})
+})
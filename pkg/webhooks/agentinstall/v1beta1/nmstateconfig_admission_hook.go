@@ -0,0 +1,238 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	nmStateConfigResource = "nmstateconfigs"
+
+	nmStateConfigAdmissionGroup   = "admission.agentinstall.openshift.io"
+	nmStateConfigAdmissionVersion = "v1"
+)
+
+// NMStateConfigValidatingAdmissionHook is a struct that is used to reference what code should be run by the generic-admission-server.
+type NMStateConfigValidatingAdmissionHook struct {
+	decoder             *admission.Decoder
+	client              ctrlclient.Client
+	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig
+}
+
+// NewNMStateConfigValidatingAdmissionHook constructs a new NMStateConfigValidatingAdmissionHook
+func NewNMStateConfigValidatingAdmissionHook(decoder *admission.Decoder) *NMStateConfigValidatingAdmissionHook {
+	return &NMStateConfigValidatingAdmissionHook{
+		decoder:             decoder,
+		staticNetworkConfig: staticnetworkconfig.New(log.WithField("pkg", "static_network_config"), staticnetworkconfig.Config{}),
+	}
+}
+
+// ValidatingResource is called by generic-admission-server on startup to register the returned REST resource through which the
+//
+//	webhook is accessed by the kube apiserver.
+//
+// For example, generic-admission-server uses the data below to register the webhook on the REST resource "/apis/admission.agentinstall.openshift.io/v1/nmstateconfigvalidators".
+//
+//	When the kube apiserver calls this registered REST resource, the generic-admission-server calls the Validate() method below.
+func (a *NMStateConfigValidatingAdmissionHook) ValidatingResource() (plural schema.GroupVersionResource, singular string) {
+	log.WithFields(log.Fields{
+		"group":    nmStateConfigAdmissionGroup,
+		"version":  nmStateConfigAdmissionVersion,
+		"resource": "nmstateconfigvalidator",
+	}).Info("Registering validation REST resource")
+	// NOTE: This GVR is meant to be different than the NMStateConfig CRD GVR which has group "agent-install.openshift.io".
+	return schema.GroupVersionResource{
+			Group:    nmStateConfigAdmissionGroup,
+			Version:  nmStateConfigAdmissionVersion,
+			Resource: "nmstateconfigvalidators",
+		},
+		"nmstateconfigvalidator"
+}
+
+// Initialize is called by generic-admission-server on startup to setup any special initialization that your webhook needs.
+func (a *NMStateConfigValidatingAdmissionHook) Initialize(kubeClientConfig *rest.Config, stopCh <-chan struct{}) error {
+	log.WithFields(log.Fields{
+		"group":    nmStateConfigAdmissionGroup,
+		"version":  nmStateConfigAdmissionVersion,
+		"resource": "nmstateconfigvalidator",
+	}).Info("Initializing validation REST resource")
+
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add agent-install scheme: %w", err)
+	}
+
+	client, err := ctrlclient.New(kubeClientConfig, ctrlclient.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create k8s client: %w", err)
+	}
+
+	a.client = client
+
+	return nil
+}
+
+// Validate is called by generic-admission-server when the registered REST resource above is called with an admission request.
+// Usually it's the kube apiserver that is making the admission validation request.
+func (a *NMStateConfigValidatingAdmissionHook) Validate(admissionSpec *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	contextLogger := log.WithFields(log.Fields{
+		"operation": admissionSpec.Operation,
+		"group":     admissionSpec.Resource.Group,
+		"version":   admissionSpec.Resource.Version,
+		"resource":  admissionSpec.Resource.Resource,
+		"method":    "Validate",
+	})
+
+	if !a.shouldValidate(admissionSpec) {
+		contextLogger.Info("Skipping validation for request")
+		// The request object isn't something that this validator should validate.
+		// Therefore, we say that it's allowed.
+		return &admissionv1.AdmissionResponse{
+			Allowed: true,
+		}
+	}
+
+	contextLogger.Info("Validating request")
+
+	if admissionSpec.Operation == admissionv1.Create || admissionSpec.Operation == admissionv1.Update {
+		return a.validateCreateOrUpdate(admissionSpec)
+	}
+
+	// We're only validating creates and updates at this time, so all other operations are explicitly allowed.
+	contextLogger.Info("Successful validation")
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+// shouldValidate explicitly checks if the request should be validated. For example, this webhook may have accidentally been registered to check
+// the validity of some other type of object with a different GVR.
+func (a *NMStateConfigValidatingAdmissionHook) shouldValidate(admissionSpec *admissionv1.AdmissionRequest) bool {
+	contextLogger := log.WithFields(log.Fields{
+		"operation": admissionSpec.Operation,
+		"group":     admissionSpec.Resource.Group,
+		"version":   admissionSpec.Resource.Version,
+		"resource":  admissionSpec.Resource.Resource,
+		"method":    "shouldValidate",
+	})
+
+	if admissionSpec.Resource.Group != v1beta1.Group {
+		contextLogger.Debug("Returning False, not our group")
+		return false
+	}
+
+	if admissionSpec.Resource.Version != v1beta1.Version {
+		contextLogger.Debug("Returning False, it's our group, but not the right version")
+		return false
+	}
+
+	if admissionSpec.Resource.Resource != nmStateConfigResource {
+		contextLogger.Debug("Returning False, it's our group and version, but not the right resource")
+		return false
+	}
+
+	// If we get here, then we're supposed to validate the object.
+	contextLogger.Debug("Returning True, passed all prerequisites.")
+	return true
+}
+
+// validateCreateOrUpdate runs the same static network validation that the InfraEnv controller
+// relies on when the discovery image is generated, so invalid configs are rejected on apply.
+func (a *NMStateConfigValidatingAdmissionHook) validateCreateOrUpdate(admissionSpec *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	contextLogger := log.WithFields(log.Fields{
+		"operation": admissionSpec.Operation,
+		"group":     admissionSpec.Resource.Group,
+		"version":   admissionSpec.Resource.Version,
+		"resource":  admissionSpec.Resource.Resource,
+		"method":    "validateCreateOrUpdate",
+	})
+
+	newObject := &v1beta1.NMStateConfig{}
+	if err := a.decoder.DecodeRaw(admissionSpec.Object, newObject); err != nil {
+		contextLogger.Errorf("Failed unmarshaling Object: %v", err.Error())
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	contextLogger.Data["object.Name"] = newObject.Name
+
+	staticNetworkConfig := []*models.HostStaticNetworkConfig{
+		{
+			MacInterfaceMap: network.BuildMacInterfaceMap(contextLogger, *newObject),
+			NetworkYaml:     string(newObject.Spec.NetConfig.Raw),
+		},
+	}
+	if err := a.staticNetworkConfig.ValidateStaticConfigParamsYAML(staticNetworkConfig); err != nil {
+		message := fmt.Sprintf("NMStateConfig %s/%s is invalid: %s", newObject.Namespace, newObject.Name, err.Error())
+		contextLogger.Infof("Failed validation: %v", message)
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+				Message: message,
+			},
+		}
+	}
+
+	response := &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	if warning := a.infraEnvSelectorWarning(contextLogger, newObject); warning != "" {
+		response.Warnings = []string{warning}
+	}
+
+	contextLogger.Info("Successful validation")
+	return response
+}
+
+// infraEnvSelectorWarning returns a warning when no InfraEnv selects the NMStateConfig. As in the InfraEnv controller,
+// InfraEnvs of every namespace are considered. Such a config is valid but will not be included in any discovery image
+// until a matching InfraEnv exists.
+func (a *NMStateConfigValidatingAdmissionHook) infraEnvSelectorWarning(logger *log.Entry, nmStateConfig *v1beta1.NMStateConfig) string {
+	if a.client == nil {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	infraEnvs := &v1beta1.InfraEnvList{}
+	if err := a.client.List(ctx, infraEnvs); err != nil {
+		logger.WithError(err).Warn("Failed to list InfraEnvs, skipping selector check")
+		return ""
+	}
+
+	for i := range infraEnvs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&infraEnvs.Items[i].Spec.NMStateConfigLabelSelector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(nmStateConfig.Labels)) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("NMStateConfig %s/%s is not selected by any InfraEnv and will not be applied to any host",
+		nmStateConfig.Namespace, nmStateConfig.Name)
+}
@@ -0,0 +1,194 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	apiserver "github.com/openshift/generic-admission-server/pkg/apiserver"
+	"go.uber.org/mock/gomock"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func createNMStateConfigTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+	return fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+var _ = Describe("nmstateconfig web hook init", func() {
+	It("ValidatingResource", func() {
+		data := NewNMStateConfigValidatingAdmissionHook(createDecoder())
+		expectedPlural := schema.GroupVersionResource{
+			Group:    "admission.agentinstall.openshift.io",
+			Version:  "v1",
+			Resource: "nmstateconfigvalidators",
+		}
+		expectedSingular := "nmstateconfigvalidator"
+
+		plural, singular := data.ValidatingResource()
+		Expect(plural).To(Equal(expectedPlural))
+		Expect(singular).To(Equal(expectedSingular))
+	})
+
+	It("Check implements interface ", func() {
+		var hook interface{} = NewNMStateConfigValidatingAdmissionHook(createDecoder())
+		_, ok := hook.(apiserver.ValidatingAdmissionHookV1)
+		Expect(ok).To(BeTrue())
+	})
+})
+
+var _ = Describe("nmstateconfig web validate", func() {
+	var (
+		ctrl      *gomock.Controller
+		mockSNC   *staticnetworkconfig.MockStaticNetworkConfig
+		namespace = "test-namespace"
+		netConfig = "interfaces:\n- name: eth0\n  state: up\n  type: ethernet\n"
+	)
+
+	newNMStateConfig := func(labels map[string]string) *v1beta1.NMStateConfig {
+		return &v1beta1.NMStateConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nmstate",
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Spec: v1beta1.NMStateConfigSpec{
+				Interfaces: []*v1beta1.Interface{
+					{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc"},
+				},
+				NetConfig: v1beta1.NetConfig{Raw: []byte(netConfig)},
+			},
+		}
+	}
+
+	newInfraEnv := func(selector map[string]string) *v1beta1.InfraEnv {
+		return &v1beta1.InfraEnv{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-infraenv",
+				Namespace: namespace,
+			},
+			Spec: v1beta1.InfraEnvSpec{
+				NMStateConfigLabelSelector: metav1.LabelSelector{MatchLabels: selector},
+			},
+		}
+	}
+
+	newRequest := func(operation admissionv1.Operation, obj *v1beta1.NMStateConfig) *admissionv1.AdmissionRequest {
+		raw, err := json.Marshal(obj)
+		Expect(err).ToNot(HaveOccurred())
+		return &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Resource: metav1.GroupVersionResource{
+				Group:    "agent-install.openshift.io",
+				Version:  "v1beta1",
+				Resource: "nmstateconfigs",
+			},
+			Object: runtime.RawExtension{Raw: raw},
+		}
+	}
+
+	newHook := func(objs ...client.Object) *NMStateConfigValidatingAdmissionHook {
+		hook := NewNMStateConfigValidatingAdmissionHook(createDecoder())
+		hook.staticNetworkConfig = mockSNC
+		hook.client = createNMStateConfigTestClient(objs...)
+		return hook
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockSNC = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("skips resources that are not NMStateConfigs", func() {
+		request := newRequest(admissionv1.Create, newNMStateConfig(nil))
+		request.Resource.Resource = "infraenvs"
+		response := newHook().Validate(request)
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("rejects an object that can't be decoded", func() {
+		request := newRequest(admissionv1.Create, newNMStateConfig(nil))
+		request.Object.Raw = []byte{0}
+		response := newHook().Validate(request)
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("allows deletes without validating", func() {
+		response := newHook().Validate(newRequest(admissionv1.Delete, newNMStateConfig(nil)))
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	for _, operation := range []admissionv1.Operation{admissionv1.Create, admissionv1.Update} {
+		operation := operation
+
+		It(string(operation)+" passes the config to the static network validation", func() {
+			labels := map[string]string{"infraenv": "test"}
+			mockSNC.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).DoAndReturn(
+				func(staticNetworkConfig []*models.HostStaticNetworkConfig) error {
+					Expect(staticNetworkConfig).To(HaveLen(1))
+					Expect(staticNetworkConfig[0].NetworkYaml).To(Equal(netConfig))
+					Expect(staticNetworkConfig[0].MacInterfaceMap).To(Equal(models.MacInterfaceMap{
+						{LogicalNicName: "eth0", MacAddress: "52:54:00:aa:bb:cc"},
+					}))
+					return nil
+				})
+			response := newHook(newInfraEnv(labels)).Validate(newRequest(operation, newNMStateConfig(labels)))
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Warnings).To(BeEmpty())
+		})
+
+		It(string(operation)+" rejects an invalid config with the validation error", func() {
+			mockSNC.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(
+				errors.New("failed to validate network yaml for host 0, bad yaml"))
+			response := newHook().Validate(newRequest(operation, newNMStateConfig(nil)))
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Code).To(BeEquivalentTo(400))
+			Expect(response.Result.Message).To(ContainSubstring("failed to validate network yaml for host 0, bad yaml"))
+		})
+
+		It(string(operation)+" warns when no InfraEnv selects the config", func() {
+			mockSNC.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(nil)
+			response := newHook(newInfraEnv(map[string]string{"infraenv": "other"})).
+				Validate(newRequest(operation, newNMStateConfig(map[string]string{"infraenv": "test"})))
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Warnings).To(HaveLen(1))
+			Expect(response.Warnings[0]).To(ContainSubstring("is not selected by any InfraEnv"))
+		})
+	}
+
+	It("doesn't warn when an InfraEnv of another namespace selects the config", func() {
+		labels := map[string]string{"infraenv": "test"}
+		mockSNC.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(nil)
+		infraEnv := newInfraEnv(labels)
+		infraEnv.Namespace = "other-namespace"
+		response := newHook(infraEnv).Validate(newRequest(admissionv1.Create, newNMStateConfig(labels)))
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Warnings).To(BeEmpty())
+	})
+
+	It("doesn't treat an InfraEnv with an empty selector as a match", func() {
+		mockSNC.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any()).Return(nil)
+		response := newHook(newInfraEnv(nil)).
+			Validate(newRequest(admissionv1.Create, newNMStateConfig(map[string]string{"infraenv": "test"})))
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Warnings).To(HaveLen(1))
+	})
+})
@@ -279,6 +279,8 @@ func (a *AgentClusterInstallValidatingAdmissionHook) validateUpdate(admissionSpe
 		// at this stage, but it is needed to serve some CI/CD gitops flows.
 		if installCompleted(newObject.Status.Conditions) {
 			ignoreChanges = append(ignoreChanges, "ProvisionRequirements")
+			// The compute pools are used to scale day-2 workers once the installation is completed.
+			ignoreChanges = append(ignoreChanges, "Compute")
 		}
 		hasChangedImmutableField, unsupportedDiff := hasChangedImmutableField(&oldObject.Spec, &newObject.Spec, ignoreChanges)
 		if hasChangedImmutableField {
@@ -183,6 +183,54 @@ var _ = Describe("ACI web validate", func() {
 			operation:       admissionv1.Update,
 			expectedAllowed: true,
 		},
+		{
+			name: "Test AgentClusterInstall.Spec update allowed for compute pools when Install finished",
+			newSpec: hiveext.AgentClusterInstallSpec{
+				Compute: []hiveext.AgentMachinePool{
+					{
+						Name:     hiveext.WorkerAgentMachinePool,
+						Replicas: swag.Int32(2),
+						AgentSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"pool": "worker"},
+						},
+					},
+				},
+			},
+			conditions: []hivev1.ClusterInstallCondition{
+				{
+					Type:   hiveext.ClusterCompletedCondition,
+					Reason: hiveext.ClusterInstalledReason,
+				},
+			},
+			oldSpec: hiveext.AgentClusterInstallSpec{
+				Compute: []hiveext.AgentMachinePool{
+					{Name: hiveext.WorkerAgentMachinePool},
+				},
+			},
+			operation:       admissionv1.Update,
+			expectedAllowed: true,
+		},
+		{
+			name: "Test AgentClusterInstall.Spec update not allowed for compute pools when Install in progress",
+			newSpec: hiveext.AgentClusterInstallSpec{
+				Compute: []hiveext.AgentMachinePool{
+					{Name: hiveext.WorkerAgentMachinePool, Replicas: swag.Int32(2)},
+				},
+			},
+			conditions: []hivev1.ClusterInstallCondition{
+				{
+					Type:   hiveext.ClusterCompletedCondition,
+					Reason: hiveext.ClusterInstallationInProgressReason,
+				},
+			},
+			oldSpec: hiveext.AgentClusterInstallSpec{
+				Compute: []hiveext.AgentMachinePool{
+					{Name: hiveext.WorkerAgentMachinePool},
+				},
+			},
+			operation:       admissionv1.Update,
+			expectedAllowed: false,
+		},
 		{
 			name: "Test AgentClusterInstall.Spec update allowed for ignition endpoint field when Install finished",
 			newSpec: hiveext.AgentClusterInstallSpec{
@@ -79,6 +79,9 @@ type InstallerAPI interface {
 	/* ListClusterHosts Get a list of cluster hosts according to supplied filters. */
 	ListClusterHosts(ctx context.Context, params installer.ListClusterHostsParams) middleware.Responder
 
+	/* ListDiscoveryCustomizationRevisions Lists the revisions of the discovery customization of the infra-env, newest first. */
+	ListDiscoveryCustomizationRevisions(ctx context.Context, params installer.ListDiscoveryCustomizationRevisionsParams) middleware.Responder
+
 	/* ListInfraEnvs Retrieves the list of infra-envs. */
 	ListInfraEnvs(ctx context.Context, params installer.ListInfraEnvsParams) middleware.Responder
 
@@ -163,9 +166,15 @@ type InstallerAPI interface {
 	/* V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error */
 	V2GetHostIgnition(ctx context.Context, params installer.V2GetHostIgnitionParams) middleware.Responder
 
+	/* V2GetHostIgnitionPreview Returns the pointer ignition of the host merged with its ignition config overrides, with secrets redacted, and the content generated by the service that the overrides replace. */
+	V2GetHostIgnitionPreview(ctx context.Context, params installer.V2GetHostIgnitionPreviewParams) middleware.Responder
+
 	/* V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster. */
 	V2GetIgnoredValidations(ctx context.Context, params installer.V2GetIgnoredValidationsParams) middleware.Responder
 
+	/* V2GetInfraEnvIgnitionPreview Returns the discovery ignition of the infra-env merged with its ignition config override, with secrets redacted, and the content generated by the service that the override replaces. */
+	V2GetInfraEnvIgnitionPreview(ctx context.Context, params installer.V2GetInfraEnvIgnitionPreviewParams) middleware.Responder
+
 	/* V2GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder
 
@@ -184,6 +193,9 @@ type InstallerAPI interface {
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
+	/* V2ListDownloadAuditRecords Lists the recorded downloads of host ignitions, cluster ignitions and cluster credentials. Available to admin users only. */
+	V2ListDownloadAuditRecords(ctx context.Context, params installer.V2ListDownloadAuditRecordsParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -199,6 +211,9 @@ type InstallerAPI interface {
 	/* V2RegisterHost Registers a new OpenShift agent. */
 	V2RegisterHost(ctx context.Context, params installer.V2RegisterHostParams) middleware.Responder
 
+	/* V2RenderClusterManifests Renders the manifests that the installer would use for the cluster installation, with the custom manifests, manifest patches and operator manifests applied. The cluster is not modified. */
+	V2RenderClusterManifests(ctx context.Context, params installer.V2RenderClusterManifestsParams) middleware.Responder
+
 	/* V2ResetCluster Resets a failed installation. */
 	V2ResetCluster(ctx context.Context, params installer.V2ResetClusterParams) middleware.Responder
 
@@ -237,6 +252,9 @@ type InstallerAPI interface {
 
 	/* V2UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
+
+	/* V2VerifyHostIgnition Verifies that the ignition served to the host matches the ignition that was generated for it, and returns the inputs it was generated with. */
+	V2VerifyHostIgnition(ctx context.Context, params installer.V2VerifyHostIgnitionParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -274,6 +292,9 @@ type OperatorsAPI interface {
 	/* V2GetBundle Get operator properties for a bundle */
 	V2GetBundle(ctx context.Context, params operators.V2GetBundleParams) middleware.Responder
 
+	/* V2InstallOperators Installs OLM operators in an installed cluster, and monitors them until they are available. */
+	V2InstallOperators(ctx context.Context, params operators.V2InstallOperatorsParams) middleware.Responder
+
 	/* V2ListBundles Get list of available bundles */
 	V2ListBundles(ctx context.Context, params operators.V2ListBundlesParams) middleware.Responder
 
@@ -286,6 +307,12 @@ type OperatorsAPI interface {
 	/* V2ListSupportedOperators Retrieves the list of supported operators. */
 	V2ListSupportedOperators(ctx context.Context, params operators.V2ListSupportedOperatorsParams) middleware.Responder
 
+	/* V2PlanCapacity Plan the hosts needed to run a set of operators */
+	V2PlanCapacity(ctx context.Context, params operators.V2PlanCapacityParams) middleware.Responder
+
+	/* V2ResolveOperators Explain the resolution of a set of operators */
+	V2ResolveOperators(ctx context.Context, params operators.V2ResolveOperatorsParams) middleware.Responder
+
 	/* V2ReportMonitoredOperatorStatus Controller API to report of monitored operators. */
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params operators.V2ReportMonitoredOperatorStatusParams) middleware.Responder
 }
@@ -491,6 +518,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ListClusterHosts(ctx, params)
 	})
+	api.InstallerListDiscoveryCustomizationRevisionsHandler = installer.ListDiscoveryCustomizationRevisionsHandlerFunc(func(params installer.ListDiscoveryCustomizationRevisionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListDiscoveryCustomizationRevisions(ctx, params)
+	})
 	api.InstallerListInfraEnvsHandler = installer.ListInfraEnvsHandlerFunc(func(params installer.ListInfraEnvsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -586,6 +618,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPresignedForClusterFiles(ctx, params)
 	})
+	api.OperatorsV2InstallOperatorsHandler = operators.V2InstallOperatorsHandlerFunc(func(params operators.V2InstallOperatorsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2InstallOperators(ctx, params)
+	})
 	api.OperatorsV2ListBundlesHandler = operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -616,6 +653,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.V2ListSupportedOperators(ctx, params)
 	})
+	api.OperatorsV2PlanCapacityHandler = operators.V2PlanCapacityHandlerFunc(func(params operators.V2PlanCapacityParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2PlanCapacity(ctx, params)
+	})
+	api.OperatorsV2ResolveOperatorsHandler = operators.V2ResolveOperatorsHandlerFunc(func(params operators.V2ResolveOperatorsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2ResolveOperators(ctx, params)
+	})
 	api.InstallerV2UpdateClusterHandler = installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -686,11 +733,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetHostIgnition(ctx, params)
 	})
+	api.InstallerV2GetHostIgnitionPreviewHandler = installer.V2GetHostIgnitionPreviewHandlerFunc(func(params installer.V2GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetHostIgnitionPreview(ctx, params)
+	})
 	api.InstallerV2GetIgnoredValidationsHandler = installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetIgnoredValidations(ctx, params)
 	})
+	api.InstallerV2GetInfraEnvIgnitionPreviewHandler = installer.V2GetInfraEnvIgnitionPreviewHandlerFunc(func(params installer.V2GetInfraEnvIgnitionPreviewParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetInfraEnvIgnitionPreview(ctx, params)
+	})
 	api.InstallerV2GetNextStepsHandler = installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -726,6 +783,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListComponentVersions(ctx, params)
 	})
+	api.InstallerV2ListDownloadAuditRecordsHandler = installer.V2ListDownloadAuditRecordsHandlerFunc(func(params installer.V2ListDownloadAuditRecordsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListDownloadAuditRecords(ctx, params)
+	})
 	api.EventsV2ListEventsHandler = events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -766,6 +828,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.InstallerV2RenderClusterManifestsHandler = installer.V2RenderClusterManifestsHandlerFunc(func(params installer.V2RenderClusterManifestsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RenderClusterManifests(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -841,6 +908,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.InstallerV2VerifyHostIgnitionHandler = installer.V2VerifyHostIgnitionHandlerFunc(func(params installer.V2VerifyHostIgnitionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2VerifyHostIgnition(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
@@ -922,6 +922,84 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/actions/install-operators": {
+      "post": {
+        "description": "Installs OLM operators in an installed cluster, and monitors them until they are available.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2InstallOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The installed cluster to install the operators in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators to install.",
+            "name": "install-operators-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/install-operators-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/{cluster_id}/actions/reset": {
       "post": {
         "description": "Resets a failed installation.",
@@ -2435,6 +2513,13 @@ func init() {
             "name": "file_name",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Download the Butane config that the manifest was translated from instead of the manifest.",
+            "name": "butane_source",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2483,6 +2568,85 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/manifests/rendered": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Renders the manifests that the installer would use for the cluster installation, with the custom manifests, manifest patches and operator manifests applied. The cluster is not modified.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RenderClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifests should be rendered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Return only the manifests that differ from the installer defaults, with a unified diff against the default manifest instead of their content.",
+            "name": "diff",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-rendered-manifests"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
@@ -3106,6 +3270,108 @@ func init() {
         }
       }
     },
+    "/v2/download-audit-records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the recorded downloads of host ignitions, cluster ignitions and cluster credentials. Available to admin users only.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListDownloadAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the downloads of files of this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the downloads of ignitions of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "host-ignition",
+              "host-ignition-params",
+              "cluster-ignition",
+              "kubeconfig",
+              "kubeadmin-password",
+              "credentials"
+            ],
+            "type": "string",
+            "description": "Only return the downloads of this type of file.",
+            "name": "file_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the downloads that took place at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 1000,
+            "description": "The maximal number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 0,
+            "description": "The number of records to skip.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/download-audit-record-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/events": {
       "get": {
         "security": [
@@ -3852,7 +4118,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+    "/v2/infra-envs/{infra_env_id}/discovery-customization-revisions": {
       "get": {
         "security": [
           {
@@ -3861,45 +4127,113 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the revisions of the discovery customization of the infra-env, newest first.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "ListDiscoveryCustomizationRevisions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
+            "description": "The infra-env whose discovery customization revisions should be listed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/discovery-customization-revision-list"
+            }
           },
-          {
-            "enum": [
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DownloadInfraEnvFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose file should be downloaded.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
               "discovery.ign",
               "ipxe-script",
-              "static-network-config"
+              "grub-config",
+              "static-network-config",
+              "boot-artifacts-sha256sums"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "description": "The file to be downloaded. boot-artifacts-sha256sums lists the expected SHA-256 digests of the boot artifacts that are identical for every infra-env, in the sha256sum format.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -3907,7 +4241,7 @@ func init() {
           {
             "type": "string",
             "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
+            "description": "Mac address of the host running ipxe script or grub config.",
             "name": "mac",
             "in": "query"
           },
@@ -3917,7 +4251,7 @@ func init() {
               "boot-order-control"
             ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
+            "description": "Specify the script type to be served for iPXE or GRUB.",
             "name": "ipxe_script_type",
             "in": "query"
           },
@@ -4016,10 +4350,13 @@ func init() {
           {
             "enum": [
               "discovery.ign",
-              "ipxe-script"
+              "ipxe-script",
+              "grub-config",
+              "uefi-http-boot",
+              "boot-artifacts-sha256sums"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "description": "The file to be downloaded. For uefi-http-boot, the URL of the discovery image that UEFI HTTP Boot clients boot from is returned.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -4030,7 +4367,7 @@ func init() {
               "boot-order-control"
             ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
+            "description": "Specify the script type to be served for iPXE or GRUB.",
             "name": "ipxe_script_type",
             "in": "query"
           }
@@ -5199,6 +5536,166 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Returns the pointer ignition of the host merged with its ignition config overrides, with secrets redacted, and the content generated by the service that the overrides replace.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostIgnitionPreview",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose pointer ignition should be previewed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose pointer ignition should be previewed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignition-preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-verification": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Verifies that the ignition served to the host matches the ignition that was generated for it, and returns the inputs it was generated with.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2VerifyHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition should be verified.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition should be verified.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-verification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
       "patch": {
         "description": "Updates a host's installer arguments.",
@@ -5664,26 +6161,44 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+    "/v2/infra-envs/{infra_env_id}/ignition-preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Returns the discovery ignition of the infra-env merged with its ignition config override, with secrets redacted, and the content generated by the service that the override replaces.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2GetInfraEnvIgnitionPreview",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env whose discovery ignition should be previewed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignition-preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5718,9 +6233,63 @@ func init() {
         }
       }
     },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegenerateInfraEnvSigningKey",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
           {
             "userAuth": [
               "admin",
@@ -5922,6 +6491,180 @@ func init() {
         }
       }
     },
+    "/v2/operators/capacity-plan": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Finds the smallest layout of hosts, among the given host profiles, that satisfies the requirements of OpenShift and of the operators, without a cluster.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Plan the hosts needed to run a set of operators",
+        "operationId": "V2PlanCapacity",
+        "parameters": [
+          {
+            "name": "capacity-plan-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/capacity-plan-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/capacity-plan"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/resolution": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Resolves the dependencies of the requested operators and explains why each operator is part of the result, which constraints conflict with them and the resulting host requirements.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Explain the resolution of a set of operators",
+        "operationId": "V2ResolveOperators",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Names of the requested operators.",
+            "name": "operators",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "default": "baremetal",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 3,
+            "description": "Number of control plane nodes of the cluster.",
+            "name": "control_plane_count",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operators-resolution"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/release-sources": {
       "get": {
         "security": [
@@ -6272,7 +7015,8 @@ func init() {
                   "oadp",
                   "metallb",
                   "loki",
-                  "openshift-logging"
+                  "openshift-logging",
+                  "sriov"
                 ]
               }
             }
@@ -6544,48 +7288,174 @@ func init() {
         }
       }
     },
-    "cluster": {
+    "capacity-plan": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+        "binding_requirements": {
+          "description": "The requirements that the next smaller layouts don't satisfy, which prevent using fewer or smaller hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacity-plan-issue"
+          }
         },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
+        "control_plane": {
+          "description": "The control plane hosts of the smallest viable layout.",
+          "$ref": "#/definitions/capacity-plan-hosts"
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
+        "feasible": {
+          "description": "Whether a layout of hosts satisfies all the requirements.",
+          "type": "boolean"
         },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+        "issues": {
+          "description": "When no layout is viable, the requirements that the largest layout doesn't satisfy.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/api_vip"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
+            "$ref": "#/definitions/capacity-plan-issue"
+          }
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
+        "operators": {
+          "description": "Names of the planned operators, dependencies included.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "workers": {
+          "description": "The worker hosts of the smallest viable layout.",
+          "$ref": "#/definitions/capacity-plan-hosts"
+        }
+      }
+    },
+    "capacity-plan-hosts": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of hosts.",
+          "type": "integer"
+        },
+        "host_profile": {
+          "description": "Name of the profile of the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "capacity-plan-issue": {
+      "type": "object",
+      "properties": {
+        "layout": {
+          "description": "The layout of hosts that doesn't satisfy the requirement.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Explanation of the requirement that isn't satisfied.",
+          "type": "string"
+        },
+        "role": {
+          "description": "Role of the hosts that don't satisfy the requirement. Empty for requirements of the cluster.",
+          "$ref": "#/definitions/host-role"
+        },
+        "source": {
+          "description": "What the requirement comes from, \"hardware\" for the CPU cores, the RAM and the disk that OpenShift and the operators require, or the ID of the validation of an operator.",
+          "type": "string"
+        }
+      }
+    },
+    "capacity-plan-params": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "host_profiles"
+      ],
+      "properties": {
+        "control_plane_count": {
+          "description": "Number of control plane nodes of the cluster.",
+          "type": "integer",
+          "default": 3,
+          "maximum": 5,
+          "minimum": 1
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts.",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
+        "host_profiles": {
+          "description": "The shapes of hosts that can be bought.",
+          "type": "array",
+          "maxItems": 10,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/host-profile"
+          }
+        },
+        "max_workers": {
+          "description": "Largest number of workers to consider.",
+          "type": "integer",
+          "default": 10,
+          "maximum": 100
+        },
+        "olm_operators": {
+          "description": "The operators to run, with their properties. Their dependencies are added.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
@@ -7397,7 +8267,10 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "storage-capacity-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -7676,7 +8549,7 @@ func init() {
       ],
       "properties": {
         "content": {
-          "description": "base64 encoded manifest content.",
+          "description": "base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.",
           "type": "string"
         },
         "file_name": {
@@ -7787,6 +8660,183 @@ func init() {
         }
       }
     },
+    "discovery-customization": {
+      "description": "Customizations of the discovery environment that are rendered into the discovery ignition of the infra-env.",
+      "type": "object",
+      "properties": {
+        "files": {
+          "description": "Files that are written to the discovery environment, e.g. additional CA certificates.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-file"
+          }
+        },
+        "kernel_modules": {
+          "description": "Kernel modules that are loaded when the discovery environment boots.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^[a-zA-Z0-9_-]+$"
+          }
+        },
+        "sysctls": {
+          "description": "Kernel parameters that are set when the discovery environment boots.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-sysctl"
+          }
+        },
+        "systemd_units": {
+          "description": "Systemd units and drop-ins that are added to the discovery environment.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-systemd-unit"
+          }
+        }
+      }
+    },
+    "discovery-customization-file": {
+      "type": "object",
+      "required": [
+        "path",
+        "contents"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the file.",
+          "type": "string"
+        },
+        "mode": {
+          "description": "The permissions of the file, in decimal. Defaults to 420 (0644).",
+          "type": "integer",
+          "maximum": 4095,
+          "x-nullable": true
+        },
+        "path": {
+          "description": "The absolute path of the file.",
+          "type": "string",
+          "pattern": "^/.+$"
+        }
+      }
+    },
+    "discovery-customization-revision": {
+      "type": "object",
+      "required": [
+        "infra_env_id",
+        "revision",
+        "created_at"
+      ],
+      "properties": {
+        "created_at": {
+          "description": "The time that the revision was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "discovery_customization": {
+          "description": "JSON formatted discovery-customization of this revision. Empty if the customization was removed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that the discovery customization belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "revision": {
+          "description": "The revision number.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        },
+        "user_name": {
+          "description": "The user that made the change.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-customization-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/discovery-customization-revision"
+      }
+    },
+    "discovery-customization-sysctl": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The kernel parameter, e.g. net.ipv4.ip_forward.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_][a-zA-Z0-9_./-]*$"
+        },
+        "value": {
+          "description": "The value of the kernel parameter.",
+          "type": "string",
+          "pattern": "^[^\\n]+$"
+        }
+      }
+    },
+    "discovery-customization-systemd-dropin": {
+      "type": "object",
+      "required": [
+        "name",
+        "contents"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the drop-in.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the drop-in, e.g. 10-proxy.conf.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9@_.:-]+\\.conf$"
+        }
+      }
+    },
+    "discovery-customization-systemd-unit": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the unit. When empty, only the drop-ins are added to an existing unit.",
+          "type": "string"
+        },
+        "dropins": {
+          "description": "Drop-ins that are added to the unit.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-systemd-dropin"
+          }
+        },
+        "enabled": {
+          "description": "Whether the unit is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the unit, e.g. my-service.service.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9@_.:-]+\\.(service|socket|timer|path|mount|target)$"
+        }
+      }
+    },
     "disk": {
       "type": "object",
       "properties": {
@@ -8074,38 +9124,118 @@ func init() {
         }
       }
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+    "download-audit-record": {
       "type": "object",
       "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
+        "file_type",
+        "downloaded_at"
       ],
       "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
-          "type": "string"
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose file was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
+        "content_sha256": {
+          "description": "Hex encoded SHA-256 hash of the downloaded content.",
           "type": "string"
         },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
+        "downloaded_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "file_name": {
+          "description": "The name of the downloaded file.",
           "type": "string"
-        }
-      }
-    },
-    "drive_type": {
-      "type": "string",
-      "enum": [
-        "Unknown",
+        },
+        "file_type": {
+          "description": "The type of the downloaded file. The ignition of a host is 'host-ignition' when it was downloaded\nas a file, and 'host-ignition-params' when it was fetched as JSON.\n",
+          "type": "string",
+          "enum": [
+            "host-ignition",
+            "host-ignition-params",
+            "cluster-ignition",
+            "kubeconfig",
+            "kubeadmin-password",
+            "credentials"
+          ]
+        },
+        "host_id": {
+          "description": "Unique identifier of the host whose ignition was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env of the host whose ignition was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "org_id": {
+          "description": "The organization of the principal that downloaded the file.",
+          "type": "string"
+        },
+        "principal": {
+          "description": "The user or agent that downloaded the file.",
+          "type": "string"
+        },
+        "principal_role": {
+          "description": "The role of the principal that downloaded the file.",
+          "type": "string"
+        },
+        "request_id": {
+          "description": "Unique identifier of the download request.",
+          "type": "string"
+        },
+        "source_ip": {
+          "description": "The address the download request was sent from.",
+          "type": "string"
+        }
+      }
+    },
+    "download-audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/download-audit-record"
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
+        },
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
+        },
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
+        },
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
         "HDD",
         "FDD",
         "ODD",
@@ -8319,7 +9449,8 @@ func init() {
         "METALLB",
         "DUAL_STACK_PRIMARY_IPV6",
         "LOKI",
-        "OPENSHIFT_LOGGING"
+        "OPENSHIFT_LOGGING",
+        "SRIOV"
       ],
       "x-nullable": false
     },
@@ -8540,9 +9671,15 @@ func init() {
         "ignition_config_overrides": {
           "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
           "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
+        "ignition_config_overrides_source": {
+          "description": "The Butane config that ignition_config_overrides was translated from, if the overrides were supplied as a Butane config.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
+          "readOnly": true
+        },
         "ignition_endpoint_token_set": {
           "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
           "type": "boolean"
@@ -8758,6 +9895,36 @@ func init() {
     "host-ignition-params": {
       "properties": {
         "config": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition, or a Butane config of the openshift or fcos variant that is translated to it.",
+          "type": "string"
+        }
+      }
+    },
+    "host-ignition-verification": {
+      "type": "object",
+      "properties": {
+        "expected_sha256": {
+          "description": "Hex encoded SHA-256 hash of the ignition that was generated for the host.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the ignition file that is served to the host.",
+          "type": "string"
+        },
+        "generation_record": {
+          "$ref": "#/definitions/ignition-generation-record"
+        },
+        "host_id": {
+          "description": "Unique identifier of the verified host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "matches": {
+          "description": "Whether the ignition that is served to the host matches the ignition that was generated for it.",
+          "type": "boolean"
+        },
+        "served_sha256": {
+          "description": "Hex encoded SHA-256 hash of the ignition that is served to the host.",
           "type": "string"
         }
       }
@@ -8768,6 +9935,57 @@ func init() {
         "$ref": "#/definitions/host"
       }
     },
+    "host-profile": {
+      "type": "object",
+      "required": [
+        "name",
+        "cpu_cores",
+        "ram_mib"
+      ],
+      "properties": {
+        "cpu_cores": {
+          "description": "Number of CPU cores of the host.",
+          "type": "integer"
+        },
+        "disks": {
+          "description": "Disks of the host. The first disk is the installation disk.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-profile-disk"
+          }
+        },
+        "gpus": {
+          "description": "GPUs of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "name": {
+          "description": "Unique name of the profile.",
+          "type": "string"
+        },
+        "ram_mib": {
+          "description": "RAM of the host in MiB.",
+          "type": "integer"
+        }
+      }
+    },
+    "host-profile-disk": {
+      "type": "object",
+      "required": [
+        "size_gb"
+      ],
+      "properties": {
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "size_gb": {
+          "description": "Size of the disk in GB.",
+          "type": "integer"
+        }
+      }
+    },
     "host-progress": {
       "type": "object",
       "properties": {
@@ -9012,7 +10230,9 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "host_network": {
@@ -9073,6 +10293,27 @@ func init() {
         }
       }
     },
+    "ignition-content-hash": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The name of the generated ignition file.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "Unique identifier of the host the ignition was generated for. Empty for the ignitions of a role.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "sha256": {
+          "description": "Hex encoded SHA-256 hash of the generated ignition.",
+          "type": "string"
+        }
+      }
+    },
     "ignition-endpoint": {
       "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
       "type": "object",
@@ -9107,6 +10348,81 @@ func init() {
         }
       }
     },
+    "ignition-generation-record": {
+      "description": "The content hashes of the ignitions generated for a cluster, and the inputs they were generated with.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster the ignitions were generated for.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "generated_at": {
+          "description": "The time the ignitions were generated.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "ignitions": {
+          "description": "The content hashes of the generated ignitions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-content-hash"
+          }
+        },
+        "install_config_hash": {
+          "description": "Hex encoded SHA-256 hash of the install config the ignitions were generated from.",
+          "type": "string"
+        },
+        "installer_digest": {
+          "description": "Hex encoded SHA-256 hash of the installer binary that generated the ignitions.",
+          "type": "string"
+        },
+        "manifests_revision": {
+          "description": "Hex encoded SHA-256 hash of the manifests the ignitions were generated from.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The release image the ignitions were generated for.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-override-conflict": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The path of the replaced file, directory or link, or the name of the replaced unit or user.",
+          "type": "string"
+        },
+        "type": {
+          "description": "The type of the replaced ignition content.",
+          "type": "string",
+          "enum": [
+            "file",
+            "directory",
+            "link",
+            "unit",
+            "user"
+          ]
+        }
+      }
+    },
+    "ignition-preview": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The content generated by the service that the ignition config overrides replace.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-conflict"
+          }
+        },
+        "ignition": {
+          "description": "The ignition config merged with the ignition config overrides, with secrets redacted.",
+          "type": "string"
+        }
+      }
+    },
     "ignored-validations": {
       "type": "object",
       "properties": {
@@ -9285,6 +10601,18 @@ func init() {
             "type": "Time"
           }
         },
+        "discovery_customization": {
+          "description": "JSON formatted discovery-customization that is rendered into the discovery ignition.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
+          "x-nullable": true
+        },
+        "discovery_customization_revision": {
+          "description": "The revision of the discovery customization, incremented whenever it changes. Zero if the infra-env was never customized.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
         "download_url": {
           "type": "string"
         },
@@ -9312,7 +10640,14 @@ func init() {
         },
         "ignition_config_override": {
           "description": "Json formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"serializer:encrypted\""
+        },
+        "ignition_config_override_source": {
+          "description": "The Butane config that ignition_config_override was translated from, if the override was supplied as a Butane config.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"serializer:encrypted\"",
+          "readOnly": true
         },
         "kernel_arguments": {
           "description": "JSON formatted string array representing the discovery image kernel arguments.",
@@ -9432,8 +10767,11 @@ func init() {
           ],
           "x-nullable": false
         },
+        "discovery_customization": {
+          "$ref": "#/definitions/discovery-customization"
+        },
         "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "description": "JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.",
           "type": "string"
         },
         "image_type": {
@@ -9507,8 +10845,11 @@ func init() {
           "maxLength": 65535,
           "x-nullable": true
         },
+        "discovery_customization": {
+          "$ref": "#/definitions/discovery-customization"
+        },
         "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "description": "JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.",
           "type": "string"
         },
         "image_type": {
@@ -9602,6 +10943,21 @@ func init() {
         }
       }
     },
+    "install-operators-params": {
+      "type": "object",
+      "required": [
+        "olm_operators"
+      ],
+      "properties": {
+        "olm_operators": {
+          "description": "The OLM operators to install. Their missing dependencies are installed as well.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        }
+      }
+    },
     "install_cmd_request": {
       "type": "object",
       "required": [
@@ -9994,6 +11350,12 @@ func init() {
         "$ref": "#/definitions/manifest"
       }
     },
+    "list-rendered-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/rendered-manifest"
+      }
+    },
     "list-versions": {
       "type": "object",
       "properties": {
@@ -10163,6 +11525,13 @@ func init() {
             "openshift"
           ]
         },
+        "lint_issues": {
+          "description": "The issues that were found in the manifest when it was last checked against the other manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/manifest-lint-issue"
+          }
+        },
         "manifest_source": {
           "description": "Describes whether manifest is sourced from a user or created by the system.",
           "type": "string",
@@ -10173,6 +11542,34 @@ func init() {
         }
       }
     },
+    "manifest-lint-issue": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "description": "A description of the issue and of the object it was found in.",
+          "type": "string"
+        },
+        "rule": {
+          "description": "The check that found the issue.",
+          "type": "string",
+          "enum": [
+            "invalid-object",
+            "api-version",
+            "machine-config-pool",
+            "ignition-version",
+            "duplicate-object"
+          ]
+        },
+        "severity": {
+          "description": "Errors fail the custom manifests cluster validation, warnings only inform.",
+          "type": "string",
+          "enum": [
+            "warning",
+            "error"
+          ]
+        }
+      }
+    },
     "memory": {
       "type": "object",
       "properties": {
@@ -10222,10 +11619,21 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "day2": {
+          "description": "Whether the operator was installed after the cluster was installed. The service, instead of the installer, applies its manifests and monitors it.",
+          "type": "boolean"
+        },
         "dependency_only": {
           "description": "Whether the operator can't be installed without being required by another operator.",
           "type": "boolean"
         },
+        "failure_policy": {
+          "$ref": "#/definitions/operator-failure-policy"
+        },
+        "max_retries": {
+          "description": "Number of times the subscription of the operator is reapplied when it fails and its failure policy is retry. 3 when not set.",
+          "type": "integer"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -10239,10 +11647,18 @@ func init() {
           "$ref": "#/definitions/operator-type"
         },
         "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "description": "JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "retries": {
+          "description": "Number of times the subscription of the operator was reapplied after it failed.",
+          "type": "integer"
+        },
+        "retry_pending": {
+          "description": "Whether another attempt of the failed operator was recorded, and its subscription wasn't reapplied yet.",
+          "type": "boolean"
+        },
         "source_bundles": {
           "description": "IDs of the bundles this operator was selected through. Empty for standalone selections. An operator can belong to multiple bundles.",
           "type": "array",
@@ -10273,6 +11689,9 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "subscription": {
+          "$ref": "#/definitions/operator-subscription"
+        },
         "subscription_name": {
           "description": "The name of the subscription of the operator.",
           "type": "string"
@@ -10462,19 +11881,66 @@ func init() {
         }
       }
     },
-    "operator-create-params": {
+    "operator-conflict": {
       "type": "object",
       "properties": {
-        "name": {
+        "constraint": {
+          "description": "The constraint that the operators violate.",
+          "type": "string",
+          "enum": [
+            "openshift-version",
+            "cpu-architecture",
+            "platform",
+            "operators"
+          ]
+        },
+        "message": {
+          "description": "Explanation of the conflict.",
           "type": "string"
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
+        "operators": {
+          "description": "Names of the operators involved in the conflict.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "operator-create-params": {
+      "type": "object",
+      "properties": {
+        "failure_policy": {
+          "$ref": "#/definitions/operator-failure-policy"
+        },
+        "max_retries": {
+          "description": "Number of times the subscription of the operator is reapplied when it fails. Only valid with the retry failure policy, 3 when not set.",
+          "type": "integer",
+          "maximum": 10,
+          "minimum": 1
+        },
+        "name": {
+          "type": "string"
+        },
+        "properties": {
+          "description": "JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.",
+          "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "subscription": {
+          "$ref": "#/definitions/operator-subscription"
         }
       }
     },
+    "operator-failure-policy": {
+      "description": "What a failure of an OLM operator during the installation means for the cluster:\n  * required - The installation fails.\n  * best-effort - The cluster is installed, but degraded. This is the default.\n  * retry - The subscription of the operator is reapplied, up to max_retries times. When it still fails, the cluster is installed, but degraded. The subscription is reapplied by the service, so this only works for clusters whose API is reachable from the service; for the others it's the same as best-effort.\n",
+      "type": "string",
+      "enum": [
+        "required",
+        "best-effort",
+        "retry"
+      ]
+    },
     "operator-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -10568,6 +12034,13 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "schema": {
+          "description": "JSON Schema (draft 4) of the value of the property, that the value is validated against. Values of mandatory properties must be set, and properties that aren't listed aren't allowed.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
         }
       }
     },
@@ -10580,6 +12053,37 @@ func init() {
         "available"
       ]
     },
+    "operator-subscription": {
+      "description": "Overrides of the OLM subscription of an operator. The fields that aren't set keep the values of the operator.",
+      "type": "object",
+      "properties": {
+        "channel": {
+          "description": "Channel of the operator package to subscribe to, for example ` + "`" + `stable-4.16` + "`" + `.",
+          "type": "string"
+        },
+        "install_plan_approval": {
+          "description": "Whether the install plans of the operator are approved automatically. Install plans that require a manual approval aren't approved by the installation, and the operator isn't available until they are.",
+          "type": "string",
+          "enum": [
+            "Automatic",
+            "Manual"
+          ]
+        },
+        "source": {
+          "description": "Name of the CatalogSource that provides the operator package, for example a mirror of ` + "`" + `redhat-operators` + "`" + ` in a disconnected environment.",
+          "type": "string"
+        },
+        "source_namespace": {
+          "description": "Namespace of the CatalogSource. Requires the source to be set.",
+          "type": "string"
+        },
+        "starting_csv": {
+          "description": "Name of the ClusterServiceVersion to install first, for example ` + "`" + `odf-operator.v4.16.3` + "`" + `. Together with the ` + "`" + `Manual` + "`" + ` install plan approval it pins the operator to that version.",
+          "type": "string"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:subscription_\""
+    },
     "operator-type": {
       "description": "Kind of operator. Different types are monitored by the service differently.",
       "type": "string",
@@ -10588,6 +12092,36 @@ func init() {
         "olm"
       ]
     },
+    "operators-resolution": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The constraints that prevent installing the operators.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-conflict"
+          }
+        },
+        "operators": {
+          "description": "The requested operators and their dependencies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resolved-operator"
+          }
+        },
+        "requirements": {
+          "description": "Host requirements of each of the operators.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
+        },
+        "total_requirements": {
+          "description": "Host requirements of all the operators together. CPU cores and RAM are added up, disk size is the largest of the operators.",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
     "os-image": {
       "type": "object",
       "required": [
@@ -10610,11 +12144,25 @@ func init() {
           ],
           "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
+        "kernel_sha256": {
+          "description": "The expected SHA-256 digest of the kernel of the OS image, as served by the image service.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
+        "kernel_signature_url": {
+          "description": "The URL of a detached signature of the kernel of the OS image, which iPXE verifies the kernel with before booting it.",
+          "type": "string"
+        },
         "openshift_version": {
           "description": "Version of the operating system image",
           "type": "string",
           "example": "4.12"
         },
+        "rootfs_sha256": {
+          "description": "The expected SHA-256 digest of the rootfs of the OS image, as served by the image service.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
         "url": {
           "description": "The base OS image used for the discovery iso.",
           "type": "string"
@@ -10875,6 +12423,64 @@ func init() {
         "$ref": "#/definitions/release-source"
       }
     },
+    "rendered-manifest": {
+      "type": "object",
+      "properties": {
+        "change": {
+          "description": "How the manifest differs from the manifest generated by the installer.",
+          "type": "string",
+          "enum": [
+            "added",
+            "modified",
+            "unchanged"
+          ]
+        },
+        "content": {
+          "description": "The rendered manifest content. Not set when a diff is requested.",
+          "type": "string"
+        },
+        "diff": {
+          "description": "Unified diff of the rendered manifest against the manifest generated by the installer. Only set when a diff is requested.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest file.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder that contains the manifest.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "resolved-operator": {
+      "type": "object",
+      "properties": {
+        "dependency_only": {
+          "description": "Whether the operator was added only because other operators depend on it.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the operator is part of the resolution.",
+          "type": "string"
+        },
+        "required_by": {
+          "description": "Names of the operators that depend on this operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -11146,7 +12752,7 @@ func init() {
           "x-nullable": false
         },
         "updated_content": {
-          "description": "The new base64 encoded manifest content.",
+          "description": "The new base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.",
           "type": "string",
           "x-nullable": true
         },
@@ -12570,6 +14176,84 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/actions/install-operators": {
+      "post": {
+        "description": "Installs OLM operators in an installed cluster, and monitors them until they are available.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2InstallOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The installed cluster to install the operators in.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators to install.",
+            "name": "install-operators-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/install-operators-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/{cluster_id}/actions/reset": {
       "post": {
         "description": "Resets a failed installation.",
@@ -14083,6 +15767,13 @@ func init() {
             "name": "file_name",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Download the Butane config that the manifest was translated from instead of the manifest.",
+            "name": "butane_source",
+            "in": "query"
           }
         ],
         "responses": {
@@ -14131,32 +15822,36 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/clusters/{cluster_id}/manifests/rendered": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Renders the manifests that the installer would use for the cluster installation, with the custom manifests, manifest patches and operator manifests applied. The cluster is not modified.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2RenderClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose manifests should be rendered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "type": "boolean",
+            "default": false,
+            "description": "Return only the manifests that differ from the installer defaults, with a unified diff against the default manifest instead of their content.",
+            "name": "diff",
             "in": "query"
           }
         ],
@@ -14164,7 +15859,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/list-rendered-manifests"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -14198,46 +15899,42 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/monitored-operators": {
+      "get": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
           "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -14264,23 +15961,96 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "v2ReportMonitoredOperatorStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
@@ -14754,6 +16524,109 @@ func init() {
         }
       }
     },
+    "/v2/download-audit-records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the recorded downloads of host ignitions, cluster ignitions and cluster credentials. Available to admin users only.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListDownloadAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the downloads of files of this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the downloads of ignitions of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "host-ignition",
+              "host-ignition-params",
+              "cluster-ignition",
+              "kubeconfig",
+              "kubeadmin-password",
+              "credentials"
+            ],
+            "type": "string",
+            "description": "Only return the downloads of this type of file.",
+            "name": "file_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the downloads that took place at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 1000,
+            "description": "The maximal number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of records to skip.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/download-audit-record-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/events": {
       "get": {
         "security": [
@@ -15505,6 +17378,72 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/discovery-customization-revisions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the revisions of the discovery customization of the infra-env, newest first.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListDiscoveryCustomizationRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose discovery customization revisions should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/discovery-customization-revision-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
@@ -15549,10 +17488,12 @@ func init() {
             "enum": [
               "discovery.ign",
               "ipxe-script",
-              "static-network-config"
+              "grub-config",
+              "static-network-config",
+              "boot-artifacts-sha256sums"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "description": "The file to be downloaded. boot-artifacts-sha256sums lists the expected SHA-256 digests of the boot artifacts that are identical for every infra-env, in the sha256sum format.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -15560,7 +17501,7 @@ func init() {
           {
             "type": "string",
             "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
+            "description": "Mac address of the host running ipxe script or grub config.",
             "name": "mac",
             "in": "query"
           },
@@ -15570,7 +17511,7 @@ func init() {
               "boot-order-control"
             ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
+            "description": "Specify the script type to be served for iPXE or GRUB.",
             "name": "ipxe_script_type",
             "in": "query"
           },
@@ -15669,10 +17610,13 @@ func init() {
           {
             "enum": [
               "discovery.ign",
-              "ipxe-script"
+              "ipxe-script",
+              "grub-config",
+              "uefi-http-boot",
+              "boot-artifacts-sha256sums"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "description": "The file to be downloaded. For uefi-http-boot, the URL of the discovery image that UEFI HTTP Boot clients boot from is returned.",
             "name": "file_name",
             "in": "query",
             "required": true
@@ -15683,7 +17627,7 @@ func init() {
               "boot-order-control"
             ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
+            "description": "Specify the script type to be served for iPXE or GRUB.",
             "name": "ipxe_script_type",
             "in": "query"
           }
@@ -16852,18 +18796,27 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Returns the pointer ignition of the host merged with its ignition config overrides, with secrets redacted, and the content generated by the service that the overrides replace.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2GetHostIgnitionPreview",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env of the host whose pointer ignition should be previewed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16871,23 +18824,174 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host whose pointer ignition should be previewed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignition-preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-verification": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Verifies that the ignition served to the host matches the ignition that was generated for it, and returns the inputs it was generated with.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2VerifyHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition should be verified.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition should be verified.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-verification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallerArgs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -17317,6 +19421,78 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/ignition-preview": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Returns the discovery ignition of the infra-env merged with its ignition config override, with secrets redacted, and the content generated by the service that the override replaces.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetInfraEnvIgnitionPreview",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose discovery ignition should be previewed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/ignition-preview"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
       "post": {
         "description": "Regenerate InfraEnv token signing key.",
@@ -17363,7 +19539,211 @@ func init() {
             }
           },
           "500": {
-            "description": "Error.",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles": {
+      "get": {
+        "description": "Retrieves a list of available bundles filtered by support level.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get list of available bundles",
+        "operationId": "V2ListBundles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external"
+            ],
+            "type": "string",
+            "description": "The provider platform type. openshift_version must be set.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "name": "external_platform_name",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles/{id}": {
+      "get": {
+        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "SNO"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
+            "name": "feature_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/bundle"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Bundle not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17371,8 +19751,8 @@ func init() {
         }
       }
     },
-    "/v2/openshift-versions": {
-      "get": {
+    "/v2/operators/capacity-plan": {
+      "post": {
         "security": [
           {
             "userAuth": [
@@ -17382,46 +19762,49 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Finds the smallest layout of hosts, among the given host profiles, that satisfies the requirements of OpenShift and of the operators, without a cluster.",
         "tags": [
-          "versions"
+          "operators"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "summary": "Plan the hosts needed to run a set of operators",
+        "operationId": "V2PlanCapacity",
         "parameters": [
           {
-            "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "name": "capacity-plan-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/capacity-plan-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/capacity-plan"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17429,20 +19812,41 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles": {
+    "/v2/operators/resolution": {
       "get": {
-        "description": "Retrieves a list of available bundles filtered by support level.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Resolves the dependencies of the requested operators and explains why each operator is part of the result, which constraints conflict with them and the resulting host requirements.",
         "tags": [
           "operators"
         ],
-        "summary": "Get list of available bundles",
-        "operationId": "V2ListBundles",
+        "summary": "Explain the resolution of a set of operators",
+        "operationId": "V2ResolveOperators",
         "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Names of the requested operators.",
+            "name": "operators",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster. If the parameter is not specified, no filtering is applied.",
+            "description": "Version of the OpenShift cluster.",
             "name": "openshift_version",
-            "in": "query"
+            "in": "query",
+            "required": true
           },
           {
             "enum": [
@@ -17455,7 +19859,7 @@ func init() {
             ],
             "type": "string",
             "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc). openshift_version must be set.",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
             "name": "cpu_architecture",
             "in": "query"
           },
@@ -17468,38 +19872,30 @@ func init() {
               "external"
             ],
             "type": "string",
-            "description": "The provider platform type. openshift_version must be set.",
+            "default": "baremetal",
+            "description": "The provider platform type.",
             "name": "platform_type",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external or if openshift_version is not set.",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
             "name": "external_platform_name",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
+            "type": "integer",
+            "default": 3,
+            "description": "Number of control plane nodes of the cluster.",
+            "name": "control_plane_count",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/operators-resolution"
             }
           },
           "400": {
@@ -17508,66 +19904,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Internal server error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/operators/bundles/{id}": {
-      "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle when some features are activated.",
-        "tags": [
-          "operators"
-        ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai` + "`" + `.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "SNO"
-              ],
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Array of feature IDs that affect bundle composition (e.g., [\"SNO\"] for Single Node OpenShift).",
-            "name": "feature_ids",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success",
-            "schema": {
-              "$ref": "#/definitions/bundle"
-            }
-          },
-          "400": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Bundle not found",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -17925,7 +20275,8 @@ func init() {
                   "oadp",
                   "metallb",
                   "loki",
-                  "openshift-logging"
+                  "openshift-logging",
+                  "sriov"
                 ]
               }
             }
@@ -18314,31 +20665,158 @@ func init() {
           "description": "List of operators that can be optionally selected by the user for this bundle. All are selected by default.",
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "string"
+          }
+        },
+        "title": {
+          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
+          "type": "string"
+        }
+      }
+    },
+    "bundle-create-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "description": "Bundle identifier (e.g., \"openshift-ai\").",
+          "type": "string"
+        },
+        "optional_operators": {
+          "description": "List of optional operator names the user selected for this bundle.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "capacity-plan": {
+      "type": "object",
+      "properties": {
+        "binding_requirements": {
+          "description": "The requirements that the next smaller layouts don't satisfy, which prevent using fewer or smaller hosts.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacity-plan-issue"
+          }
+        },
+        "control_plane": {
+          "description": "The control plane hosts of the smallest viable layout.",
+          "$ref": "#/definitions/capacity-plan-hosts"
+        },
+        "feasible": {
+          "description": "Whether a layout of hosts satisfies all the requirements.",
+          "type": "boolean"
+        },
+        "issues": {
+          "description": "When no layout is viable, the requirements that the largest layout doesn't satisfy.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacity-plan-issue"
+          }
+        },
+        "operators": {
+          "description": "Names of the planned operators, dependencies included.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "workers": {
+          "description": "The worker hosts of the smallest viable layout.",
+          "$ref": "#/definitions/capacity-plan-hosts"
+        }
+      }
+    },
+    "capacity-plan-hosts": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of hosts.",
+          "type": "integer"
+        },
+        "host_profile": {
+          "description": "Name of the profile of the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "capacity-plan-issue": {
+      "type": "object",
+      "properties": {
+        "layout": {
+          "description": "The layout of hosts that doesn't satisfy the requirement.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Explanation of the requirement that isn't satisfied.",
+          "type": "string"
+        },
+        "role": {
+          "description": "Role of the hosts that don't satisfy the requirement. Empty for requirements of the cluster.",
+          "$ref": "#/definitions/host-role"
+        },
+        "source": {
+          "description": "What the requirement comes from, \"hardware\" for the CPU cores, the RAM and the disk that OpenShift and the operators require, or the ID of the validation of an operator.",
+          "type": "string"
+        }
+      }
+    },
+    "capacity-plan-params": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "host_profiles"
+      ],
+      "properties": {
+        "control_plane_count": {
+          "description": "Number of control plane nodes of the cluster.",
+          "type": "integer",
+          "default": 3,
+          "maximum": 5,
+          "minimum": 1
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the hosts.",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ]
+        },
+        "host_profiles": {
+          "description": "The shapes of hosts that can be bought.",
+          "type": "array",
+          "maxItems": 10,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/host-profile"
+          }
+        },
+        "max_workers": {
+          "description": "Largest number of workers to consider.",
+          "type": "integer",
+          "default": 10,
+          "maximum": 100,
+          "minimum": 0
+        },
+        "olm_operators": {
+          "description": "The operators to run, with their properties. Their dependencies are added.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
           }
         },
-        "title": {
-          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
-          "type": "string"
-        }
-      }
-    },
-    "bundle-create-params": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "description": "Bundle identifier (e.g., \"openshift-ai\").",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
-        },
-        "optional_operators": {
-          "description": "List of optional operator names the user selected for this bundle.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
         }
       }
     },
@@ -19195,7 +21673,10 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "storage-capacity-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -19474,7 +21955,7 @@ func init() {
       ],
       "properties": {
         "content": {
-          "description": "base64 encoded manifest content.",
+          "description": "base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.",
           "type": "string"
         },
         "file_name": {
@@ -19585,6 +22066,184 @@ func init() {
         }
       }
     },
+    "discovery-customization": {
+      "description": "Customizations of the discovery environment that are rendered into the discovery ignition of the infra-env.",
+      "type": "object",
+      "properties": {
+        "files": {
+          "description": "Files that are written to the discovery environment, e.g. additional CA certificates.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-file"
+          }
+        },
+        "kernel_modules": {
+          "description": "Kernel modules that are loaded when the discovery environment boots.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^[a-zA-Z0-9_-]+$"
+          }
+        },
+        "sysctls": {
+          "description": "Kernel parameters that are set when the discovery environment boots.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-sysctl"
+          }
+        },
+        "systemd_units": {
+          "description": "Systemd units and drop-ins that are added to the discovery environment.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-systemd-unit"
+          }
+        }
+      }
+    },
+    "discovery-customization-file": {
+      "type": "object",
+      "required": [
+        "path",
+        "contents"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the file.",
+          "type": "string"
+        },
+        "mode": {
+          "description": "The permissions of the file, in decimal. Defaults to 420 (0644).",
+          "type": "integer",
+          "maximum": 4095,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "path": {
+          "description": "The absolute path of the file.",
+          "type": "string",
+          "pattern": "^/.+$"
+        }
+      }
+    },
+    "discovery-customization-revision": {
+      "type": "object",
+      "required": [
+        "infra_env_id",
+        "revision",
+        "created_at"
+      ],
+      "properties": {
+        "created_at": {
+          "description": "The time that the revision was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "discovery_customization": {
+          "description": "JSON formatted discovery-customization of this revision. Empty if the customization was removed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that the discovery customization belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "revision": {
+          "description": "The revision number.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        },
+        "user_name": {
+          "description": "The user that made the change.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-customization-revision-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/discovery-customization-revision"
+      }
+    },
+    "discovery-customization-sysctl": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The kernel parameter, e.g. net.ipv4.ip_forward.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_][a-zA-Z0-9_./-]*$"
+        },
+        "value": {
+          "description": "The value of the kernel parameter.",
+          "type": "string",
+          "pattern": "^[^\\n]+$"
+        }
+      }
+    },
+    "discovery-customization-systemd-dropin": {
+      "type": "object",
+      "required": [
+        "name",
+        "contents"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the drop-in.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the drop-in, e.g. 10-proxy.conf.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9@_.:-]+\\.conf$"
+        }
+      }
+    },
+    "discovery-customization-systemd-unit": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "contents": {
+          "description": "The contents of the unit. When empty, only the drop-ins are added to an existing unit.",
+          "type": "string"
+        },
+        "dropins": {
+          "description": "Drop-ins that are added to the unit.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/discovery-customization-systemd-dropin"
+          }
+        },
+        "enabled": {
+          "description": "Whether the unit is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "The name of the unit, e.g. my-service.service.",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9@_.:-]+\\.(service|socket|timer|path|mount|target)$"
+        }
+      }
+    },
     "disk": {
       "type": "object",
       "properties": {
@@ -19839,6 +22498,86 @@ func init() {
         }
       }
     },
+    "download-audit-record": {
+      "type": "object",
+      "required": [
+        "file_type",
+        "downloaded_at"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster whose file was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "content_sha256": {
+          "description": "Hex encoded SHA-256 hash of the downloaded content.",
+          "type": "string"
+        },
+        "downloaded_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "file_name": {
+          "description": "The name of the downloaded file.",
+          "type": "string"
+        },
+        "file_type": {
+          "description": "The type of the downloaded file. The ignition of a host is 'host-ignition' when it was downloaded\nas a file, and 'host-ignition-params' when it was fetched as JSON.\n",
+          "type": "string",
+          "enum": [
+            "host-ignition",
+            "host-ignition-params",
+            "cluster-ignition",
+            "kubeconfig",
+            "kubeadmin-password",
+            "credentials"
+          ]
+        },
+        "host_id": {
+          "description": "Unique identifier of the host whose ignition was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env of the host whose ignition was downloaded.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "org_id": {
+          "description": "The organization of the principal that downloaded the file.",
+          "type": "string"
+        },
+        "principal": {
+          "description": "The user or agent that downloaded the file.",
+          "type": "string"
+        },
+        "principal_role": {
+          "description": "The role of the principal that downloaded the file.",
+          "type": "string"
+        },
+        "request_id": {
+          "description": "Unique identifier of the download request.",
+          "type": "string"
+        },
+        "source_ip": {
+          "description": "The address the download request was sent from.",
+          "type": "string"
+        }
+      }
+    },
+    "download-audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/download-audit-record"
+      }
+    },
     "download_boot_artifacts_request": {
       "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
       "type": "object",
@@ -20084,7 +22823,8 @@ func init() {
         "METALLB",
         "DUAL_STACK_PRIMARY_IPV6",
         "LOKI",
-        "OPENSHIFT_LOGGING"
+        "OPENSHIFT_LOGGING",
+        "SRIOV"
       ],
       "x-nullable": false
     },
@@ -20305,9 +23045,15 @@ func init() {
         "ignition_config_overrides": {
           "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
           "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
+        "ignition_config_overrides_source": {
+          "description": "The Butane config that ignition_config_overrides was translated from, if the overrides were supplied as a Butane config.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
+          "readOnly": true
+        },
         "ignition_endpoint_token_set": {
           "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
           "type": "boolean"
@@ -20520,19 +23266,100 @@ func init() {
         }
       }
     },
-    "host-ignition-params": {
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition, or a Butane config of the openshift or fcos variant that is translated to it.",
+          "type": "string"
+        }
+      }
+    },
+    "host-ignition-verification": {
+      "type": "object",
+      "properties": {
+        "expected_sha256": {
+          "description": "Hex encoded SHA-256 hash of the ignition that was generated for the host.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the ignition file that is served to the host.",
+          "type": "string"
+        },
+        "generation_record": {
+          "$ref": "#/definitions/ignition-generation-record"
+        },
+        "host_id": {
+          "description": "Unique identifier of the verified host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "matches": {
+          "description": "Whether the ignition that is served to the host matches the ignition that was generated for it.",
+          "type": "boolean"
+        },
+        "served_sha256": {
+          "description": "Hex encoded SHA-256 hash of the ignition that is served to the host.",
+          "type": "string"
+        }
+      }
+    },
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-profile": {
+      "type": "object",
+      "required": [
+        "name",
+        "cpu_cores",
+        "ram_mib"
+      ],
+      "properties": {
+        "cpu_cores": {
+          "description": "Number of CPU cores of the host.",
+          "type": "integer"
+        },
+        "disks": {
+          "description": "Disks of the host. The first disk is the installation disk.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-profile-disk"
+          }
+        },
+        "gpus": {
+          "description": "GPUs of the host.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "name": {
+          "description": "Unique name of the profile.",
+          "type": "string"
+        },
+        "ram_mib": {
+          "description": "RAM of the host in MiB.",
+          "type": "integer"
+        }
+      }
+    },
+    "host-profile-disk": {
+      "type": "object",
+      "required": [
+        "size_gb"
+      ],
       "properties": {
-        "config": {
-          "type": "string"
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "size_gb": {
+          "description": "Size of the disk in GB.",
+          "type": "integer"
         }
       }
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
-      }
-    },
     "host-progress": {
       "type": "object",
       "properties": {
@@ -20777,7 +23604,9 @@ func init() {
         "oadp-requirements-satisfied",
         "metallb-requirements-satisfied",
         "loki-requirements-satisfied",
-        "openshift-logging-requirements-satisfied"
+        "openshift-logging-requirements-satisfied",
+        "sriov-requirements-satisfied",
+        "declarative-operators-requirements-satisfied"
       ]
     },
     "host_network": {
@@ -20838,6 +23667,27 @@ func init() {
         }
       }
     },
+    "ignition-content-hash": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The name of the generated ignition file.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "Unique identifier of the host the ignition was generated for. Empty for the ignitions of a role.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "sha256": {
+          "description": "Hex encoded SHA-256 hash of the generated ignition.",
+          "type": "string"
+        }
+      }
+    },
     "ignition-endpoint": {
       "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
       "type": "object",
@@ -20872,6 +23722,81 @@ func init() {
         }
       }
     },
+    "ignition-generation-record": {
+      "description": "The content hashes of the ignitions generated for a cluster, and the inputs they were generated with.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "Unique identifier of the cluster the ignitions were generated for.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "generated_at": {
+          "description": "The time the ignitions were generated.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "ignitions": {
+          "description": "The content hashes of the generated ignitions.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-content-hash"
+          }
+        },
+        "install_config_hash": {
+          "description": "Hex encoded SHA-256 hash of the install config the ignitions were generated from.",
+          "type": "string"
+        },
+        "installer_digest": {
+          "description": "Hex encoded SHA-256 hash of the installer binary that generated the ignitions.",
+          "type": "string"
+        },
+        "manifests_revision": {
+          "description": "Hex encoded SHA-256 hash of the manifests the ignitions were generated from.",
+          "type": "string"
+        },
+        "release_image": {
+          "description": "The release image the ignitions were generated for.",
+          "type": "string"
+        }
+      }
+    },
+    "ignition-override-conflict": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The path of the replaced file, directory or link, or the name of the replaced unit or user.",
+          "type": "string"
+        },
+        "type": {
+          "description": "The type of the replaced ignition content.",
+          "type": "string",
+          "enum": [
+            "file",
+            "directory",
+            "link",
+            "unit",
+            "user"
+          ]
+        }
+      }
+    },
+    "ignition-preview": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The content generated by the service that the ignition config overrides replace.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-override-conflict"
+          }
+        },
+        "ignition": {
+          "description": "The ignition config merged with the ignition config overrides, with secrets redacted.",
+          "type": "string"
+        }
+      }
+    },
     "ignored-validations": {
       "type": "object",
       "properties": {
@@ -21051,6 +23976,19 @@ func init() {
             "type": "Time"
           }
         },
+        "discovery_customization": {
+          "description": "JSON formatted discovery-customization that is rendered into the discovery ignition.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text;serializer:encrypted\"",
+          "x-nullable": true
+        },
+        "discovery_customization_revision": {
+          "description": "The revision of the discovery customization, incremented whenever it changes. Zero if the infra-env was never customized.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": false
+        },
         "download_url": {
           "type": "string"
         },
@@ -21078,7 +24016,14 @@ func init() {
         },
         "ignition_config_override": {
           "description": "Json formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"serializer:encrypted\""
+        },
+        "ignition_config_override_source": {
+          "description": "The Butane config that ignition_config_override was translated from, if the override was supplied as a Butane config.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"serializer:encrypted\"",
+          "readOnly": true
         },
         "kernel_arguments": {
           "description": "JSON formatted string array representing the discovery image kernel arguments.",
@@ -21200,8 +24145,11 @@ func init() {
           ],
           "x-nullable": false
         },
+        "discovery_customization": {
+          "$ref": "#/definitions/discovery-customization"
+        },
         "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "description": "JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.",
           "type": "string"
         },
         "image_type": {
@@ -21276,8 +24224,11 @@ func init() {
           "maxLength": 65535,
           "x-nullable": true
         },
+        "discovery_customization": {
+          "$ref": "#/definitions/discovery-customization"
+        },
         "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "description": "JSON formatted string containing the user overrides for the initial ignition config, or a Butane config of the openshift or fcos variant that is translated to it.",
           "type": "string"
         },
         "image_type": {
@@ -21372,6 +24323,21 @@ func init() {
         }
       }
     },
+    "install-operators-params": {
+      "type": "object",
+      "required": [
+        "olm_operators"
+      ],
+      "properties": {
+        "olm_operators": {
+          "description": "The OLM operators to install. Their missing dependencies are installed as well.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        }
+      }
+    },
     "install_cmd_request": {
       "type": "object",
       "required": [
@@ -21764,6 +24730,12 @@ func init() {
         "$ref": "#/definitions/manifest"
       }
     },
+    "list-rendered-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/rendered-manifest"
+      }
+    },
     "list-versions": {
       "type": "object",
       "properties": {
@@ -21918,6 +24890,13 @@ func init() {
             "openshift"
           ]
         },
+        "lint_issues": {
+          "description": "The issues that were found in the manifest when it was last checked against the other manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/manifest-lint-issue"
+          }
+        },
         "manifest_source": {
           "description": "Describes whether manifest is sourced from a user or created by the system.",
           "type": "string",
@@ -21928,6 +24907,34 @@ func init() {
         }
       }
     },
+    "manifest-lint-issue": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "description": "A description of the issue and of the object it was found in.",
+          "type": "string"
+        },
+        "rule": {
+          "description": "The check that found the issue.",
+          "type": "string",
+          "enum": [
+            "invalid-object",
+            "api-version",
+            "machine-config-pool",
+            "ignition-version",
+            "duplicate-object"
+          ]
+        },
+        "severity": {
+          "description": "Errors fail the custom manifests cluster validation, warnings only inform.",
+          "type": "string",
+          "enum": [
+            "warning",
+            "error"
+          ]
+        }
+      }
+    },
     "memory": {
       "type": "object",
       "properties": {
@@ -21977,10 +24984,21 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
+        "day2": {
+          "description": "Whether the operator was installed after the cluster was installed. The service, instead of the installer, applies its manifests and monitors it.",
+          "type": "boolean"
+        },
         "dependency_only": {
           "description": "Whether the operator can't be installed without being required by another operator.",
           "type": "boolean"
         },
+        "failure_policy": {
+          "$ref": "#/definitions/operator-failure-policy"
+        },
+        "max_retries": {
+          "description": "Number of times the subscription of the operator is reapplied when it fails and its failure policy is retry. 3 when not set.",
+          "type": "integer"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -21994,10 +25012,18 @@ func init() {
           "$ref": "#/definitions/operator-type"
         },
         "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "description": "JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "retries": {
+          "description": "Number of times the subscription of the operator was reapplied after it failed.",
+          "type": "integer"
+        },
+        "retry_pending": {
+          "description": "Whether another attempt of the failed operator was recorded, and its subscription wasn't reapplied yet.",
+          "type": "boolean"
+        },
         "source_bundles": {
           "description": "IDs of the bundles this operator was selected through. Empty for standalone selections. An operator can belong to multiple bundles.",
           "type": "array",
@@ -22028,6 +25054,9 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "subscription": {
+          "$ref": "#/definitions/operator-subscription"
+        },
         "subscription_name": {
           "description": "The name of the subscription of the operator.",
           "type": "string"
@@ -22217,19 +25246,66 @@ func init() {
         }
       }
     },
+    "operator-conflict": {
+      "type": "object",
+      "properties": {
+        "constraint": {
+          "description": "The constraint that the operators violate.",
+          "type": "string",
+          "enum": [
+            "openshift-version",
+            "cpu-architecture",
+            "platform",
+            "operators"
+          ]
+        },
+        "message": {
+          "description": "Explanation of the conflict.",
+          "type": "string"
+        },
+        "operators": {
+          "description": "Names of the operators involved in the conflict.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "operator-create-params": {
       "type": "object",
       "properties": {
+        "failure_policy": {
+          "$ref": "#/definitions/operator-failure-policy"
+        },
+        "max_retries": {
+          "description": "Number of times the subscription of the operator is reapplied when it fails. Only valid with the retry failure policy, 3 when not set.",
+          "type": "integer",
+          "maximum": 10,
+          "minimum": 1
+        },
         "name": {
           "type": "string"
         },
         "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "description": "JSON object with the operator-dependent parameters that are required for installation. It is validated against the properties schema of the operator, and the defaults of the schema are filled in.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "subscription": {
+          "$ref": "#/definitions/operator-subscription"
         }
       }
     },
+    "operator-failure-policy": {
+      "description": "What a failure of an OLM operator during the installation means for the cluster:\n  * required - The installation fails.\n  * best-effort - The cluster is installed, but degraded. This is the default.\n  * retry - The subscription of the operator is reapplied, up to max_retries times. When it still fails, the cluster is installed, but degraded. The subscription is reapplied by the service, so this only works for clusters whose API is reachable from the service; for the others it's the same as best-effort.\n",
+      "type": "string",
+      "enum": [
+        "required",
+        "best-effort",
+        "retry"
+      ]
+    },
     "operator-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -22323,6 +25399,13 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "schema": {
+          "description": "JSON Schema (draft 4) of the value of the property, that the value is validated against. Values of mandatory properties must be set, and properties that aren't listed aren't allowed.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
         }
       }
     },
@@ -22335,6 +25418,37 @@ func init() {
         "available"
       ]
     },
+    "operator-subscription": {
+      "description": "Overrides of the OLM subscription of an operator. The fields that aren't set keep the values of the operator.",
+      "type": "object",
+      "properties": {
+        "channel": {
+          "description": "Channel of the operator package to subscribe to, for example ` + "`" + `stable-4.16` + "`" + `.",
+          "type": "string"
+        },
+        "install_plan_approval": {
+          "description": "Whether the install plans of the operator are approved automatically. Install plans that require a manual approval aren't approved by the installation, and the operator isn't available until they are.",
+          "type": "string",
+          "enum": [
+            "Automatic",
+            "Manual"
+          ]
+        },
+        "source": {
+          "description": "Name of the CatalogSource that provides the operator package, for example a mirror of ` + "`" + `redhat-operators` + "`" + ` in a disconnected environment.",
+          "type": "string"
+        },
+        "source_namespace": {
+          "description": "Namespace of the CatalogSource. Requires the source to be set.",
+          "type": "string"
+        },
+        "starting_csv": {
+          "description": "Name of the ClusterServiceVersion to install first, for example ` + "`" + `odf-operator.v4.16.3` + "`" + `. Together with the ` + "`" + `Manual` + "`" + ` install plan approval it pins the operator to that version.",
+          "type": "string"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:subscription_\""
+    },
     "operator-type": {
       "description": "Kind of operator. Different types are monitored by the service differently.",
       "type": "string",
@@ -22343,6 +25457,36 @@ func init() {
         "olm"
       ]
     },
+    "operators-resolution": {
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "The constraints that prevent installing the operators.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-conflict"
+          }
+        },
+        "operators": {
+          "description": "The requested operators and their dependencies.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resolved-operator"
+          }
+        },
+        "requirements": {
+          "description": "Host requirements of each of the operators.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
+        },
+        "total_requirements": {
+          "description": "Host requirements of all the operators together. CPU cores and RAM are added up, disk size is the largest of the operators.",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
     "os-image": {
       "type": "object",
       "required": [
@@ -22365,11 +25509,25 @@ func init() {
           ],
           "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
+        "kernel_sha256": {
+          "description": "The expected SHA-256 digest of the kernel of the OS image, as served by the image service.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
+        "kernel_signature_url": {
+          "description": "The URL of a detached signature of the kernel of the OS image, which iPXE verifies the kernel with before booting it.",
+          "type": "string"
+        },
         "openshift_version": {
           "description": "Version of the operating system image",
           "type": "string",
           "example": "4.12"
         },
+        "rootfs_sha256": {
+          "description": "The expected SHA-256 digest of the rootfs of the OS image, as served by the image service.",
+          "type": "string",
+          "pattern": "^[a-f0-9]{64}$"
+        },
         "url": {
           "description": "The base OS image used for the discovery iso.",
           "type": "string"
@@ -22630,6 +25788,64 @@ func init() {
         "$ref": "#/definitions/release-source"
       }
     },
+    "rendered-manifest": {
+      "type": "object",
+      "properties": {
+        "change": {
+          "description": "How the manifest differs from the manifest generated by the installer.",
+          "type": "string",
+          "enum": [
+            "added",
+            "modified",
+            "unchanged"
+          ]
+        },
+        "content": {
+          "description": "The rendered manifest content. Not set when a diff is requested.",
+          "type": "string"
+        },
+        "diff": {
+          "description": "Unified diff of the rendered manifest against the manifest generated by the installer. Only set when a diff is requested.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest file.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder that contains the manifest.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "resolved-operator": {
+      "type": "object",
+      "properties": {
+        "dependency_only": {
+          "description": "Whether the operator was added only because other operators depend on it.",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Why the operator is part of the resolution.",
+          "type": "string"
+        },
+        "required_by": {
+          "description": "Names of the operators that depend on this operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -22875,7 +26091,7 @@ func init() {
           "x-nullable": false
         },
         "updated_content": {
-          "description": "The new base64 encoded manifest content.",
+          "description": "The new base64 encoded manifest content. YAML manifests can also be Butane configs of the openshift variant, which are translated to MachineConfigs.",
           "type": "string",
           "x-nullable": true
         },
@@ -84,6 +84,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerListClusterHostsHandler: installer.ListClusterHostsHandlerFunc(func(params installer.ListClusterHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusterHosts has not yet been implemented")
 		}),
+		InstallerListDiscoveryCustomizationRevisionsHandler: installer.ListDiscoveryCustomizationRevisionsHandlerFunc(func(params installer.ListDiscoveryCustomizationRevisionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListDiscoveryCustomizationRevisions has not yet been implemented")
+		}),
 		InstallerListInfraEnvsHandler: installer.ListInfraEnvsHandlerFunc(func(params installer.ListInfraEnvsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListInfraEnvs has not yet been implemented")
 		}),
@@ -141,6 +144,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetPresignedForClusterFilesHandler: installer.V2GetPresignedForClusterFilesHandlerFunc(func(params installer.V2GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		OperatorsV2InstallOperatorsHandler: operators.V2InstallOperatorsHandlerFunc(func(params operators.V2InstallOperatorsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2InstallOperators has not yet been implemented")
+		}),
 		OperatorsV2ListBundlesHandler: operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListBundles has not yet been implemented")
 		}),
@@ -159,6 +165,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsV2ListSupportedOperatorsHandler: operators.V2ListSupportedOperatorsHandlerFunc(func(params operators.V2ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListSupportedOperators has not yet been implemented")
 		}),
+		OperatorsV2PlanCapacityHandler: operators.V2PlanCapacityHandlerFunc(func(params operators.V2PlanCapacityParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2PlanCapacity has not yet been implemented")
+		}),
+		OperatorsV2ResolveOperatorsHandler: operators.V2ResolveOperatorsHandlerFunc(func(params operators.V2ResolveOperatorsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2ResolveOperators has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterHandler: installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateCluster has not yet been implemented")
 		}),
@@ -201,9 +213,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetHostIgnitionHandler: installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHostIgnition has not yet been implemented")
 		}),
+		InstallerV2GetHostIgnitionPreviewHandler: installer.V2GetHostIgnitionPreviewHandlerFunc(func(params installer.V2GetHostIgnitionPreviewParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetHostIgnitionPreview has not yet been implemented")
+		}),
 		InstallerV2GetIgnoredValidationsHandler: installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetIgnoredValidations has not yet been implemented")
 		}),
+		InstallerV2GetInfraEnvIgnitionPreviewHandler: installer.V2GetInfraEnvIgnitionPreviewHandlerFunc(func(params installer.V2GetInfraEnvIgnitionPreviewParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetInfraEnvIgnitionPreview has not yet been implemented")
+		}),
 		InstallerV2GetNextStepsHandler: installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetNextSteps has not yet been implemented")
 		}),
@@ -225,6 +243,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		VersionsV2ListComponentVersionsHandler: versions.V2ListComponentVersionsHandlerFunc(func(params versions.V2ListComponentVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListComponentVersions has not yet been implemented")
 		}),
+		InstallerV2ListDownloadAuditRecordsHandler: installer.V2ListDownloadAuditRecordsHandlerFunc(func(params installer.V2ListDownloadAuditRecordsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListDownloadAuditRecords has not yet been implemented")
+		}),
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
@@ -249,6 +270,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		InstallerV2RenderClusterManifestsHandler: installer.V2RenderClusterManifestsHandlerFunc(func(params installer.V2RenderClusterManifestsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RenderClusterManifests has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -294,6 +318,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		InstallerV2VerifyHostIgnitionHandler: installer.V2VerifyHostIgnitionHandlerFunc(func(params installer.V2VerifyHostIgnitionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2VerifyHostIgnition has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -412,6 +439,8 @@ type AssistedInstallAPI struct {
 	InstallerGetSupportedFeaturesHandler installer.GetSupportedFeaturesHandler
 	// InstallerListClusterHostsHandler sets the operation handler for the list cluster hosts operation
 	InstallerListClusterHostsHandler installer.ListClusterHostsHandler
+	// InstallerListDiscoveryCustomizationRevisionsHandler sets the operation handler for the list discovery customization revisions operation
+	InstallerListDiscoveryCustomizationRevisionsHandler installer.ListDiscoveryCustomizationRevisionsHandler
 	// InstallerListInfraEnvsHandler sets the operation handler for the list infra envs operation
 	InstallerListInfraEnvsHandler installer.ListInfraEnvsHandler
 	// InstallerRegenerateInfraEnvSigningKeyHandler sets the operation handler for the regenerate infra env signing key operation
@@ -450,6 +479,8 @@ type AssistedInstallAPI struct {
 	InstallerV2GetPresignedForClusterCredentialsHandler installer.V2GetPresignedForClusterCredentialsHandler
 	// InstallerV2GetPresignedForClusterFilesHandler sets the operation handler for the v2 get presigned for cluster files operation
 	InstallerV2GetPresignedForClusterFilesHandler installer.V2GetPresignedForClusterFilesHandler
+	// OperatorsV2InstallOperatorsHandler sets the operation handler for the v2 install operators operation
+	OperatorsV2InstallOperatorsHandler operators.V2InstallOperatorsHandler
 	// OperatorsV2ListBundlesHandler sets the operation handler for the v2 list bundles operation
 	OperatorsV2ListBundlesHandler operators.V2ListBundlesHandler
 	// ManifestsV2ListClusterManifestsHandler sets the operation handler for the v2 list cluster manifests operation
@@ -462,6 +493,10 @@ type AssistedInstallAPI struct {
 	OperatorsV2ListOperatorPropertiesHandler operators.V2ListOperatorPropertiesHandler
 	// OperatorsV2ListSupportedOperatorsHandler sets the operation handler for the v2 list supported operators operation
 	OperatorsV2ListSupportedOperatorsHandler operators.V2ListSupportedOperatorsHandler
+	// OperatorsV2PlanCapacityHandler sets the operation handler for the v2 plan capacity operation
+	OperatorsV2PlanCapacityHandler operators.V2PlanCapacityHandler
+	// OperatorsV2ResolveOperatorsHandler sets the operation handler for the v2 resolve operators operation
+	OperatorsV2ResolveOperatorsHandler operators.V2ResolveOperatorsHandler
 	// InstallerV2UpdateClusterHandler sets the operation handler for the v2 update cluster operation
 	InstallerV2UpdateClusterHandler installer.V2UpdateClusterHandler
 	// ManifestsV2UpdateClusterManifestHandler sets the operation handler for the v2 update cluster manifest operation
@@ -490,8 +525,12 @@ type AssistedInstallAPI struct {
 	InstallerV2GetHostHandler installer.V2GetHostHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
+	// InstallerV2GetHostIgnitionPreviewHandler sets the operation handler for the v2 get host ignition preview operation
+	InstallerV2GetHostIgnitionPreviewHandler installer.V2GetHostIgnitionPreviewHandler
 	// InstallerV2GetIgnoredValidationsHandler sets the operation handler for the v2 get ignored validations operation
 	InstallerV2GetIgnoredValidationsHandler installer.V2GetIgnoredValidationsHandler
+	// InstallerV2GetInfraEnvIgnitionPreviewHandler sets the operation handler for the v2 get infra env ignition preview operation
+	InstallerV2GetInfraEnvIgnitionPreviewHandler installer.V2GetInfraEnvIgnitionPreviewHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
@@ -506,6 +545,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
+	// InstallerV2ListDownloadAuditRecordsHandler sets the operation handler for the v2 list download audit records operation
+	InstallerV2ListDownloadAuditRecordsHandler installer.V2ListDownloadAuditRecordsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
@@ -522,6 +563,8 @@ type AssistedInstallAPI struct {
 	InstallerV2RegisterDisconnectedClusterHandler installer.V2RegisterDisconnectedClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// InstallerV2RenderClusterManifestsHandler sets the operation handler for the v2 render cluster manifests operation
+	InstallerV2RenderClusterManifestsHandler installer.V2RenderClusterManifestsHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -552,6 +595,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// InstallerV2VerifyHostIgnitionHandler sets the operation handler for the v2 verify host ignition operation
+	InstallerV2VerifyHostIgnitionHandler installer.V2VerifyHostIgnitionHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -687,6 +732,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerListClusterHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListClusterHostsHandler")
 	}
+	if o.InstallerListDiscoveryCustomizationRevisionsHandler == nil {
+		unregistered = append(unregistered, "installer.ListDiscoveryCustomizationRevisionsHandler")
+	}
 	if o.InstallerListInfraEnvsHandler == nil {
 		unregistered = append(unregistered, "installer.ListInfraEnvsHandler")
 	}
@@ -744,6 +792,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterFilesHandler")
 	}
+	if o.OperatorsV2InstallOperatorsHandler == nil {
+		unregistered = append(unregistered, "operators.V2InstallOperatorsHandler")
+	}
 	if o.OperatorsV2ListBundlesHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListBundlesHandler")
 	}
@@ -762,6 +813,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsV2ListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListSupportedOperatorsHandler")
 	}
+	if o.OperatorsV2PlanCapacityHandler == nil {
+		unregistered = append(unregistered, "operators.V2PlanCapacityHandler")
+	}
+	if o.OperatorsV2ResolveOperatorsHandler == nil {
+		unregistered = append(unregistered, "operators.V2ResolveOperatorsHandler")
+	}
 	if o.InstallerV2UpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterHandler")
 	}
@@ -804,9 +861,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostIgnitionHandler")
 	}
+	if o.InstallerV2GetHostIgnitionPreviewHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetHostIgnitionPreviewHandler")
+	}
 	if o.InstallerV2GetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetIgnoredValidationsHandler")
 	}
+	if o.InstallerV2GetInfraEnvIgnitionPreviewHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetInfraEnvIgnitionPreviewHandler")
+	}
 	if o.InstallerV2GetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetNextStepsHandler")
 	}
@@ -828,6 +891,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.VersionsV2ListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListComponentVersionsHandler")
 	}
+	if o.InstallerV2ListDownloadAuditRecordsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListDownloadAuditRecordsHandler")
+	}
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
@@ -852,6 +918,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.InstallerV2RenderClusterManifestsHandler == nil {
+		unregistered = append(unregistered, "installer.V2RenderClusterManifestsHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -897,6 +966,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.InstallerV2VerifyHostIgnitionHandler == nil {
+		unregistered = append(unregistered, "installer.V2VerifyHostIgnitionHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1065,6 +1137,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/discovery-customization-revisions"] = installer.NewListDiscoveryCustomizationRevisions(o.context, o.InstallerListDiscoveryCustomizationRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs"] = installer.NewListInfraEnvs(o.context, o.InstallerListInfraEnvsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1138,6 +1214,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/files-presigned"] = installer.NewV2GetPresignedForClusterFiles(o.context, o.InstallerV2GetPresignedForClusterFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/install-operators"] = operators.NewV2InstallOperators(o.context, o.OperatorsV2InstallOperatorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1162,6 +1242,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/supported-operators"] = operators.NewV2ListSupportedOperators(o.context, o.OperatorsV2ListSupportedOperatorsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/operators/capacity-plan"] = operators.NewV2PlanCapacity(o.context, o.OperatorsV2PlanCapacityHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/operators/resolution"] = operators.NewV2ResolveOperators(o.context, o.OperatorsV2ResolveOperatorsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1221,10 +1309,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-preview"] = installer.NewV2GetHostIgnitionPreview(o.context, o.InstallerV2GetHostIgnitionPreviewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/ignored-validations"] = installer.NewV2GetIgnoredValidations(o.context, o.InstallerV2GetIgnoredValidationsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/ignition-preview"] = installer.NewV2GetInfraEnvIgnitionPreview(o.context, o.InstallerV2GetInfraEnvIgnitionPreviewHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions"] = installer.NewV2GetNextSteps(o.context, o.InstallerV2GetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1253,6 +1349,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/download-audit-records"] = installer.NewV2ListDownloadAuditRecords(o.context, o.InstallerV2ListDownloadAuditRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/events"] = events.NewV2ListEvents(o.context, o.EventsV2ListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1282,6 +1382,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/manifests/rendered"] = installer.NewV2RenderClusterManifests(o.context, o.InstallerV2RenderClusterManifestsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1342,6 +1446,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition-verification"] = installer.NewV2VerifyHostIgnition(o.context, o.InstallerV2VerifyHostIgnitionHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
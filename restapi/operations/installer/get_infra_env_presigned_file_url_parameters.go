@@ -32,7 +32,7 @@ type GetInfraEnvPresignedFileURLParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*The file to be downloaded.
+	/*The file to be downloaded. For uefi-http-boot, the URL of the discovery image that UEFI HTTP Boot clients boot from is returned.
 	  Required: true
 	  In: query
 	*/
@@ -42,7 +42,7 @@ type GetInfraEnvPresignedFileURLParams struct {
 	  In: path
 	*/
 	InfraEnvID strfmt.UUID
-	/*Specify the script type to be served for iPXE.
+	/*Specify the script type to be served for iPXE or GRUB.
 	  In: query
 	*/
 	IpxeScriptType *string
@@ -107,7 +107,7 @@ func (o *GetInfraEnvPresignedFileURLParams) bindFileName(rawData []string, hasKe
 // validateFileName carries on validations for parameter FileName
 func (o *GetInfraEnvPresignedFileURLParams) validateFileName(formats strfmt.Registry) error {
 
-	if err := validate.EnumCase("file_name", "query", o.FileName, []interface{}{"discovery.ign", "ipxe-script"}, true); err != nil {
+	if err := validate.EnumCase("file_name", "query", o.FileName, []interface{}{"discovery.ign", "ipxe-script", "grub-config", "uefi-http-boot", "boot-artifacts-sha256sums"}, true); err != nil {
 		return err
 	}
 
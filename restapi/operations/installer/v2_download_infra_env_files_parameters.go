@@ -36,7 +36,7 @@ type V2DownloadInfraEnvFilesParams struct {
 	  In: query
 	*/
 	DiscoveryIsoType *string
-	/*The file to be downloaded.
+	/*The file to be downloaded. boot-artifacts-sha256sums lists the expected SHA-256 digests of the boot artifacts that are identical for every infra-env, in the sha256sum format.
 	  Required: true
 	  In: query
 	*/
@@ -46,11 +46,11 @@ type V2DownloadInfraEnvFilesParams struct {
 	  In: path
 	*/
 	InfraEnvID strfmt.UUID
-	/*Specify the script type to be served for iPXE.
+	/*Specify the script type to be served for iPXE or GRUB.
 	  In: query
 	*/
 	IpxeScriptType *string
-	/*Mac address of the host running ipxe script.
+	/*Mac address of the host running ipxe script or grub config.
 	  In: query
 	*/
 	Mac *strfmt.MAC
@@ -157,7 +157,7 @@ func (o *V2DownloadInfraEnvFilesParams) bindFileName(rawData []string, hasKey bo
 // validateFileName carries on validations for parameter FileName
 func (o *V2DownloadInfraEnvFilesParams) validateFileName(formats strfmt.Registry) error {
 
-	if err := validate.EnumCase("file_name", "query", o.FileName, []interface{}{"discovery.ign", "ipxe-script", "static-network-config"}, true); err != nil {
+	if err := validate.EnumCase("file_name", "query", o.FileName, []interface{}{"discovery.ign", "ipxe-script", "grub-config", "static-network-config", "boot-artifacts-sha256sums"}, true); err != nil {
 		return err
 	}
 
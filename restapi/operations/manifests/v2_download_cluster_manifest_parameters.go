@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -22,10 +23,14 @@ func NewV2DownloadClusterManifestParams() V2DownloadClusterManifestParams {
 	var (
 		// initialize parameters with default values
 
+		butaneSourceDefault = bool(false)
+
 		folderDefault = string("manifests")
 	)
 
 	return V2DownloadClusterManifestParams{
+		ButaneSource: &butaneSourceDefault,
+
 		Folder: &folderDefault,
 	}
 }
@@ -39,6 +44,11 @@ type V2DownloadClusterManifestParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Download the Butane config that the manifest was translated from instead of the manifest.
+	  In: query
+	  Default: false
+	*/
+	ButaneSource *bool
 	/*The cluster whose manifest should be downloaded.
 	  Required: true
 	  In: path
@@ -67,6 +77,11 @@ func (o *V2DownloadClusterManifestParams) BindRequest(r *http.Request, route *mi
 
 	qs := runtime.Values(r.URL.Query())
 
+	qButaneSource, qhkButaneSource, _ := qs.GetOK("butane_source")
+	if err := o.bindButaneSource(qButaneSource, qhkButaneSource, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -87,6 +102,30 @@ func (o *V2DownloadClusterManifestParams) BindRequest(r *http.Request, route *mi
 	return nil
 }
 
+// bindButaneSource binds and validates parameter ButaneSource from query.
+func (o *V2DownloadClusterManifestParams) bindButaneSource(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2DownloadClusterManifestParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("butane_source", "query", "bool", raw)
+	}
+	o.ButaneSource = &value
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *V2DownloadClusterManifestParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -12,14 +12,16 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2DownloadClusterManifestURL generates an URL for the v2 download cluster manifest operation
 type V2DownloadClusterManifestURL struct {
 	ClusterID strfmt.UUID
 
-	FileName string
-	Folder   *string
+	ButaneSource *bool
+	FileName     string
+	Folder       *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -62,6 +64,14 @@ func (o *V2DownloadClusterManifestURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var butaneSourceQ string
+	if o.ButaneSource != nil {
+		butaneSourceQ = swag.FormatBool(*o.ButaneSource)
+	}
+	if butaneSourceQ != "" {
+		qs.Set("butane_source", butaneSourceQ)
+	}
+
 	fileNameQ := o.FileName
 	if fileNameQ != "" {
 		qs.Set("file_name", fileNameQ)
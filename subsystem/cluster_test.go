@@ -59,6 +59,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -3714,6 +3715,8 @@ var _ = Describe("Preflight Cluster Requirements", func() {
 				continue
 			case openshiftlogging.Operator.Name:
 				continue
+			case sriov.Operator.Name:
+				continue
 			default:
 				Fail("Unexpected operator")
 			}
@@ -211,7 +211,8 @@ spec:
 
 			var found bool = false
 			for _, manifest := range response.Payload {
-				if *manifest == manifestFile || *manifest == renamedManifestFile {
+				if (manifest.FileName == manifestFile.FileName && manifest.Folder == manifestFile.Folder) ||
+					(manifest.FileName == renamedManifestFile.FileName && manifest.Folder == renamedManifestFile.Folder) {
 					found = true
 					break
 				}
@@ -45,6 +45,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
+	"github.com/openshift/assisted-service/internal/operators/sriov"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/subsystem/utils_test"
 )
@@ -89,6 +90,7 @@ var _ = Describe("Operators endpoint tests", func() {
 				metallb.Operator.Name,
 				loki.Operator.Name,
 				openshiftlogging.Operator.Name,
+				sriov.Operator.Name,
 			))
 		})
 
@@ -99,6 +101,17 @@ var _ = Describe("Operators endpoint tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(reply.Payload).To(BeEquivalentTo(models.OperatorProperties{}))
 		})
+
+		It("should provide the schemas of the operator properties", func() {
+			params := opclient.NewV2ListOperatorPropertiesParams().WithOperatorName(lvm.Operator.Name)
+			reply, err := utils_test.TestContext.UserBMClient.Operators.V2ListOperatorProperties(context.TODO(), params)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reply.Payload).ToNot(BeEmpty())
+			for _, property := range reply.Payload {
+				Expect(property.Schema).To(HaveKeyWithValue("type", "integer"))
+			}
+		})
 	})
 
 	Context("Create cluster", func() {
@@ -115,7 +128,9 @@ var _ = Describe("Operators endpoint tests", func() {
 			cluster := reply.GetPayload()
 			c := &common.Cluster{Cluster: *cluster}
 
-			for _, builtinOperator := range operators.NewManager(log, nil, operators.Options{}, nil).GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
+			operatorsManager, err := operators.NewManager(log, nil, operators.Options{}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			for _, builtinOperator := range operatorsManager.GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
 				Expect(operatorscommon.HasOperator(c.MonitoredOperators, builtinOperator.Name)).Should(BeTrue())
 			}
 		})
@@ -338,7 +353,9 @@ var _ = Describe("Operators endpoint tests", func() {
 			}
 
 			// Builtin
-			for _, builtinOperator := range operators.NewManager(log, nil, operators.Options{}, nil).GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
+			operatorsManager, err := operators.NewManager(log, nil, operators.Options{}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			for _, builtinOperator := range operatorsManager.GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
 				Expect(operatorNames).To(ContainElements(builtinOperator.Name))
 			}
 
@@ -486,7 +503,9 @@ var _ = Describe("Operators endpoint tests", func() {
 			}
 
 			// Builtin
-			for _, builtinOperator := range operators.NewManager(log, nil, operators.Options{}, nil).GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
+			operatorsManager, err := operators.NewManager(log, nil, operators.Options{}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			for _, builtinOperator := range operatorsManager.GetSupportedOperatorsByType(models.OperatorTypeBuiltin) {
 				Expect(operatorNames).To(ContainElements(builtinOperator.Name))
 			}
 